@@ -0,0 +1,70 @@
+//! Address object commands
+
+use crate::{AddressCommands, output::Output};
+use super::ApiClient;
+use super::bulk::{self, Record};
+use serde::{Deserialize, Serialize};
+
+const ADDRESS_TYPES: [&str; 4] = ["ip", "cidr", "range", "fqdn"];
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct AddressObject {
+    pub id: String,
+    pub name: String,
+    #[serde(rename = "type")]
+    pub kind: String,
+    pub value: String,
+}
+
+#[derive(Debug, Deserialize)]
+pub struct PaginatedAddresses {
+    pub items: Vec<AddressObject>,
+}
+
+pub async fn handle(action: AddressCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
+    match action {
+        AddressCommands::List => {
+            let addresses: PaginatedAddresses = client.get("/objects/addresses").await?;
+            out.print(&addresses.items)?;
+        }
+        AddressCommands::Create { bulk, .. } if bulk.from_csv.is_some() => {
+            bulk::run(client, "/objects/addresses", &bulk, address_from_row).await?;
+        }
+        AddressCommands::Create { name, kind, value, description, bulk } => {
+            check_type(&kind)?;
+            let body = serde_json::json!({
+                "name": name,
+                "type": kind,
+                "value": value,
+                "description": description,
+            });
+            if bulk.dry_run {
+                bulk::validate(client, "/objects/addresses", &body).await?;
+            } else {
+                let address: AddressObject = client.post("/objects/addresses", &body).await?;
+                println!("Created address object: {}", address.id);
+            }
+        }
+    }
+    Ok(())
+}
+
+fn check_type(kind: &str) -> Result<(), String> {
+    if ADDRESS_TYPES.contains(&kind) {
+        Ok(())
+    } else {
+        Err(format!("unknown address type '{}', expected one of {}", kind, ADDRESS_TYPES.join(", ")))
+    }
+}
+
+/// Columns: name, type (default cidr), value, description
+fn address_from_row(row: &Record) -> Result<serde_json::Value, String> {
+    let kind = bulk::optional(row, "type").unwrap_or("cidr");
+    check_type(kind)?;
+    Ok(serde_json::json!({
+        "name": bulk::required(row, "name")?,
+        "type": kind,
+        "value": bulk::required(row, "value")?,
+        "description": bulk::optional(row, "description"),
+    }))
+}
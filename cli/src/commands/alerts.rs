@@ -1,10 +1,10 @@
 //! Alerts commands
 
-use crate::{AlertCommands, output::OutputFormat};
+use crate::{AlertCommands, output::Output};
 use super::ApiClient;
 use serde::{Deserialize, Serialize};
 
-#[derive(Debug, Serialize, Deserialize)]
+#[derive(Debug, Clone, Serialize, Deserialize)]
 pub struct Alert {
     pub id: String,
     pub severity: String,
@@ -17,7 +17,7 @@ pub struct PaginatedAlerts {
     pub items: Vec<Alert>,
 }
 
-pub async fn handle(action: AlertCommands, client: &ApiClient, format: OutputFormat) -> Result<(), String> {
+pub async fn handle(action: AlertCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
     match action {
         AlertCommands::List { severity, status } => {
             let mut params = Vec::new();
@@ -25,11 +25,11 @@ pub async fn handle(action: AlertCommands, client: &ApiClient, format: OutputFor
             if let Some(s) = status { params.push(format!("status={}", s)); }
             let query = if params.is_empty() { String::new() } else { format!("?{}", params.join("&")) };
             let alerts: PaginatedAlerts = client.get(&format!("/alerts{}", query)).await?;
-            format.print(&alerts.items);
+            out.print(&alerts.items)?;
         }
         AlertCommands::Get { id } => {
             let alert: Alert = client.get(&format!("/alerts/{}", id)).await?;
-            format.print(&alert);
+            out.print(&alert)?;
         }
         AlertCommands::Ack { id } => {
             let _: Alert = client.post(&format!("/alerts/{}/acknowledge", id), &()).await?;
@@ -1,6 +1,6 @@
 //! Analytics commands
 
-use crate::{AnalyticsCommands, output::OutputFormat};
+use crate::{AnalyticsCommands, output::Output};
 use super::ApiClient;
 use serde::{Deserialize, Serialize};
 
@@ -18,15 +18,15 @@ pub struct ThreatStats {
     pub total_threats: u64,
 }
 
-pub async fn handle(action: AnalyticsCommands, client: &ApiClient, format: OutputFormat) -> Result<(), String> {
+pub async fn handle(action: AnalyticsCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
     match action {
         AnalyticsCommands::Traffic { period } => {
             let stats: TrafficStats = client.get(&format!("/analytics/traffic?period={}", period)).await?;
-            format.print(&stats);
+            out.print(&stats)?;
         }
         AnalyticsCommands::Threats { period } => {
             let stats: ThreatStats = client.get(&format!("/analytics/threats?period={}", period)).await?;
-            format.print(&stats);
+            out.print(&stats)?;
         }
     }
     Ok(())
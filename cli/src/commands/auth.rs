@@ -0,0 +1,108 @@
+//! Auth commands
+//!
+//! Credentials are kept in the OS keychain (Keychain on macOS, Credential
+//! Manager on Windows, the kernel keyring on Linux), one entry per profile.
+//! Profile files under ~/.opensase only hold non-secret settings.
+
+use crate::AuthCommands;
+use crate::config::{Config, Credential};
+use serde::Deserialize;
+use std::io::{self, BufRead, Write};
+
+#[derive(Debug, Deserialize)]
+struct TokenResponse {
+    access_token: String,
+    refresh_token: Option<String>,
+}
+
+pub async fn handle(action: AuthCommands, profile: Option<&str>) -> Result<(), String> {
+    match action {
+        AuthCommands::Login { api_key, refresh_token, api_url, tenant_id } => {
+            let credential = match (api_key, refresh_token) {
+                (_, Some(refresh_token)) => Credential::OAuth { refresh_token },
+                (Some(api_key), None) => Credential::ApiKey { api_key },
+                (None, None) => Credential::ApiKey { api_key: prompt("API key: ")? },
+            };
+            credential.store(profile)?;
+
+            let mut config = Config::load(profile).unwrap_or_default();
+            if api_url.is_some() {
+                config.api_url = api_url;
+            }
+            if tenant_id.is_some() {
+                config.tenant_id = tenant_id;
+            }
+            // Drop any plaintext key left over from older versions
+            config.api_key = None;
+            config.save_profile(profile)?;
+
+            println!("Logged in to profile {}", profile.unwrap_or("default"));
+        }
+        AuthCommands::Logout => {
+            Credential::delete(profile)?;
+            println!("Removed credentials for profile {}", profile.unwrap_or("default"));
+        }
+        AuthCommands::Switch { profile } => {
+            if !Config::profiles()?.contains(&profile) {
+                return Err(format!("Unknown profile: {} (run `opensase --profile {} auth login`)", profile, profile));
+            }
+            let mut config = Config::load(None).unwrap_or_default();
+            config.current_profile = Some(profile.clone());
+            config.save()?;
+            println!("Switched to profile {}", profile);
+        }
+        AuthCommands::List => {
+            let current = Config::active_profile(None).unwrap_or_else(|| "default".into());
+            for name in Config::profiles()? {
+                let marker = if name == current { "*" } else { " " };
+                let kind = match Credential::load(Some(&name)) {
+                    Ok(Some(Credential::ApiKey { .. })) => "api key",
+                    Ok(Some(Credential::OAuth { .. })) => "oauth",
+                    Ok(None) => "not logged in",
+                    Err(_) => "keychain unavailable",
+                };
+                println!("{} {} ({})", marker, name, kind);
+            }
+        }
+    }
+    Ok(())
+}
+
+/// Resolves the bearer token for a profile from the keychain, exchanging an
+/// OAuth refresh token for an access token when needed
+pub async fn bearer_token(api_url: &str, profile: Option<&str>) -> Result<Option<String>, String> {
+    match Credential::load(profile)? {
+        None => Ok(None),
+        Some(Credential::ApiKey { api_key }) => Ok(Some(api_key)),
+        Some(Credential::OAuth { refresh_token }) => {
+            let resp = reqwest::Client::new()
+                .post(format!("{}/oauth/token", api_url))
+                .form(&[("grant_type", "refresh_token"), ("refresh_token", refresh_token.as_str())])
+                .send()
+                .await
+                .map_err(|e| e.to_string())?;
+            if !resp.status().is_success() {
+                return Err(format!("token refresh failed ({}); run `opensase auth login` again", resp.status()));
+            }
+            let token: TokenResponse = resp.json().await.map_err(|e| e.to_string())?;
+
+            // Refresh tokens may be rotated on use
+            if let Some(rotated) = token.refresh_token {
+                Credential::OAuth { refresh_token: rotated }.store(profile)?;
+            }
+            Ok(Some(token.access_token))
+        }
+    }
+}
+
+fn prompt(label: &str) -> Result<String, String> {
+    print!("{}", label);
+    io::stdout().flush().map_err(|e| e.to_string())?;
+    let mut line = String::new();
+    io::stdin().lock().read_line(&mut line).map_err(|e| e.to_string())?;
+    let value = line.trim().to_string();
+    if value.is_empty() {
+        return Err("no credential given".into());
+    }
+    Ok(value)
+}
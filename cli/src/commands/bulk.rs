@@ -0,0 +1,169 @@
+//! Bulk create from CSV
+
+use super::{ApiClient, fnv1a};
+use clap::Args;
+use futures_util::stream::{self, StreamExt};
+use std::collections::{HashMap, HashSet};
+use std::fs::{self, OpenOptions};
+use std::io::Write;
+
+/// Flags shared by every bulk-capable create command
+#[derive(Args, Debug, Clone)]
+pub struct BulkArgs {
+    /// Create one object per row of a CSV file with a header row
+    #[arg(long = "from-csv", value_name = "FILE")]
+    pub from_csv: Option<String>,
+
+    /// Requests in flight at once
+    #[arg(long, default_value_t = 4, requires = "from_csv")]
+    pub concurrency: usize,
+
+    /// Validate every row server-side without creating anything
+    #[arg(long)]
+    pub dry_run: bool,
+
+    /// Progress file recording finished rows by their contents (default: <FILE>.progress)
+    #[arg(long, value_name = "FILE", requires = "from_csv")]
+    pub progress_file: Option<String>,
+}
+
+/// A CSV row keyed by header
+pub type Record = HashMap<String, String>;
+
+/// Returns a required, non-empty column
+pub fn required<'a>(row: &'a Record, column: &str) -> Result<&'a str, String> {
+    match row.get(column).map(|v| v.trim()) {
+        Some(v) if !v.is_empty() => Ok(v),
+        _ => Err(format!("missing required column '{}'", column)),
+    }
+}
+
+/// Returns an optional column, treating empty cells as absent
+pub fn optional<'a>(row: &'a Record, column: &str) -> Option<&'a str> {
+    row.get(column).map(|v| v.trim()).filter(|v| !v.is_empty())
+}
+
+/// Creates one object per CSV row at `path`. Rows recorded in the progress
+/// file are skipped, so an interrupted run can be repeated to resume it.
+/// Rows are recorded by a hash of their contents rather than their line, so
+/// failed rows can be fixed, and rows added or removed, before re-running.
+pub async fn run(
+    client: &ApiClient,
+    path: &str,
+    args: &BulkArgs,
+    build: fn(&Record) -> Result<serde_json::Value, String>,
+) -> Result<(), String> {
+    let file = args.from_csv.as_deref().ok_or("--from-csv is required")?;
+    let rows = read_csv(file)?;
+
+    let progress_path = args
+        .progress_file
+        .clone()
+        .unwrap_or_else(|| format!("{}.progress", file));
+    let done = if args.dry_run { HashSet::new() } else { read_progress(&progress_path)? };
+    let mut progress = if args.dry_run {
+        None
+    } else {
+        Some(
+            OpenOptions::new()
+                .create(true)
+                .append(true)
+                .open(&progress_path)
+                .map_err(|e| format!("{}: {}", progress_path, e))?,
+        )
+    };
+
+    // Line numbers are 1-based and count the header, matching what editors
+    // show; they are only used in messages
+    let rows: Vec<(usize, Record)> = rows.into_iter().enumerate().map(|(i, row)| (i + 2, row)).collect();
+    let total_rows = rows.len();
+    let pending: Vec<(usize, Record)> = rows.into_iter().filter(|(_, row)| !done.contains(&row_key(row))).collect();
+    let skipped = total_rows - pending.len();
+    let total = pending.len();
+
+    let mut results = stream::iter(pending)
+        .map(|(line, row)| async move {
+            let result = match build(&row) {
+                Ok(body) => client
+                    .post_with::<serde_json::Value, _>(path, &body, args.dry_run)
+                    .await
+                    .map(|created| created.get("id").and_then(|v| v.as_str()).map(String::from)),
+                Err(e) => Err(e),
+            };
+            (line, row_key(&row), result)
+        })
+        .buffer_unordered(args.concurrency.max(1));
+
+    let (mut ok, mut failed) = (0, 0);
+    while let Some((line, key, result)) = results.next().await {
+        match result {
+            Ok(id) => {
+                ok += 1;
+                if let Some(f) = progress.as_mut() {
+                    writeln!(f, "{}", key).map_err(|e| e.to_string())?;
+                }
+                match (args.dry_run, id) {
+                    (true, _) => println!("line {}: valid", line),
+                    (false, Some(id)) => println!("line {}: created {}", line, id),
+                    (false, None) => println!("line {}: created", line),
+                }
+            }
+            Err(e) => {
+                failed += 1;
+                eprintln!("line {}: {}", line, e);
+            }
+        }
+    }
+
+    let verb = if args.dry_run { "valid" } else { "created" };
+    println!("{} of {} rows {}, {} failed, {} already done", ok, total, verb, failed, skipped);
+    if failed > 0 {
+        return Err(format!("{} rows failed; fix them and re-run to resume", failed));
+    }
+    Ok(())
+}
+
+/// Validates a single object server-side without creating it, as `--dry-run`
+/// does for each row of a bulk create
+pub async fn validate(client: &ApiClient, path: &str, body: &serde_json::Value) -> Result<(), String> {
+    client.post_with::<serde_json::Value, _>(path, body, true).await?;
+    println!("valid");
+    Ok(())
+}
+
+/// Identifies a row by its contents: a 64-bit FNV-1a hash of its cells,
+/// sorted by column name so reordering columns does not change it.
+/// Identical rows share a key.
+fn row_key(row: &Record) -> String {
+    let mut columns: Vec<(&String, &String)> = row.iter().collect();
+    columns.sort();
+    let hash = fnv1a(columns.into_iter().flat_map(|(column, value)| {
+        column.bytes().chain([0x1f]).chain(value.trim().bytes()).chain([0x1e])
+    }));
+    format!("{:016x}", hash)
+}
+
+fn read_csv(file: &str) -> Result<Vec<Record>, String> {
+    let mut reader = csv::Reader::from_path(file).map_err(|e| format!("{}: {}", file, e))?;
+    let headers = reader.headers().map_err(|e| e.to_string())?.clone();
+    let mut rows = Vec::new();
+    for record in reader.records() {
+        let record = record.map_err(|e| format!("{}: {}", file, e))?;
+        rows.push(
+            headers
+                .iter()
+                .zip(record.iter())
+                .map(|(h, v)| (h.trim().to_lowercase(), v.to_string()))
+                .collect(),
+        );
+    }
+    Ok(rows)
+}
+
+fn read_progress(path: &str) -> Result<HashSet<String>, String> {
+    match fs::read_to_string(path) {
+        Ok(content) => Ok(content.lines().map(|l| l.trim().to_string()).filter(|l| !l.is_empty()).collect()),
+        Err(e) if e.kind() == std::io::ErrorKind::NotFound => Ok(HashSet::new()),
+        Err(e) => Err(format!("{}: {}", path, e)),
+    }
+}
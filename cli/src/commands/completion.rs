@@ -0,0 +1,170 @@
+//! Shell completion
+//!
+//! `opensase completion <shell>` prints a completion script. Besides the
+//! static subcommands and flags, the script completes site and policy IDs by
+//! calling the hidden `opensase __complete <kind>` command, which answers from
+//! a local cache under ~/.opensase/cache so that pressing TAB stays fast and
+//! works offline. The cache is refreshed from the API once it is older than
+//! OPENSASE_COMPLETION_TTL seconds (default 300); if the API cannot be reached
+//! the stale entries are used.
+
+use super::{ApiClient, fnv1a};
+use super::policies::PaginatedPolicies;
+use super::sites::PaginatedSites;
+use clap::ValueEnum;
+use clap_complete::Shell;
+use serde::{Deserialize, Serialize};
+use std::fs;
+use std::path::PathBuf;
+use std::time::{Duration, SystemTime, UNIX_EPOCH};
+
+const DEFAULT_TTL_SECS: u64 = 300;
+const FETCH_TIMEOUT: Duration = Duration::from_secs(3);
+
+#[derive(Debug, Clone, Copy, ValueEnum)]
+pub enum CompletionKind {
+    Sites,
+    Policies,
+}
+
+impl CompletionKind {
+    fn name(self) -> &'static str {
+        match self {
+            CompletionKind::Sites => "sites",
+            CompletionKind::Policies => "policies",
+        }
+    }
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct CacheFile {
+    fetched_at: u64,
+    entries: Vec<Entry>,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct Entry {
+    id: String,
+    name: String,
+}
+
+/// Prints the completion script for `shell`
+pub fn print(shell: Shell, cmd: &mut clap::Command) -> Result<(), String> {
+    let name = cmd.get_name().to_string();
+    let mut script = Vec::new();
+    clap_complete::generate(shell, cmd, &name, &mut script);
+    let mut script = String::from_utf8(script).map_err(|e| e.to_string())?;
+
+    match shell {
+        Shell::Bash => script.push_str(BASH_DYNAMIC),
+        Shell::Zsh => script.push_str(ZSH_DYNAMIC),
+        Shell::Fish => script.push_str(FISH_DYNAMIC),
+        _ => {}
+    }
+    print!("{}", script);
+    Ok(())
+}
+
+/// Prints cached `id<TAB>name` lines for `kind`, refreshing the cache when stale
+pub async fn complete(kind: CompletionKind, client: &ApiClient, profile: Option<&str>) -> Result<(), String> {
+    let path = cache_path(kind, client, profile)?;
+    let cached = fs::read_to_string(&path)
+        .ok()
+        .and_then(|s| serde_json::from_str::<CacheFile>(&s).ok());
+
+    let fresh = cached.as_ref().map_or(false, |c| now().saturating_sub(c.fetched_at) < ttl());
+    let entries = if fresh {
+        cached.map(|c| c.entries).unwrap_or_default()
+    } else {
+        match tokio::time::timeout(FETCH_TIMEOUT, fetch(kind, client)).await {
+            Ok(Ok(entries)) => {
+                let file = CacheFile { fetched_at: now(), entries };
+                // A failed write only costs a refetch on the next TAB
+                if let Some(parent) = path.parent() {
+                    let _ = fs::create_dir_all(parent);
+                }
+                let _ = fs::write(&path, serde_json::to_string(&file).unwrap_or_default());
+                file.entries
+            }
+            _ => cached.map(|c| c.entries).unwrap_or_default(),
+        }
+    };
+
+    for entry in entries {
+        println!("{}\t{}", entry.id, entry.name);
+    }
+    Ok(())
+}
+
+async fn fetch(kind: CompletionKind, client: &ApiClient) -> Result<Vec<Entry>, String> {
+    let entries = match kind {
+        CompletionKind::Sites => {
+            let sites: PaginatedSites = client.get("/sites").await?;
+            sites.items.into_iter().map(|s| Entry { id: s.id, name: s.name }).collect()
+        }
+        CompletionKind::Policies => {
+            let policies: PaginatedPolicies = client.get("/policies").await?;
+            policies.items.into_iter().map(|p| Entry { id: p.id, name: p.name }).collect()
+        }
+    };
+    Ok(entries)
+}
+
+/// Cache file per profile, API endpoint and tenant, so profiles never
+/// complete each other's IDs
+fn cache_path(kind: CompletionKind, client: &ApiClient, profile: Option<&str>) -> Result<PathBuf, String> {
+    let home = dirs::home_dir().ok_or("Cannot find home directory")?;
+    let filename = format!(
+        "{}-{:016x}-{}-{}.json",
+        profile.unwrap_or("default"),
+        fnv1a(client.base_url.bytes()),
+        client.tenant_id.as_deref().unwrap_or("default"),
+        kind.name(),
+    );
+    Ok(home.join(".opensase").join("cache").join(filename))
+}
+
+fn ttl() -> u64 {
+    std::env::var("OPENSASE_COMPLETION_TTL")
+        .ok()
+        .and_then(|v| v.parse().ok())
+        .unwrap_or(DEFAULT_TTL_SECS)
+}
+
+fn now() -> u64 {
+    SystemTime::now().duration_since(UNIX_EPOCH).map(|d| d.as_secs()).unwrap_or(0)
+}
+
+const BASH_DYNAMIC: &str = r#"
+_opensase_dynamic() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+    if [[ $COMP_CWORD -eq 3 && "${COMP_WORDS[2]}" == "get" ]]; then
+        case "${COMP_WORDS[1]}" in
+            sites|policies)
+                COMPREPLY=( $(compgen -W "$(opensase __complete "${COMP_WORDS[1]}" 2>/dev/null | cut -f1)" -- "$cur") )
+                return 0
+                ;;
+        esac
+    fi
+    _opensase "$@"
+}
+complete -F _opensase_dynamic -o bashdefault -o default opensase
+"#;
+
+const ZSH_DYNAMIC: &str = r#"
+_opensase_dynamic() {
+    if (( CURRENT == 4 )) && [[ ${words[3]} == get && ${words[2]} == (sites|policies) ]]; then
+        local -a ids
+        ids=(${(f)"$(opensase __complete ${words[2]} 2>/dev/null | sed 's/:/\\:/g; s/\t/:/')"})
+        _describe 'id' ids
+        return
+    fi
+    _opensase "$@"
+}
+compdef _opensase_dynamic opensase
+"#;
+
+const FISH_DYNAMIC: &str = r#"
+complete -c opensase -n "__fish_seen_subcommand_from sites; and __fish_seen_subcommand_from get" -f -a "(opensase __complete sites 2>/dev/null)"
+complete -c opensase -n "__fish_seen_subcommand_from policies; and __fish_seen_subcommand_from get" -f -a "(opensase __complete policies 2>/dev/null)"
+"#;
@@ -1,30 +1,33 @@
 //! Config commands
 
 use crate::ConfigCommands;
-use crate::config::Config;
+use crate::config::{Config, Credential};
 
-pub async fn handle(action: ConfigCommands) -> Result<(), String> {
+pub async fn handle(action: ConfigCommands, profile: Option<&str>) -> Result<(), String> {
     match action {
         ConfigCommands::Init => {
             let config = Config::default();
-            config.save()?;
-            println!("Configuration initialized at ~/.opensase/config.toml");
+            config.save_profile(profile)?;
+            println!("Configuration initialized for profile {}", profile.unwrap_or("default"));
         }
         ConfigCommands::Set { key, value } => {
-            let mut config = Config::load(None).unwrap_or_default();
+            let mut config = Config::load(profile).unwrap_or_default();
             match key.as_str() {
-                "api_key" => config.api_key = Some(value),
+                "api_key" => {
+                    Credential::ApiKey { api_key: value }.store(profile)?;
+                    config.api_key = None;
+                }
                 "tenant_id" => config.tenant_id = Some(value),
                 "api_url" => config.api_url = Some(value),
                 _ => return Err(format!("Unknown config key: {}", key)),
             }
-            config.save()?;
+            config.save_profile(profile)?;
             println!("Set {} successfully", key);
         }
         ConfigCommands::Get { key } => {
-            let config = Config::load(None).unwrap_or_default();
+            let config = Config::load(profile).unwrap_or_default();
             let value = match key.as_str() {
-                "api_key" => config.api_key.map(|k| format!("{}****", &k[..8.min(k.len())])),
+                "api_key" => stored_api_key(config.api_key, profile).map(|k| format!("{}****", &k[..8.min(k.len())])),
                 "tenant_id" => config.tenant_id,
                 "api_url" => config.api_url,
                 _ => return Err(format!("Unknown config key: {}", key)),
@@ -32,11 +35,19 @@ pub async fn handle(action: ConfigCommands) -> Result<(), String> {
             println!("{}: {}", key, value.unwrap_or_else(|| "(not set)".into()));
         }
         ConfigCommands::List => {
-            let config = Config::load(None).unwrap_or_default();
+            let config = Config::load(profile).unwrap_or_default();
             println!("api_url: {}", config.api_url.unwrap_or_else(|| "(not set)".into()));
             println!("tenant_id: {}", config.tenant_id.unwrap_or_else(|| "(not set)".into()));
-            println!("api_key: {}", config.api_key.map(|k| format!("{}****", &k[..8.min(k.len())])).unwrap_or_else(|| "(not set)".into()));
+            println!("api_key: {}", stored_api_key(config.api_key, profile).map(|k| format!("{}****", &k[..8.min(k.len())])).unwrap_or_else(|| "(not set)".into()));
         }
     }
     Ok(())
 }
+
+/// The profile's keychain API key, falling back to a legacy plaintext one
+fn stored_api_key(plaintext: Option<String>, profile: Option<&str>) -> Option<String> {
+    match Credential::load(profile) {
+        Ok(Some(Credential::ApiKey { api_key })) => Some(api_key),
+        _ => plaintext,
+    }
+}
@@ -0,0 +1,65 @@
+//! Contacts commands
+
+use crate::{ContactCommands, output::Output};
+use super::ApiClient;
+use super::bulk::{self, Record};
+use serde::{Deserialize, Serialize};
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Contact {
+    pub id: String,
+    pub email: String,
+    #[serde(default)]
+    pub first_name: Option<String>,
+    #[serde(default)]
+    pub last_name: Option<String>,
+    #[serde(default)]
+    pub company: Option<String>,
+}
+
+#[derive(Debug, Deserialize)]
+pub struct PaginatedContacts {
+    pub items: Vec<Contact>,
+}
+
+pub async fn handle(action: ContactCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
+    match action {
+        ContactCommands::List { search } => {
+            let path = match search {
+                Some(s) => format!("/crm/contacts?search={}", s),
+                None => "/crm/contacts".to_string(),
+            };
+            let contacts: PaginatedContacts = client.get(&path).await?;
+            out.print(&contacts.items)?;
+        }
+        ContactCommands::Create { bulk, .. } if bulk.from_csv.is_some() => {
+            bulk::run(client, "/crm/contacts", &bulk, contact_from_row).await?;
+        }
+        ContactCommands::Create { email, first_name, last_name, company, bulk } => {
+            let body = serde_json::json!({
+                "email": email,
+                "first_name": first_name,
+                "last_name": last_name,
+                "company": company,
+            });
+            if bulk.dry_run {
+                bulk::validate(client, "/crm/contacts", &body).await?;
+            } else {
+                let contact: Contact = client.post("/crm/contacts", &body).await?;
+                println!("Created contact: {}", contact.id);
+            }
+        }
+    }
+    Ok(())
+}
+
+/// Columns: email, first_name, last_name, company, phone
+fn contact_from_row(row: &Record) -> Result<serde_json::Value, String> {
+    Ok(serde_json::json!({
+        "email": bulk::required(row, "email")?,
+        "first_name": bulk::optional(row, "first_name"),
+        "last_name": bulk::optional(row, "last_name"),
+        "company": bulk::optional(row, "company"),
+        "phone": bulk::optional(row, "phone"),
+    }))
+}
@@ -6,10 +6,17 @@ pub mod policies;
 pub mod alerts;
 pub mod analytics;
 pub mod config;
+pub mod top;
+pub mod bulk;
+pub mod contacts;
+pub mod addresses;
+pub mod completion;
+pub mod auth;
 
 use serde::de::DeserializeOwned;
 
 /// API client
+#[derive(Clone)]
 pub struct ApiClient {
     pub base_url: String,
     pub api_key: Option<String>,
@@ -46,16 +53,34 @@ impl ApiClient {
     }
 
     pub async fn post<T: DeserializeOwned, B: serde::Serialize>(&self, path: &str, body: &B) -> Result<T, String> {
+        self.post_with(path, body, false).await
+    }
+
+    /// POST that the server only validates, without committing, when `dry_run` is set
+    pub async fn post_with<T: DeserializeOwned, B: serde::Serialize>(&self, path: &str, body: &B, dry_run: bool) -> Result<T, String> {
         let url = format!("{}{}", self.base_url, self.tenant_path(path));
         let mut req = self.client.post(&url).json(body);
         
         if let Some(key) = &self.api_key {
             req = req.header("Authorization", format!("Bearer {}", key));
         }
+        if dry_run {
+            req = req.header("X-OpenSASE-Dry-Run", "true");
+        }
         
         let resp = req.send().await.map_err(|e| e.to_string())?;
+        let status = resp.status();
         let json: serde_json::Value = resp.json().await.map_err(|e| e.to_string())?;
         
+        if !status.is_success() {
+            let message = json
+                .get("message")
+                .or_else(|| json.get("error").and_then(|e| e.get("message")))
+                .and_then(|m| m.as_str())
+                .unwrap_or_else(|| status.canonical_reason().unwrap_or("request failed"));
+            return Err(format!("{} ({})", message, status.as_u16()));
+        }
+        
         if let Some(data) = json.get("data") {
             serde_json::from_value(data.clone()).map_err(|e| e.to_string())
         } else {
@@ -63,6 +88,22 @@ impl ApiClient {
         }
     }
 
+    /// Opens a server-sent event stream
+    pub async fn stream(&self, path: &str) -> Result<reqwest::Response, String> {
+        let url = format!("{}{}", self.base_url, self.tenant_path(path));
+        let mut req = self.client.get(&url).header("Accept", "text/event-stream");
+
+        if let Some(key) = &self.api_key {
+            req = req.header("Authorization", format!("Bearer {}", key));
+        }
+
+        let resp = req.send().await.map_err(|e| e.to_string())?;
+        if !resp.status().is_success() {
+            return Err(format!("stream returned {}", resp.status()));
+        }
+        Ok(resp)
+    }
+
     fn tenant_path(&self, path: &str) -> String {
         if let Some(tenant) = &self.tenant_id {
             format!("/tenants/{}{}", tenant, path)
@@ -71,3 +112,14 @@ impl ApiClient {
         }
     }
 }
+
+/// 64-bit FNV-1a hash, stable across builds so it can name files and
+/// progress entries
+pub fn fnv1a(bytes: impl IntoIterator<Item = u8>) -> u64 {
+    let mut hash: u64 = 0xcbf2_9ce4_8422_2325;
+    for b in bytes {
+        hash ^= u64::from(b);
+        hash = hash.wrapping_mul(0x0000_0100_0000_01b3);
+    }
+    hash
+}
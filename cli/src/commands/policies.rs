@@ -1,6 +1,6 @@
 //! Policies commands
 
-use crate::{PolicyCommands, output::OutputFormat};
+use crate::{PolicyCommands, output::Output};
 use super::ApiClient;
 use serde::{Deserialize, Serialize};
 use std::fs;
@@ -18,15 +18,15 @@ pub struct PaginatedPolicies {
     pub items: Vec<Policy>,
 }
 
-pub async fn handle(action: PolicyCommands, client: &ApiClient, format: OutputFormat) -> Result<(), String> {
+pub async fn handle(action: PolicyCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
     match action {
         PolicyCommands::List => {
             let policies: PaginatedPolicies = client.get("/policies").await?;
-            format.print(&policies.items);
+            out.print(&policies.items)?;
         }
         PolicyCommands::Get { id } => {
             let policy: Policy = client.get(&format!("/policies/{}", id)).await?;
-            format.print(&policy);
+            out.print(&policy)?;
         }
         PolicyCommands::Apply { file } => {
             let content = fs::read_to_string(&file).map_err(|e| e.to_string())?;
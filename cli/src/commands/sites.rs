@@ -1,7 +1,8 @@
 //! Sites commands
 
-use crate::{SiteCommands, output::OutputFormat};
+use crate::{SiteCommands, output::Output};
 use super::ApiClient;
+use super::bulk::{self, Record};
 use serde::{Deserialize, Serialize};
 
 #[derive(Debug, Serialize, Deserialize)]
@@ -18,21 +19,36 @@ pub struct PaginatedSites {
     pub total: u64,
 }
 
-pub async fn handle(action: SiteCommands, client: &ApiClient, format: OutputFormat) -> Result<(), String> {
+pub async fn handle(action: SiteCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
     match action {
         SiteCommands::List => {
             let sites: PaginatedSites = client.get("/sites").await?;
-            format.print(&sites.items);
+            out.print(&sites.items)?;
         }
         SiteCommands::Get { id } => {
             let site: Site = client.get(&format!("/sites/{}", id)).await?;
-            format.print(&site);
+            out.print(&site)?;
         }
-        SiteCommands::Create { name, location } => {
+        SiteCommands::Create { bulk, .. } if bulk.from_csv.is_some() => {
+            bulk::run(client, "/sites", &bulk, site_from_row).await?;
+        }
+        SiteCommands::Create { name, location, bulk } => {
             let body = serde_json::json!({ "name": name, "location": location });
-            let site: Site = client.post("/sites", &body).await?;
-            println!("Created site: {}", site.id);
+            if bulk.dry_run {
+                bulk::validate(client, "/sites", &body).await?;
+            } else {
+                let site: Site = client.post("/sites", &body).await?;
+                println!("Created site: {}", site.id);
+            }
         }
     }
     Ok(())
 }
+
+/// Columns: name, location
+fn site_from_row(row: &Record) -> Result<serde_json::Value, String> {
+    Ok(serde_json::json!({
+        "name": bulk::required(row, "name")?,
+        "location": bulk::required(row, "location")?,
+    }))
+}
@@ -0,0 +1,341 @@
+//! Live terminal dashboard
+
+use super::alerts::{Alert, PaginatedAlerts};
+use super::ApiClient;
+use crossterm::event::{self, Event, KeyCode, KeyEventKind};
+use crossterm::execute;
+use crossterm::terminal::{disable_raw_mode, enable_raw_mode, EnterAlternateScreen, LeaveAlternateScreen};
+use futures_util::StreamExt;
+use ratatui::backend::CrosstermBackend;
+use ratatui::layout::{Constraint, Direction, Layout, Rect};
+use ratatui::style::{Color, Modifier, Style};
+use ratatui::widgets::{Block, Borders, Cell, Paragraph, Row, Table};
+use ratatui::{Frame, Terminal};
+use serde::Deserialize;
+use std::io;
+use std::time::{Duration, Instant};
+use tokio::sync::mpsc;
+
+/// Rows kept per panel
+const MAX_ROWS: usize = 50;
+
+#[derive(Debug, Clone, Deserialize)]
+pub struct Tunnel {
+    pub id: String,
+    pub site: String,
+    pub pop: String,
+    pub status: String,
+    #[serde(default)]
+    pub latency_ms: f64,
+    #[serde(default)]
+    pub loss_pct: f64,
+}
+
+#[derive(Debug, Deserialize)]
+pub struct PaginatedTunnels {
+    pub items: Vec<Tunnel>,
+}
+
+#[derive(Debug, Clone, Deserialize)]
+pub struct TopApp {
+    pub name: String,
+    pub bytes: u64,
+    #[serde(default)]
+    pub sessions: u64,
+}
+
+#[derive(Debug, Clone, Deserialize)]
+pub struct SlaViolation {
+    pub site: String,
+    pub metric: String,
+    pub value: f64,
+    pub threshold: f64,
+}
+
+/// An event from the dashboard stream
+#[derive(Debug, Deserialize)]
+#[serde(tag = "type", content = "data", rename_all = "snake_case")]
+enum Update {
+    Tunnel(Tunnel),
+    TopApps(Vec<TopApp>),
+    Alert(Alert),
+    SlaViolation(SlaViolation),
+}
+
+enum Message {
+    Update(Update),
+    Status(String),
+}
+
+#[derive(Default)]
+struct Dashboard {
+    tunnels: Vec<Tunnel>,
+    apps: Vec<TopApp>,
+    alerts: Vec<Alert>,
+    violations: Vec<SlaViolation>,
+    status: String,
+}
+
+impl Dashboard {
+    /// Replace all panels with a fresh snapshot
+    async fn load(&mut self, client: &ApiClient) -> Result<(), String> {
+        let tunnels: PaginatedTunnels = client.get("/tunnels").await?;
+        let alerts: PaginatedAlerts = client.get("/alerts?status=open").await?;
+        self.tunnels = tunnels.items;
+        self.alerts = alerts.items;
+        self.apps = client.get("/analytics/top-apps?period=5m").await?;
+        self.violations = client.get("/sla/violations?period=1h").await?;
+        self.alerts.truncate(MAX_ROWS);
+        self.violations.truncate(MAX_ROWS);
+        Ok(())
+    }
+
+    fn apply(&mut self, update: Update) {
+        match update {
+            Update::Tunnel(t) => match self.tunnels.iter_mut().find(|x| x.id == t.id) {
+                Some(existing) => *existing = t,
+                None => self.tunnels.push(t),
+            },
+            Update::TopApps(apps) => self.apps = apps,
+            Update::Alert(a) => {
+                self.alerts.retain(|x| x.id != a.id);
+                if a.status != "resolved" {
+                    self.alerts.insert(0, a);
+                    self.alerts.truncate(MAX_ROWS);
+                }
+            }
+            Update::SlaViolation(v) => {
+                self.violations.insert(0, v);
+                self.violations.truncate(MAX_ROWS);
+            }
+        }
+    }
+}
+
+/// Restores the terminal even if the dashboard exits with an error
+struct TerminalGuard;
+
+impl Drop for TerminalGuard {
+    fn drop(&mut self) {
+        let _ = disable_raw_mode();
+        let _ = execute!(io::stdout(), LeaveAlternateScreen);
+    }
+}
+
+pub async fn run(client: &ApiClient, refresh: u64) -> Result<(), String> {
+    let mut dash = Dashboard::default();
+    dash.load(client).await?;
+    dash.status = "connecting to stream...".into();
+
+    let (tx, mut rx) = mpsc::unbounded_channel();
+    let stream = tokio::spawn(stream_updates(client.clone(), tx));
+
+    enable_raw_mode().map_err(|e| e.to_string())?;
+    let _guard = TerminalGuard;
+    execute!(io::stdout(), EnterAlternateScreen).map_err(|e| e.to_string())?;
+    let mut terminal = Terminal::new(CrosstermBackend::new(io::stdout())).map_err(|e| e.to_string())?;
+
+    let refresh = Duration::from_secs(refresh.max(1));
+    let mut last_load = Instant::now();
+    let mut reload = false;
+
+    loop {
+        while let Ok(msg) = rx.try_recv() {
+            match msg {
+                Message::Update(u) => dash.apply(u),
+                Message::Status(s) => dash.status = s,
+            }
+        }
+
+        terminal.draw(|f| draw(f, &dash)).map_err(|e| e.to_string())?;
+
+        if event::poll(Duration::from_millis(200)).map_err(|e| e.to_string())? {
+            if let Event::Key(key) = event::read().map_err(|e| e.to_string())? {
+                if key.kind == KeyEventKind::Press {
+                    match key.code {
+                        KeyCode::Char('q') | KeyCode::Esc => break,
+                        KeyCode::Char('r') => reload = true,
+                        _ => {}
+                    }
+                }
+            }
+        }
+
+        // The stream carries incremental changes; a periodic snapshot
+        // corrects anything missed while it was reconnecting
+        if reload || last_load.elapsed() >= refresh {
+            if let Err(e) = dash.load(client).await {
+                dash.status = format!("refresh failed: {}", e);
+            }
+            last_load = Instant::now();
+            reload = false;
+        }
+    }
+
+    stream.abort();
+    Ok(())
+}
+
+/// Reads the server-sent event stream, reconnecting with backoff
+async fn stream_updates(client: ApiClient, tx: mpsc::UnboundedSender<Message>) {
+    let mut backoff = Duration::from_secs(1);
+    loop {
+        match client.stream("/stream/dashboard").await {
+            Ok(resp) => {
+                backoff = Duration::from_secs(1);
+                let _ = tx.send(Message::Status("live".into()));
+
+                let mut body = resp.bytes_stream();
+                let mut buf = String::new();
+                while let Some(chunk) = body.next().await {
+                    let chunk = match chunk {
+                        Ok(c) => c,
+                        Err(e) => {
+                            let _ = tx.send(Message::Status(format!("stream error: {}", e)));
+                            break;
+                        }
+                    };
+                    buf.push_str(&String::from_utf8_lossy(&chunk));
+                    while let Some(end) = buf.find("\n\n") {
+                        let frame: String = buf.drain(..end + 2).collect();
+                        if let Some(update) = parse_frame(&frame) {
+                            if tx.send(Message::Update(update)).is_err() {
+                                return;
+                            }
+                        }
+                    }
+                }
+            }
+            Err(e) => {
+                let _ = tx.send(Message::Status(format!("stream unavailable: {}", e)));
+            }
+        }
+
+        if tx.is_closed() {
+            return;
+        }
+        tokio::time::sleep(backoff).await;
+        backoff = (backoff * 2).min(Duration::from_secs(30));
+    }
+}
+
+/// Extracts the JSON payload of one SSE frame
+fn parse_frame(frame: &str) -> Option<Update> {
+    let data: Vec<&str> = frame
+        .lines()
+        .filter_map(|l| l.strip_prefix("data:"))
+        .map(str::trim_start)
+        .collect();
+    if data.is_empty() {
+        return None;
+    }
+    serde_json::from_str(&data.join("\n")).ok()
+}
+
+fn draw(f: &mut Frame, dash: &Dashboard) {
+    let rows = Layout::default()
+        .direction(Direction::Vertical)
+        .constraints([Constraint::Percentage(50), Constraint::Min(5), Constraint::Length(1)])
+        .split(f.size());
+    let top = Layout::default()
+        .direction(Direction::Horizontal)
+        .constraints([Constraint::Percentage(60), Constraint::Percentage(40)])
+        .split(rows[0]);
+    let bottom = Layout::default()
+        .direction(Direction::Horizontal)
+        .constraints([Constraint::Percentage(50), Constraint::Percentage(50)])
+        .split(rows[1]);
+
+    draw_tunnels(f, top[0], dash);
+    draw_apps(f, top[1], dash);
+    draw_alerts(f, bottom[0], dash);
+    draw_violations(f, bottom[1], dash);
+
+    let footer = format!(" q quit  r refresh  |  {}", dash.status);
+    f.render_widget(Paragraph::new(footer).style(Style::default().fg(Color::DarkGray)), rows[2]);
+}
+
+fn header(cells: &[&'static str]) -> Row<'static> {
+    Row::new(cells.to_vec()).style(Style::default().add_modifier(Modifier::BOLD))
+}
+
+fn draw_tunnels(f: &mut Frame, area: Rect, dash: &Dashboard) {
+    let up = dash.tunnels.iter().filter(|t| t.status == "up").count();
+    let rows = dash.tunnels.iter().map(|t| {
+        let color = match t.status.as_str() {
+            "up" => Color::Green,
+            "down" => Color::Red,
+            _ => Color::Yellow,
+        };
+        Row::new(vec![
+            Cell::from(t.site.clone()),
+            Cell::from(t.pop.clone()),
+            Cell::from(t.status.clone()).style(Style::default().fg(color)),
+            Cell::from(format!("{:.1}", t.latency_ms)),
+            Cell::from(format!("{:.2}", t.loss_pct)),
+        ])
+    });
+    let table = Table::new(
+        rows,
+        [Constraint::Percentage(30), Constraint::Percentage(25), Constraint::Length(8), Constraint::Length(10), Constraint::Length(8)],
+    )
+    .header(header(&["SITE", "POP", "STATUS", "RTT ms", "LOSS %"]))
+    .block(Block::default().borders(Borders::ALL).title(format!(" Tunnels {}/{} up ", up, dash.tunnels.len())));
+    f.render_widget(table, area);
+}
+
+fn draw_apps(f: &mut Frame, area: Rect, dash: &Dashboard) {
+    let rows = dash.apps.iter().map(|a| {
+        Row::new(vec![a.name.clone(), human_bytes(a.bytes), a.sessions.to_string()])
+    });
+    let table = Table::new(rows, [Constraint::Percentage(50), Constraint::Length(10), Constraint::Length(9)])
+        .header(header(&["APP", "BYTES", "SESSIONS"]))
+        .block(Block::default().borders(Borders::ALL).title(" Top applications (5m) "));
+    f.render_widget(table, area);
+}
+
+fn draw_alerts(f: &mut Frame, area: Rect, dash: &Dashboard) {
+    let rows = dash.alerts.iter().map(|a| {
+        let color = match a.severity.as_str() {
+            "critical" => Color::Red,
+            "high" => Color::LightRed,
+            "medium" => Color::Yellow,
+            _ => Color::Reset,
+        };
+        Row::new(vec![
+            Cell::from(a.severity.clone()).style(Style::default().fg(color)),
+            Cell::from(a.title.clone()),
+            Cell::from(a.status.clone()),
+        ])
+    });
+    let table = Table::new(rows, [Constraint::Length(9), Constraint::Min(20), Constraint::Length(12)])
+        .header(header(&["SEVERITY", "TITLE", "STATUS"]))
+        .block(Block::default().borders(Borders::ALL).title(format!(" Open alerts ({}) ", dash.alerts.len())));
+    f.render_widget(table, area);
+}
+
+fn draw_violations(f: &mut Frame, area: Rect, dash: &Dashboard) {
+    let rows = dash.violations.iter().map(|v| {
+        Row::new(vec![
+            v.site.clone(),
+            v.metric.clone(),
+            format!("{:.2}", v.value),
+            format!("{:.2}", v.threshold),
+        ])
+    });
+    let table = Table::new(rows, [Constraint::Percentage(35), Constraint::Percentage(25), Constraint::Length(10), Constraint::Length(10)])
+        .header(header(&["SITE", "METRIC", "VALUE", "THRESHOLD"]))
+        .block(Block::default().borders(Borders::ALL).title(" SLA violations (1h) "));
+    f.render_widget(table, area);
+}
+
+fn human_bytes(n: u64) -> String {
+    const UNITS: [&str; 5] = ["B", "KB", "MB", "GB", "TB"];
+    let mut v = n as f64;
+    let mut unit = 0;
+    while v >= 1024.0 && unit < UNITS.len() - 1 {
+        v /= 1024.0;
+        unit += 1;
+    }
+    format!("{:.1} {}", v, UNITS[unit])
+}
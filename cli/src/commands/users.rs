@@ -1,7 +1,8 @@
 //! Users commands
 
-use crate::{UserCommands, output::OutputFormat};
+use crate::{UserCommands, output::Output};
 use super::ApiClient;
+use super::bulk::{self, Record};
 use serde::{Deserialize, Serialize};
 
 #[derive(Debug, Serialize, Deserialize)]
@@ -17,7 +18,7 @@ pub struct PaginatedUsers {
     pub items: Vec<User>,
 }
 
-pub async fn handle(action: UserCommands, client: &ApiClient, format: OutputFormat) -> Result<(), String> {
+pub async fn handle(action: UserCommands, client: &ApiClient, out: &Output) -> Result<(), String> {
     match action {
         UserCommands::List { role } => {
             let path = match role {
@@ -25,17 +26,33 @@ pub async fn handle(action: UserCommands, client: &ApiClient, format: OutputForm
                 None => "/users".to_string(),
             };
             let users: PaginatedUsers = client.get(&path).await?;
-            format.print(&users.items);
+            out.print(&users.items)?;
         }
         UserCommands::Get { id } => {
             let user: User = client.get(&format!("/users/{}", id)).await?;
-            format.print(&user);
+            out.print(&user)?;
         }
-        UserCommands::Create { email, name, role } => {
+        UserCommands::Create { bulk, .. } if bulk.from_csv.is_some() => {
+            bulk::run(client, "/users", &bulk, user_from_row).await?;
+        }
+        UserCommands::Create { email, name, role, bulk } => {
             let body = serde_json::json!({ "email": email, "name": name, "role": role });
-            let user: User = client.post("/users", &body).await?;
-            println!("Created user: {}", user.id);
+            if bulk.dry_run {
+                bulk::validate(client, "/users", &body).await?;
+            } else {
+                let user: User = client.post("/users", &body).await?;
+                println!("Created user: {}", user.id);
+            }
         }
     }
     Ok(())
 }
+
+/// Columns: email, name, role (default viewer)
+fn user_from_row(row: &Record) -> Result<serde_json::Value, String> {
+    Ok(serde_json::json!({
+        "email": bulk::required(row, "email")?,
+        "name": bulk::required(row, "name")?,
+        "role": bulk::optional(row, "role").unwrap_or("viewer"),
+    }))
+}
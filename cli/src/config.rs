@@ -4,12 +4,27 @@ use serde::{Deserialize, Serialize};
 use std::fs;
 use std::path::PathBuf;
 
+/// Keychain service name under which credentials are stored
+const KEYCHAIN_SERVICE: &str = "opensase-cli";
+const DEFAULT_PROFILE: &str = "default";
+
 #[derive(Debug, Default, Serialize, Deserialize)]
 pub struct Config {
     pub api_url: Option<String>,
+    /// Legacy plaintext key; new logins store credentials in the OS keychain
     pub api_key: Option<String>,
     pub tenant_id: Option<String>,
     pub default_format: Option<String>,
+    /// Profile used when --profile is not given (only read from config.toml)
+    pub current_profile: Option<String>,
+}
+
+/// Credential stored in the OS keychain for a profile
+#[derive(Debug, Serialize, Deserialize)]
+#[serde(tag = "kind", rename_all = "snake_case")]
+pub enum Credential {
+    ApiKey { api_key: String },
+    OAuth { refresh_token: String },
 }
 
 impl Config {
@@ -24,7 +39,11 @@ impl Config {
     }
 
     pub fn save(&self) -> Result<(), String> {
-        let path = Self::config_path(None)?;
+        self.save_profile(None)
+    }
+
+    pub fn save_profile(&self, profile: Option<&str>) -> Result<(), String> {
+        let path = Self::config_path(profile)?;
         if let Some(parent) = path.parent() {
             fs::create_dir_all(parent).map_err(|e| e.to_string())?;
         }
@@ -32,12 +51,66 @@ impl Config {
         fs::write(&path, content).map_err(|e| e.to_string())
     }
 
+    /// The explicitly requested profile, else the one selected with `auth switch`
+    pub fn active_profile(explicit: Option<&str>) -> Option<String> {
+        explicit
+            .map(String::from)
+            .or_else(|| Self::load(None).ok().and_then(|c| c.current_profile))
+    }
+
+    /// Names of all profiles that have a config file
+    pub fn profiles() -> Result<Vec<String>, String> {
+        let dir = Self::config_path(None)?.parent().map(PathBuf::from).ok_or("Invalid config path")?;
+        let mut names = vec![DEFAULT_PROFILE.to_string()];
+        if let Ok(entries) = fs::read_dir(dir) {
+            for entry in entries.flatten() {
+                let file = entry.file_name().to_string_lossy().into_owned();
+                if let Some(name) = file.strip_prefix("config.").and_then(|f| f.strip_suffix(".toml")) {
+                    names.push(name.to_string());
+                }
+            }
+        }
+        names.sort();
+        names.dedup();
+        Ok(names)
+    }
+
     fn config_path(profile: Option<&str>) -> Result<PathBuf, String> {
         let home = dirs::home_dir().ok_or("Cannot find home directory")?;
         let filename = match profile {
-            Some(p) => format!("config.{}.toml", p),
-            None => "config.toml".to_string(),
+            Some(p) if p != DEFAULT_PROFILE => format!("config.{}.toml", p),
+            _ => "config.toml".to_string(),
         };
         Ok(home.join(".opensase").join(filename))
     }
 }
+
+impl Credential {
+    /// Loads the credential for a profile; a missing entry is not an error
+    pub fn load(profile: Option<&str>) -> Result<Option<Self>, String> {
+        match Self::entry(profile)?.get_password() {
+            Ok(secret) => serde_json::from_str(&secret).map(Some).map_err(|e| e.to_string()),
+            Err(keyring::Error::NoEntry) => Ok(None),
+            Err(e) => Err(format!("keychain: {}", e)),
+        }
+    }
+
+    pub fn store(&self, profile: Option<&str>) -> Result<(), String> {
+        let secret = serde_json::to_string(self).map_err(|e| e.to_string())?;
+        Self::entry(profile)?
+            .set_password(&secret)
+            .map_err(|e| format!("keychain: {}", e))
+    }
+
+    pub fn delete(profile: Option<&str>) -> Result<(), String> {
+        match Self::entry(profile)?.delete_password() {
+            Ok(()) | Err(keyring::Error::NoEntry) => Ok(()),
+            Err(e) => Err(format!("keychain: {}", e)),
+        }
+    }
+
+    fn entry(profile: Option<&str>) -> Result<keyring::Entry, String> {
+        keyring::Entry::new(KEYCHAIN_SERVICE, profile.unwrap_or(DEFAULT_PROFILE))
+            .map_err(|e| format!("keychain: {}", e))
+    }
+}
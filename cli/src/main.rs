@@ -10,23 +10,33 @@
 //! opensase policies apply -f policy.yaml
 //! opensase alerts list --severity critical
 //! opensase users list --format json
+//! opensase sites list --output go-template --template '{{.id}}\t{{.name}}'
+//! opensase alerts list --query "[?severity=='critical'].id" -o json
+//! opensase users create --from-csv users.csv --concurrency 8 --dry-run
+//! opensase --profile prod-eu auth login
+//! opensase auth switch prod-eu
+//! opensase top
+//! source <(opensase completion bash)
 //! ```
 
-use clap::{Parser, Subcommand};
+use clap::{CommandFactory, Parser, Subcommand};
 
 mod commands;
 mod config;
 mod output;
 
+/// API endpoint used when neither --api-url nor the profile sets one
+const DEFAULT_API_URL: &str = "https://api.opensase.io/v1";
+
 #[derive(Parser)]
 #[command(name = "opensase")]
 #[command(author = "OpenSASE")]
 #[command(version = "0.1.0")]
 #[command(about = "OpenSASE Command Line Interface", long_about = None)]
 struct Cli {
-    /// API endpoint URL
-    #[arg(long, env = "OPENSASE_API_URL", default_value = "https://api.opensase.io/v1")]
-    api_url: String,
+    /// API endpoint URL; defaults to the profile's, else https://api.opensase.io/v1
+    #[arg(long, env = "OPENSASE_API_URL")]
+    api_url: Option<String>,
 
     /// API key for authentication
     #[arg(long, env = "OPENSASE_API_KEY")]
@@ -36,12 +46,26 @@ struct Cli {
     #[arg(long, env = "OPENSASE_TENANT_ID")]
     tenant_id: Option<String>,
 
-    /// Output format
-    #[arg(long, short, default_value = "table")]
-    format: output::OutputFormat,
-
-    /// Profile name from config file
+    /// Output format [default: table]
     #[arg(long, short)]
+    format: Option<output::OutputFormat>,
+
+    // Separate from --format because a global -f would clash with the file
+    // flag of `policies apply`
+    /// Output format; unlike --format it may follow the subcommand
+    #[arg(long, short, global = true)]
+    output: Option<output::OutputFormat>,
+
+    /// Template for --output go-template, rendered once per item, e.g. '{{.id}}\t{{.name}}'
+    #[arg(long, global = true)]
+    template: Option<String>,
+
+    /// JMESPath expression applied before formatting, e.g. "[?status=='online'].name"
+    #[arg(long, short, global = true)]
+    query: Option<String>,
+
+    /// Profile name; defaults to the one selected with `auth switch`
+    #[arg(long, short, global = true)]
     profile: Option<String>,
 
     #[command(subcommand)]
@@ -60,6 +84,16 @@ enum Commands {
         #[command(subcommand)]
         action: UserCommands,
     },
+    /// Manage CRM contacts
+    Contacts {
+        #[command(subcommand)]
+        action: ContactCommands,
+    },
+    /// Manage address objects
+    Addresses {
+        #[command(subcommand)]
+        action: AddressCommands,
+    },
     /// Manage policies
     Policies {
         #[command(subcommand)]
@@ -75,11 +109,31 @@ enum Commands {
         #[command(subcommand)]
         action: AnalyticsCommands,
     },
+    /// Live dashboard of tunnels, top applications, alerts and SLA violations
+    Top {
+        /// Seconds between full snapshot refreshes
+        #[arg(long, default_value_t = 30)]
+        refresh: u64,
+    },
+    /// Manage credentials and profiles
+    Auth {
+        #[command(subcommand)]
+        action: AuthCommands,
+    },
     /// Configure CLI
     Config {
         #[command(subcommand)]
         action: ConfigCommands,
     },
+    /// Print a shell completion script, e.g. `source <(opensase completion bash)`
+    Completion {
+        shell: clap_complete::Shell,
+    },
+    /// Print cached IDs for shell completion
+    #[command(name = "__complete", hide = true)]
+    Complete {
+        kind: commands::completion::CompletionKind,
+    },
 }
 
 #[derive(Subcommand)]
@@ -88,12 +142,14 @@ enum SiteCommands {
     List,
     /// Get site details
     Get { id: String },
-    /// Create a new site
+    /// Create a new site, or one per CSV row with --from-csv
     Create {
-        #[arg(long)]
-        name: String,
-        #[arg(long)]
-        location: String,
+        #[arg(long, required_unless_present = "from_csv")]
+        name: Option<String>,
+        #[arg(long, required_unless_present = "from_csv")]
+        location: Option<String>,
+        #[command(flatten)]
+        bulk: commands::bulk::BulkArgs,
     },
 }
 
@@ -106,14 +162,58 @@ enum UserCommands {
     },
     /// Get user details
     Get { id: String },
-    /// Create a new user
+    /// Create a new user, or one per CSV row with --from-csv
     Create {
-        #[arg(long)]
-        email: String,
-        #[arg(long)]
-        name: String,
+        #[arg(long, required_unless_present = "from_csv")]
+        email: Option<String>,
+        #[arg(long, required_unless_present = "from_csv")]
+        name: Option<String>,
         #[arg(long, default_value = "viewer")]
         role: String,
+        #[command(flatten)]
+        bulk: commands::bulk::BulkArgs,
+    },
+}
+
+#[derive(Subcommand)]
+enum ContactCommands {
+    /// List contacts
+    List {
+        #[arg(long)]
+        search: Option<String>,
+    },
+    /// Create a new contact, or one per CSV row with --from-csv
+    Create {
+        #[arg(long, required_unless_present = "from_csv")]
+        email: Option<String>,
+        #[arg(long)]
+        first_name: Option<String>,
+        #[arg(long)]
+        last_name: Option<String>,
+        #[arg(long)]
+        company: Option<String>,
+        #[command(flatten)]
+        bulk: commands::bulk::BulkArgs,
+    },
+}
+
+#[derive(Subcommand)]
+enum AddressCommands {
+    /// List address objects
+    List,
+    /// Create a new address object, or one per CSV row with --from-csv
+    Create {
+        #[arg(long, required_unless_present = "from_csv")]
+        name: Option<String>,
+        /// ip, cidr, range or fqdn
+        #[arg(long = "type", default_value = "cidr")]
+        kind: String,
+        #[arg(long, required_unless_present = "from_csv")]
+        value: Option<String>,
+        #[arg(long)]
+        description: Option<String>,
+        #[command(flatten)]
+        bulk: commands::bulk::BulkArgs,
     },
 }
 
@@ -161,6 +261,29 @@ enum AnalyticsCommands {
     },
 }
 
+#[derive(Subcommand)]
+enum AuthCommands {
+    /// Store credentials for the profile in the OS keychain
+    Login {
+        /// API key; prompted for when neither this nor --refresh-token is given
+        #[arg(long, conflicts_with = "refresh_token")]
+        api_key: Option<String>,
+        /// OAuth refresh token
+        #[arg(long)]
+        refresh_token: Option<String>,
+        #[arg(long)]
+        api_url: Option<String>,
+        #[arg(long)]
+        tenant_id: Option<String>,
+    },
+    /// Remove the profile's credentials from the keychain
+    Logout,
+    /// Make a profile the default
+    Switch { profile: String },
+    /// List profiles
+    List,
+}
+
 #[derive(Subcommand)]
 enum ConfigCommands {
     /// Set configuration value
@@ -177,19 +300,43 @@ enum ConfigCommands {
 async fn main() {
     let cli = Cli::parse();
     
-    let config = config::Config::load(cli.profile.as_deref()).unwrap_or_default();
-    let api_key = cli.api_key.or(config.api_key);
+    let profile = config::Config::active_profile(cli.profile.as_deref());
+    let config = config::Config::load(profile.as_deref()).unwrap_or_default();
+    let api_url = cli.api_url.or(config.api_url).unwrap_or_else(|| DEFAULT_API_URL.to_string());
+    let mut api_key = cli.api_key.or(config.api_key);
     let tenant_id = cli.tenant_id.or(config.tenant_id);
+
+    let needs_api = !matches!(cli.command, Commands::Auth { .. } | Commands::Config { .. } | Commands::Completion { .. });
+    if api_key.is_none() && needs_api {
+        match commands::auth::bearer_token(&api_url, profile.as_deref()).await {
+            Ok(token) => api_key = token,
+            Err(e) => eprintln!("Warning: {}", e),
+        }
+    }
     
-    let client = commands::ApiClient::new(&cli.api_url, api_key.as_deref(), tenant_id.as_deref());
+    let client = commands::ApiClient::new(&api_url, api_key.as_deref(), tenant_id.as_deref());
+    let format = cli.output.or(cli.format).unwrap_or(output::OutputFormat::Table);
+    let out = match output::Output::new(format, cli.template, cli.query.as_deref()) {
+        Ok(out) => out,
+        Err(e) => {
+            eprintln!("Error: {}", e);
+            std::process::exit(2);
+        }
+    };
     
     let result = match cli.command {
-        Commands::Sites { action } => commands::sites::handle(action, &client, cli.format).await,
-        Commands::Users { action } => commands::users::handle(action, &client, cli.format).await,
-        Commands::Policies { action } => commands::policies::handle(action, &client, cli.format).await,
-        Commands::Alerts { action } => commands::alerts::handle(action, &client, cli.format).await,
-        Commands::Analytics { action } => commands::analytics::handle(action, &client, cli.format).await,
-        Commands::Config { action } => commands::config::handle(action).await,
+        Commands::Sites { action } => commands::sites::handle(action, &client, &out).await,
+        Commands::Users { action } => commands::users::handle(action, &client, &out).await,
+        Commands::Contacts { action } => commands::contacts::handle(action, &client, &out).await,
+        Commands::Addresses { action } => commands::addresses::handle(action, &client, &out).await,
+        Commands::Policies { action } => commands::policies::handle(action, &client, &out).await,
+        Commands::Alerts { action } => commands::alerts::handle(action, &client, &out).await,
+        Commands::Analytics { action } => commands::analytics::handle(action, &client, &out).await,
+        Commands::Top { refresh } => commands::top::run(&client, refresh).await,
+        Commands::Auth { action } => commands::auth::handle(action, profile.as_deref()).await,
+        Commands::Config { action } => commands::config::handle(action, profile.as_deref()).await,
+        Commands::Completion { shell } => commands::completion::print(shell, &mut Cli::command()),
+        Commands::Complete { kind } => commands::completion::complete(kind, &client, profile.as_deref()).await,
     };
 
     if let Err(e) = result {
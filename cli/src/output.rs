@@ -1,28 +1,140 @@
 //! Output formatting
 
 use serde::Serialize;
+use serde_json::Value;
 use clap::ValueEnum;
+use tabled::builder::Builder;
+use tabled::settings::Style;
 
 #[derive(Debug, Clone, Copy, ValueEnum)]
 pub enum OutputFormat {
     Table,
     Json,
     Yaml,
+    /// Go-style template given by --template
+    GoTemplate,
 }
 
-impl OutputFormat {
-    pub fn print<T: Serialize>(&self, data: &T) {
-        match self {
+/// Output settings for a command
+pub struct Output {
+    pub format: OutputFormat,
+    pub template: Option<String>,
+    pub query: Option<jmespath::Expression<'static>>,
+}
+
+impl Output {
+    pub fn new(format: OutputFormat, template: Option<String>, query: Option<&str>) -> Result<Self, String> {
+        if matches!(format, OutputFormat::GoTemplate) && template.is_none() {
+            return Err("--output go-template requires --template".into());
+        }
+        let query = match query {
+            Some(q) => Some(jmespath::compile(q).map_err(|e| format!("invalid --query: {}", e))?),
+            None => None,
+        };
+        Ok(Self { format, template, query })
+    }
+
+    pub fn print<T: Serialize>(&self, data: &T) -> Result<(), String> {
+        let mut value = serde_json::to_value(data).map_err(|e| e.to_string())?;
+        if let Some(expr) = &self.query {
+            let result = expr.search(value.clone()).map_err(|e| format!("query failed: {}", e))?;
+            value = serde_json::to_value(&*result).map_err(|e| e.to_string())?;
+        }
+
+        match self.format {
             OutputFormat::Json => {
-                println!("{}", serde_json::to_string_pretty(data).unwrap_or_default());
+                println!("{}", serde_json::to_string_pretty(&value).unwrap_or_default());
             }
             OutputFormat::Yaml => {
-                println!("{}", serde_yaml::to_string(data).unwrap_or_default());
+                println!("{}", serde_yaml::to_string(&value).unwrap_or_default());
             }
-            OutputFormat::Table => {
-                // For table, just print JSON nicely for now
-                println!("{}", serde_json::to_string_pretty(data).unwrap_or_default());
+            OutputFormat::Table => print_table(&value),
+            OutputFormat::GoTemplate => {
+                let template = self.template.as_deref().unwrap_or_default();
+                match &value {
+                    Value::Array(items) => {
+                        for item in items {
+                            println!("{}", render(template, item));
+                        }
+                    }
+                    v => println!("{}", render(template, v)),
+                }
             }
         }
+        Ok(())
+    }
+}
+
+/// Prints arrays of objects as rows with one column per key of the first
+/// row; anything else is printed as pretty JSON.
+fn print_table(value: &Value) {
+    let rows: Vec<&Value> = match value {
+        Value::Array(items) if items.iter().all(Value::is_object) => items.iter().collect(),
+        Value::Object(_) => vec![value],
+        _ => {
+            println!("{}", serde_json::to_string_pretty(value).unwrap_or_default());
+            return;
+        }
+    };
+    let columns: Vec<String> = match rows.first().and_then(|r| r.as_object()) {
+        Some(obj) => obj.keys().cloned().collect(),
+        None => return,
+    };
+
+    let mut builder = Builder::default();
+    builder.push_record(columns.iter().map(|c| c.to_uppercase()));
+    for row in rows {
+        builder.push_record(columns.iter().map(|c| cell(row.get(c).unwrap_or(&Value::Null))));
+    }
+    let mut table = builder.build();
+    table.with(Style::blank());
+    println!("{}", table);
+}
+
+fn cell(value: &Value) -> String {
+    match value {
+        Value::Null => String::new(),
+        Value::String(s) => s.clone(),
+        v => v.to_string(),
+    }
+}
+
+/// Renders a template with `{{.field}}`, `{{.nested.field}}`, `{{.}}` and
+/// `{{json .field}}` actions; `\t` and `\n` are expanded.
+fn render(template: &str, value: &Value) -> String {
+    let template = template.replace("\\t", "\t").replace("\\n", "\n");
+    let mut out = String::new();
+    let mut rest = template.as_str();
+    while let Some(start) = rest.find("{{") {
+        out.push_str(&rest[..start]);
+        let Some(end) = rest[start..].find("}}") else {
+            out.push_str(&rest[start..]);
+            return out;
+        };
+        let action = rest[start + 2..start + end].trim();
+        let (as_json, path) = match action.strip_prefix("json ") {
+            Some(p) => (true, p.trim()),
+            None => (false, action),
+        };
+        let found = lookup(value, path).unwrap_or(&Value::Null);
+        if as_json {
+            out.push_str(&found.to_string());
+        } else {
+            out.push_str(&cell(found));
+        }
+        rest = &rest[start + end + 2..];
+    }
+    out.push_str(rest);
+    out
+}
+
+fn lookup<'a>(value: &'a Value, path: &str) -> Option<&'a Value> {
+    let path = path.strip_prefix('.')?;
+    if path.is_empty() {
+        return Some(value);
     }
+    path.split('.').try_fold(value, |v, key| match v {
+        Value::Array(items) => key.parse::<usize>().ok().and_then(|i| items.get(i)),
+        _ => v.get(key),
+    })
 }
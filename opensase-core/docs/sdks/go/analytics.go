@@ -0,0 +1,208 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Analytics Service
+// =============================================================================
+
+// Anomaly detector types
+const (
+	DetectorTrafficVolume    = "traffic_volume"
+	DetectorImpossibleTravel = "impossible_travel"
+	DetectorDataExfiltration = "data_exfiltration"
+)
+
+// Anomaly feedback verdicts
+const (
+	VerdictTruePositive  = "true_positive"
+	VerdictFalsePositive = "false_positive"
+)
+
+// AnalyticsService provides access to analytics APIs
+type AnalyticsService struct {
+	client    *Client
+	Anomalies *AnomaliesService
+}
+
+// AnomaliesService provides access to anomaly detection APIs
+type AnomaliesService struct {
+	client *Client
+}
+
+// AnomalyDetector is the configuration of a single anomaly detector
+type AnomalyDetector struct {
+	Type            string             `json:"type"`
+	Enabled         bool               `json:"enabled"`
+	Sensitivity     string             `json:"sensitivity"`
+	MinConfidence   float64            `json:"min_confidence"`
+	Thresholds      map[string]float64 `json:"thresholds,omitempty"`
+	ExcludedUserIDs []string           `json:"excluded_user_ids,omitempty"`
+	ExcludedSiteIDs []string           `json:"excluded_site_ids,omitempty"`
+	ModelVersion    string             `json:"model_version,omitempty"`
+	UpdatedAt       *time.Time         `json:"updated_at,omitempty"`
+}
+
+// UpdateDetectorParams contains parameters for enabling or tuning a detector
+type UpdateDetectorParams struct {
+	Enabled         *bool              `json:"enabled,omitempty"`
+	Sensitivity     *string            `json:"sensitivity,omitempty"`
+	MinConfidence   *float64           `json:"min_confidence,omitempty"`
+	Thresholds      map[string]float64 `json:"thresholds,omitempty"`
+	ExcludedUserIDs []string           `json:"excluded_user_ids,omitempty"`
+	ExcludedSiteIDs []string           `json:"excluded_site_ids,omitempty"`
+}
+
+// Anomaly is a single detected anomaly
+type Anomaly struct {
+	ID           string                 `json:"id"`
+	DetectorType string                 `json:"detector_type"`
+	Severity     string                 `json:"severity"`
+	Confidence   float64                `json:"confidence"`
+	Summary      string                 `json:"summary"`
+	UserID       string                 `json:"user_id,omitempty"`
+	SiteID       string                 `json:"site_id,omitempty"`
+	Evidence     map[string]interface{} `json:"evidence,omitempty"`
+	Verdict      string                 `json:"verdict,omitempty"`
+	StartedAt    time.Time              `json:"started_at"`
+	EndedAt      *time.Time             `json:"ended_at,omitempty"`
+	DetectedAt   time.Time              `json:"detected_at"`
+}
+
+// ListAnomaliesParams contains parameters for listing detected anomalies
+type ListAnomaliesParams struct {
+	Limit         int        `json:"limit,omitempty"`
+	Cursor        string     `json:"cursor,omitempty"`
+	DetectorType  *string    `json:"detector_type,omitempty"`
+	MinConfidence *float64   `json:"min_confidence,omitempty"`
+	UserID        *string    `json:"user_id,omitempty"`
+	SiteID        *string    `json:"site_id,omitempty"`
+	Since         *time.Time `json:"since,omitempty"`
+	Until         *time.Time `json:"until,omitempty"`
+}
+
+// AnomalyListResponse contains a list of anomalies with cursor pagination
+type AnomalyListResponse struct {
+	Data       []Anomaly        `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// ListDetectors retrieves the configuration of all anomaly detectors
+func (s *AnomaliesService) ListDetectors(ctx context.Context) ([]AnomalyDetector, error) {
+	data, err := s.client.get(ctx, "/analytics/anomalies/detectors", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var detectors []AnomalyDetector
+	if err := s.client.decode(data, &detectors); err != nil {
+		return nil, err
+	}
+
+	return detectors, nil
+}
+
+// UpdateDetector enables, disables or tunes an anomaly detector
+func (s *AnomaliesService) UpdateDetector(ctx context.Context, detectorType string, params *UpdateDetectorParams) (*AnomalyDetector, error) {
+	data, err := s.client.patch(ctx, "/analytics/anomalies/detectors/"+detectorType, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var detector AnomalyDetector
+	if err := s.client.decode(data, &detector); err != nil {
+		return nil, err
+	}
+
+	return &detector, nil
+}
+
+// List retrieves detected anomalies, most recent first
+func (s *AnomaliesService) List(ctx context.Context, params *ListAnomaliesParams) (*AnomalyListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+		if params.DetectorType != nil {
+			v.Set("detector_type", *params.DetectorType)
+		}
+		if params.MinConfidence != nil {
+			v.Set("min_confidence", strconv.FormatFloat(*params.MinConfidence, 'f', -1, 64))
+		}
+		if params.UserID != nil {
+			v.Set("user_id", *params.UserID)
+		}
+		if params.SiteID != nil {
+			v.Set("site_id", *params.SiteID)
+		}
+		if params.Since != nil {
+			v.Set("since", params.Since.UTC().Format(time.RFC3339))
+		}
+		if params.Until != nil {
+			v.Set("until", params.Until.UTC().Format(time.RFC3339))
+		}
+	}
+
+	data, err := s.client.get(ctx, "/analytics/anomalies", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response AnomalyListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves an anomaly by ID
+func (s *AnomaliesService) Get(ctx context.Context, anomalyID string) (*Anomaly, error) {
+	data, err := s.client.get(ctx, "/analytics/anomalies/"+anomalyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var anomaly Anomaly
+	if err := s.client.decode(data, &anomaly); err != nil {
+		return nil, err
+	}
+
+	return &anomaly, nil
+}
+
+// SubmitFeedback marks an anomaly as a true or false positive to train the detector
+func (s *AnomaliesService) SubmitFeedback(ctx context.Context, anomalyID, verdict, comment string) (*Anomaly, error) {
+	if verdict != VerdictTruePositive && verdict != VerdictFalsePositive {
+		return nil, fmt.Errorf("opensase: unknown anomaly verdict %q", verdict)
+	}
+
+	params := map[string]interface{}{
+		"verdict": verdict,
+	}
+	if comment != "" {
+		params["comment"] = comment
+	}
+
+	data, err := s.client.post(ctx, "/analytics/anomalies/"+anomalyID+"/feedback", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var anomaly Anomaly
+	if err := s.client.decode(data, &anomaly); err != nil {
+		return nil, err
+	}
+
+	return &anomaly, nil
+}
@@ -0,0 +1,115 @@
+package opensase
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// =============================================================================
+// Per-call Options
+// =============================================================================
+
+const (
+	// DryRunHeader asks the platform to validate a mutating request without committing it
+	DryRunHeader = "X-OpenSASE-Dry-Run"
+
+	// ActingUserHeader names the end user a request is made on behalf of
+	ActingUserHeader = "X-OpenSASE-Acting-User"
+)
+
+type callOptionsKey struct{}
+
+// callOptions are request modifiers carried on the context, so they apply to
+// every service method without changing its signature
+type callOptions struct {
+	dryRun      bool
+	priority    Priority
+	prioritySet bool
+	asOf        *time.Time
+	actingUser  string
+	tenantID    string
+}
+
+func withCallOptions(ctx context.Context, fn func(*callOptions)) context.Context {
+	o := callOptionsFrom(ctx)
+	fn(&o)
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+func callOptionsFrom(ctx context.Context) callOptions {
+	o, _ := ctx.Value(callOptionsKey{}).(callOptions)
+	return o
+}
+
+// WithDryRun returns a context under which mutating requests are validated
+// (schema, quota, permissions) but not committed. Read requests are unaffected.
+//
+//	_, err := client.Identity.Users.Create(opensase.WithDryRun(ctx), params)
+func WithDryRun(ctx context.Context) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) {
+		o.dryRun = true
+	})
+}
+
+// IsDryRun reports whether ctx carries the dry-run option
+func IsDryRun(ctx context.Context) bool {
+	return callOptionsFrom(ctx).dryRun
+}
+
+// WithActingUser returns a context under which requests are made on behalf of
+// the given end user. The platform checks the request against both the
+// caller's and the user's permissions and attributes it to the user in the
+// audit log through ChangeActor.OnBehalfOf. Only credentials granted the
+// impersonation scope may use it; others receive a forbidden error.
+//
+//	ctx = opensase.WithActingUser(ctx, ticket.RequesterID)
+//	_, err := client.Identity.Users.Update(ctx, userID, params)
+func WithActingUser(ctx context.Context, userID string) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) {
+		o.actingUser = userID
+	})
+}
+
+// ActingUser returns the user ctx acts on behalf of, if any
+func ActingUser(ctx context.Context) (string, bool) {
+	u := callOptionsFrom(ctx).actingUser
+	return u, u != ""
+}
+
+// WithTargetTenant returns a context under which requests are made in the
+// given tenant instead of the client's, for managed service providers
+// working in a child tenant with the parent's credentials.
+//
+//	ctx = opensase.WithTargetTenant(ctx, child.ID)
+//	_, err := client.Network.Sites.Create(ctx, params)
+func WithTargetTenant(ctx context.Context, tenantID string) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) {
+		o.tenantID = tenantID
+	})
+}
+
+// TargetTenant returns the tenant ctx targets, if any
+func TargetTenant(ctx context.Context) (string, bool) {
+	t := callOptionsFrom(ctx).tenantID
+	return t, t != ""
+}
+
+func (o callOptions) requestPriority() Priority {
+	if !o.prioritySet {
+		return PriorityNormal
+	}
+	return o.priority
+}
+
+func (o callOptions) apply(req *http.Request) {
+	if o.dryRun && req.Method != http.MethodGet {
+		req.Header.Set(DryRunHeader, "true")
+	}
+	if o.actingUser != "" {
+		req.Header.Set(ActingUserHeader, o.actingUser)
+	}
+	if o.tenantID != "" {
+		req.Header.Set("X-Tenant-ID", o.tenantID)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// model maps an OpenAPI component schema to the SDK type that carries it
+type model struct {
+	Schema string
+	// Property, if set, checks a nested object property of the schema instead
+	Property string
+	Type     reflect.Type
+	// Request models are sent to the API: undocumented fields are errors and
+	// only required properties must be present
+	Request bool
+}
+
+var models = []model{
+	{Schema: "Error", Property: "error", Type: reflect.TypeOf(opensase.Error{})},
+	{Schema: "ErrorDetail", Type: reflect.TypeOf(opensase.ErrorDetail{})},
+	{Schema: "Pagination", Type: reflect.TypeOf(opensase.Pagination{})},
+	{Schema: "CursorPagination", Type: reflect.TypeOf(opensase.CursorPagination{})},
+	{Schema: "Address", Type: reflect.TypeOf(opensase.Address{})},
+
+	{Schema: "User", Type: reflect.TypeOf(opensase.User{})},
+	{Schema: "UserProfile", Type: reflect.TypeOf(opensase.UserProfile{})},
+	{Schema: "GroupRef", Type: reflect.TypeOf(opensase.GroupRef{})},
+	{Schema: "Group", Type: reflect.TypeOf(opensase.Group{})},
+	{Schema: "MFASettings", Type: reflect.TypeOf(opensase.MFASettings{})},
+	{Schema: "LoginResponse", Type: reflect.TypeOf(opensase.LoginResponse{})},
+	{Schema: "DeviceAuthorization", Type: reflect.TypeOf(opensase.DeviceAuthorization{})},
+	{Schema: "UserCreate", Type: reflect.TypeOf(opensase.CreateUserParams{}), Request: true},
+	{Schema: "UserUpdate", Type: reflect.TypeOf(opensase.UpdateUserParams{}), Request: true},
+	{Schema: "GroupCreate", Type: reflect.TypeOf(opensase.CreateGroupParams{}), Request: true},
+	{Schema: "GroupUpdate", Type: reflect.TypeOf(opensase.UpdateGroupParams{}), Request: true},
+	{Schema: "LoginRequest", Type: reflect.TypeOf(opensase.LoginParams{}), Request: true},
+
+	{Schema: "Contact", Type: reflect.TypeOf(opensase.Contact{})},
+	{Schema: "Deal", Type: reflect.TypeOf(opensase.Deal{})},
+	{Schema: "ContactCreate", Type: reflect.TypeOf(opensase.CreateContactParams{}), Request: true},
+	{Schema: "DealCreate", Type: reflect.TypeOf(opensase.CreateDealParams{}), Request: true},
+
+	{Schema: "PaymentIntent", Type: reflect.TypeOf(opensase.PaymentIntent{})},
+	{Schema: "Refund", Type: reflect.TypeOf(opensase.Refund{})},
+	{Schema: "Subscription", Type: reflect.TypeOf(opensase.Subscription{})},
+	{Schema: "PaymentIntentCreate", Type: reflect.TypeOf(opensase.CreatePaymentIntentParams{}), Request: true},
+	{Schema: "RefundCreate", Type: reflect.TypeOf(opensase.CreateRefundParams{}), Request: true},
+	{Schema: "SubscriptionCreate", Type: reflect.TypeOf(opensase.CreateSubscriptionParams{}), Request: true},
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func checkModels(s *spec) []finding {
+	var findings []finding
+	for _, m := range models {
+		findings = append(findings, checkModel(s, m)...)
+	}
+	return findings
+}
+
+func checkModel(s *spec, m model) []finding {
+	name := m.Schema
+	schema, ok := s.Schemas[m.Schema]
+	if !ok {
+		return []finding{{
+			Key:     "schema:" + name + ":removed",
+			Message: fmt.Sprintf("%s: schema no longer documented (mapped to %s)", name, m.Type),
+		}}
+	}
+	if m.Property != "" {
+		name += "." + m.Property
+		props, _ := s.properties(schema)
+		schema = props[m.Property]
+	}
+
+	props, required := s.properties(schema)
+	fields := jsonFields(m.Type)
+
+	var findings []finding
+	for prop, ps := range props {
+		field, ok := fields[prop]
+		if !ok {
+			if m.Request && !required[prop] {
+				continue
+			}
+			findings = append(findings, finding{
+				Key:     "schema:" + name + "." + prop + ":missing",
+				Message: fmt.Sprintf("%s.%s: documented but missing from %s", name, prop, m.Type),
+			})
+			continue
+		}
+		if want, got, ok := typeMatches(s, ps, field.Type); !ok {
+			findings = append(findings, finding{
+				Key:     "schema:" + name + "." + prop + ":type",
+				Message: fmt.Sprintf("%s.%s: documented as %s but %s.%s is %s", name, prop, want, m.Type, field.Name, got),
+			})
+		}
+	}
+	for prop, field := range fields {
+		if _, ok := props[prop]; !ok {
+			findings = append(findings, finding{
+				Key:     "schema:" + name + "." + prop + ":undocumented",
+				Message: fmt.Sprintf("%s.%s: %s.%s is not documented", name, prop, m.Type, field.Name),
+			})
+		}
+	}
+	return findings
+}
+
+// jsonFields returns the struct fields of t keyed by JSON name
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+// typeMatches reports whether a Go type can carry values of a schema
+func typeMatches(s *spec, schema map[string]interface{}, t reflect.Type) (want, got string, ok bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	got = t.String()
+
+	if ref, isRef := schema["$ref"].(string); isRef {
+		target := s.Schemas[refName(ref)]
+		if target == nil {
+			return refName(ref), got, true
+		}
+		schema = target
+	}
+
+	typ, _ := schema["type"].(string)
+	format, _ := schema["format"].(string)
+	switch typ {
+	case "string":
+		if format == "date-time" {
+			return "date-time", got, t == timeType || t.Kind() == reflect.String
+		}
+		return "string", got, t.Kind() == reflect.String
+	case "integer":
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return "integer", got, true
+		}
+		return "integer", got, false
+	case "number":
+		switch t.Kind() {
+		case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int64:
+			return "number", got, true
+		}
+		return "number", got, false
+	case "boolean":
+		return "boolean", got, t.Kind() == reflect.Bool
+	case "array":
+		if t.Kind() != reflect.Slice {
+			return "array", got, false
+		}
+		items, _ := schema["items"].(map[string]interface{})
+		if items == nil {
+			return "array", got, true
+		}
+		w, _, ok := typeMatches(s, items, t.Elem())
+		return "array of " + w, got, ok
+	case "object", "":
+		switch t.Kind() {
+		case reflect.Struct, reflect.Map, reflect.Interface:
+			return "object", got, true
+		}
+		// Untyped schemas accept anything
+		return "object", got, typ == ""
+	}
+	return typ, got, true
+}
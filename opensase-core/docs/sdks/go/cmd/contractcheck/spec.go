@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// spec is the merged view of one or more OpenAPI documents
+type spec struct {
+	Schemas map[string]map[string]interface{}
+	// Paths maps a normalized path to its documented methods
+	Paths map[string]map[string]bool
+}
+
+func loadSpec(sources []string) (*spec, error) {
+	s := &spec{
+		Schemas: map[string]map[string]interface{}{},
+		Paths:   map[string]map[string]bool{},
+	}
+	for _, src := range sources {
+		data, err := readSource(src)
+		if err != nil {
+			return nil, err
+		}
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("%s: %w", src, err)
+		}
+		s.merge(doc)
+	}
+	return s, nil
+}
+
+func readSource(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.ReadFile(src)
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", src, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// merge adds a document. Full documents contribute components.schemas and
+// paths; path fragments (no "openapi" key) hold paths at the top level and
+// may carry extra schema maps under non-path keys.
+func (s *spec) merge(doc map[string]interface{}) {
+	if _, ok := doc["openapi"]; ok {
+		if components, ok := doc["components"].(map[string]interface{}); ok {
+			s.addSchemas(components["schemas"])
+		}
+		s.addPaths(doc["paths"])
+		return
+	}
+
+	paths := map[string]interface{}{}
+	for key, value := range doc {
+		if strings.HasPrefix(key, "/") {
+			paths[key] = value
+		} else {
+			s.addSchemas(value)
+		}
+	}
+	s.addPaths(paths)
+}
+
+func (s *spec) addSchemas(raw interface{}) {
+	schemas, _ := raw.(map[string]interface{})
+	for name, schema := range schemas {
+		if m, ok := schema.(map[string]interface{}); ok {
+			s.Schemas[name] = m
+		}
+	}
+}
+
+func (s *spec) addPaths(raw interface{}) {
+	paths, _ := raw.(map[string]interface{})
+	for path, item := range paths {
+		ops, _ := item.(map[string]interface{})
+		norm := normalizePath(path)
+		if s.Paths[norm] == nil {
+			s.Paths[norm] = map[string]bool{}
+		}
+		for method := range ops {
+			switch method {
+			case "get", "post", "put", "patch", "delete":
+				s.Paths[norm][strings.ToUpper(method)] = true
+			}
+		}
+	}
+}
+
+// properties returns the properties and required set of a schema, following
+// allOf composition
+func (s *spec) properties(schema map[string]interface{}) (map[string]map[string]interface{}, map[string]bool) {
+	props := map[string]map[string]interface{}{}
+	required := map[string]bool{}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if target, ok := s.Schemas[refName(ref)]; ok {
+			return s.properties(target)
+		}
+	}
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, part := range all {
+			if m, ok := part.(map[string]interface{}); ok {
+				p, r := s.properties(m)
+				for k, v := range p {
+					props[k] = v
+				}
+				for k := range r {
+					required[k] = true
+				}
+			}
+		}
+	}
+	if raw, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, p := range raw {
+			if m, ok := p.(map[string]interface{}); ok {
+				props[name] = m
+			}
+		}
+	}
+	if raw, ok := schema["required"].([]interface{}); ok {
+		for _, r := range raw {
+			if name, ok := r.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+	return props, required
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// normalizePath replaces path parameters so SDK and spec paths compare equal
+func normalizePath(path string) string {
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+	parts := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	for i, p := range parts {
+		if strings.HasPrefix(p, "{") {
+			parts[i] = "{}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
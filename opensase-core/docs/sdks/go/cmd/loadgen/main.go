@@ -0,0 +1,147 @@
+// Command loadgen drives load against the OpenSASE management API through the
+// SDK and reports per-operation latency and errors. It is meant for validating
+// tenant scale limits before large migrations, against a staging tenant.
+//
+//	OPENSASE_API_KEY=... loadgen -scenario policy-churn -concurrency 16 -duration 5m
+//	OPENSASE_API_KEY=... loadgen -scenario site-onboarding -ops 2000 -rate 50
+//	OPENSASE_API_KEY=... loadgen -scenario log-stream -concurrency 200 -duration 10m
+//
+// Scenarios that create objects delete them again unless -keep is set. All
+// objects are named with the run prefix so leftovers can be found after an
+// interrupted run.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// scenario runs one worker until ctx is done or ops are exhausted
+type scenario func(ctx context.Context, r *runner, worker int) error
+
+var scenarios = map[string]scenario{
+	"policy-churn":    policyChurn,
+	"site-onboarding": siteOnboarding,
+	"log-stream":      logStream,
+}
+
+type runner struct {
+	client *opensase.Client
+	stats  *stats
+	prefix string
+	keep   bool
+
+	ops    int64
+	issued int64
+}
+
+// next reserves one operation slot; it reports false once -ops is exhausted
+func (r *runner) next() bool {
+	if r.ops <= 0 {
+		return true
+	}
+	return atomic.AddInt64(&r.issued, 1) <= r.ops
+}
+
+// time records the latency and outcome of a single API call
+func (r *runner) time(op string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	r.stats.record(op, time.Since(start), err)
+	return err
+}
+
+func main() {
+	var (
+		name        = flag.String("scenario", "", "scenario to run: "+strings.Join(scenarioNames(), ", "))
+		concurrency = flag.Int("concurrency", 8, "number of concurrent workers")
+		duration    = flag.Duration("duration", time.Minute, "how long to run")
+		ops         = flag.Int64("ops", 0, "stop after this many operations (0 = until -duration)")
+		rate        = flag.Float64("rate", 0, "client-side request rate limit per second (0 = unlimited)")
+		baseURL     = flag.String("base-url", "", "API base URL (default: SDK default)")
+		tenant      = flag.String("tenant", os.Getenv("OPENSASE_TENANT_ID"), "tenant to run against")
+		keep        = flag.Bool("keep", false, "do not delete objects created by the run")
+		jsonOut     = flag.Bool("json", false, "print the report as JSON")
+	)
+	flag.Parse()
+
+	run, ok := scenarios[*name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "loadgen: unknown scenario %q (want one of %s)\n", *name, strings.Join(scenarioNames(), ", "))
+		os.Exit(2)
+	}
+	apiKey := os.Getenv("OPENSASE_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "loadgen: OPENSASE_API_KEY is not set")
+		os.Exit(2)
+	}
+
+	// Retries would hide the errors this tool exists to measure
+	opts := []opensase.ClientOption{opensase.WithMaxRetries(0)}
+	if *baseURL != "" {
+		opts = append(opts, opensase.WithBaseURL(*baseURL))
+	}
+	if *tenant != "" {
+		opts = append(opts, opensase.WithTenant(*tenant))
+	}
+	if *rate > 0 {
+		opts = append(opts, opensase.WithRateLimit(*rate, *concurrency))
+	}
+
+	r := &runner{
+		client: opensase.NewClient(apiKey, opts...),
+		stats:  newStats(),
+		prefix: fmt.Sprintf("loadgen-%d", time.Now().Unix()),
+		keep:   *keep,
+		ops:    *ops,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *duration)
+	defer cancel()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "loadgen: running %s with %d workers (prefix %s)\n", *name, *concurrency, r.prefix)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < *concurrency; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			if err := run(ctx, r, worker); err != nil && ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "loadgen: worker %d stopped: %v\n", worker, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	report := r.stats.report(time.Since(start))
+	if *jsonOut {
+		report.writeJSON(os.Stdout)
+	} else {
+		report.writeTable(os.Stdout)
+	}
+	if report.Errors > 0 {
+		os.Exit(1)
+	}
+}
+
+func scenarioNames() []string {
+	names := make([]string, 0, len(scenarios))
+	for n := range scenarios {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,222 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Data Retention & Legal Hold
+// =============================================================================
+
+// Data types with configurable retention
+const (
+	DataTypeTrafficLogs    = "traffic_logs"
+	DataTypeFlowRecords    = "flow_records"
+	DataTypeSecurityLogs   = "security_events"
+	DataTypeAuditLogs      = "audit_logs"
+	DataTypeDNSLogs        = "dns_logs"
+	DataTypePacketCaptures = "packet_captures"
+)
+
+// RetentionService provides access to log and flow retention settings
+type RetentionService struct {
+	client *Client
+}
+
+// RetentionPolicy is the retention period configured for a data type
+type RetentionPolicy struct {
+	DataType      string     `json:"data_type"`
+	RetentionDays int        `json:"retention_days"`
+	MinDays       int        `json:"min_days"`
+	MaxDays       int        `json:"max_days"`
+	Archive       bool       `json:"archive"`
+	ArchiveDays   int        `json:"archive_days,omitempty"`
+	HeldRecords   bool       `json:"held_records"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// UpdateRetentionParams contains parameters for changing a data type's retention
+type UpdateRetentionParams struct {
+	RetentionDays *int  `json:"retention_days,omitempty"`
+	Archive       *bool `json:"archive,omitempty"`
+	ArchiveDays   *int  `json:"archive_days,omitempty"`
+}
+
+// List retrieves the current retention settings for all data types
+func (s *RetentionService) List(ctx context.Context) ([]RetentionPolicy, error) {
+	data, err := s.client.get(ctx, "/compliance/retention", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []RetentionPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Get retrieves the retention setting for a single data type
+func (s *RetentionService) Get(ctx context.Context, dataType string) (*RetentionPolicy, error) {
+	data, err := s.client.get(ctx, "/compliance/retention/"+dataType, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy RetentionPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update changes the retention setting for a data type. Shortening retention
+// never deletes records covered by an active legal hold.
+func (s *RetentionService) Update(ctx context.Context, dataType string, params *UpdateRetentionParams) (*RetentionPolicy, error) {
+	data, err := s.client.patch(ctx, "/compliance/retention/"+dataType, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy RetentionPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// LegalHoldsService provides access to legal hold APIs
+type LegalHoldsService struct {
+	client *Client
+}
+
+// LegalHold preserves matching records beyond their retention period until released
+type LegalHold struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Reason     string     `json:"reason,omitempty"`
+	CaseRef    string     `json:"case_reference,omitempty"`
+	Status     string     `json:"status"`
+	UserIDs    []string   `json:"user_ids,omitempty"`
+	DataTypes  []string   `json:"data_types,omitempty"`
+	From       *time.Time `json:"from,omitempty"`
+	To         *time.Time `json:"to,omitempty"`
+	CreatedBy  string     `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReleasedBy string     `json:"released_by,omitempty"`
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+}
+
+// CreateLegalHoldParams contains parameters for placing a legal hold.
+// At least one of UserIDs or a time range must be set; an empty DataTypes
+// holds every data type.
+type CreateLegalHoldParams struct {
+	Name      string     `json:"name"`
+	Reason    string     `json:"reason,omitempty"`
+	CaseRef   string     `json:"case_reference,omitempty"`
+	UserIDs   []string   `json:"user_ids,omitempty"`
+	DataTypes []string   `json:"data_types,omitempty"`
+	From      *time.Time `json:"from,omitempty"`
+	To        *time.Time `json:"to,omitempty"`
+}
+
+// ListLegalHoldsParams contains parameters for listing legal holds
+type ListLegalHoldsParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	UserID  *string `json:"user_id,omitempty"`
+}
+
+// LegalHoldListResponse contains a list of legal holds with pagination
+type LegalHoldListResponse struct {
+	Data       []LegalHold `json:"data"`
+	Pagination Pagination  `json:"pagination"`
+}
+
+// Create places a new legal hold
+func (s *LegalHoldsService) Create(ctx context.Context, params *CreateLegalHoldParams, opts *RequestOptions) (*LegalHold, error) {
+	if len(params.UserIDs) == 0 && params.From == nil && params.To == nil {
+		return nil, fmt.Errorf("opensase: legal hold requires user_ids or a time range")
+	}
+
+	data, err := s.client.post(ctx, "/compliance/legal_holds", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold LegalHold
+	if err := s.client.decode(data, &hold); err != nil {
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+// Get retrieves a legal hold by ID
+func (s *LegalHoldsService) Get(ctx context.Context, holdID string) (*LegalHold, error) {
+	data, err := s.client.get(ctx, "/compliance/legal_holds/"+holdID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold LegalHold
+	if err := s.client.decode(data, &hold); err != nil {
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+// List retrieves legal holds with pagination
+func (s *LegalHoldsService) List(ctx context.Context, params *ListLegalHoldsParams) (*LegalHoldListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+		if params.UserID != nil {
+			v.Set("user_id", *params.UserID)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/compliance/legal_holds", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response LegalHoldListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Release releases a legal hold; held records become subject to normal retention again
+func (s *LegalHoldsService) Release(ctx context.Context, holdID string) (*LegalHold, error) {
+	data, err := s.client.post(ctx, "/compliance/legal_holds/"+holdID+"/release", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold LegalHold
+	if err := s.client.decode(data, &hold); err != nil {
+		return nil, err
+	}
+
+	return &hold, nil
+}
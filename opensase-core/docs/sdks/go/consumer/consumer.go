@@ -0,0 +1,328 @@
+// Package consumer implements a reliable, at-least-once webhook consumer.
+//
+// Incoming webhooks are verified and written to a Store before the HTTP
+// request is acknowledged, then processed asynchronously with retries and
+// exponential backoff. Events that keep failing are moved to a dead-letter
+// state where they can be inspected and requeued.
+//
+//	c := consumer.New(store, secret, func(ctx context.Context, e *opensase.WebhookEvent) error {
+//	    return handle(ctx, e)
+//	})
+//	http.Handle("/webhooks/opensase", c)
+//	go c.Run(ctx)
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Webhook delivery headers
+const (
+	SignatureHeader = "X-OpenSASE-Signature"
+	TimestampHeader = "X-OpenSASE-Timestamp"
+)
+
+// ErrNotFound is returned by a Store when a record does not exist
+var ErrNotFound = errors.New("consumer: record not found")
+
+// ErrInvalidEvent is returned by Accept for a payload that is not a webhook
+// event with an ID. Records are keyed by event ID, so such a payload cannot
+// be deduplicated and is refused rather than stored.
+var ErrInvalidEvent = errors.New("consumer: invalid event")
+
+// HandlerFunc processes a single webhook event. Returning an error schedules a
+// retry; handlers must be idempotent because an event may be delivered again.
+type HandlerFunc func(ctx context.Context, event *opensase.WebhookEvent) error
+
+// Option configures a Consumer
+type Option func(*Consumer)
+
+// WithMaxAttempts sets the number of attempts before an event is dead-lettered
+func WithMaxAttempts(n int) Option {
+	return func(c *Consumer) {
+		c.maxAttempts = n
+	}
+}
+
+// WithBackoff sets the base and maximum delay between attempts
+func WithBackoff(base, max time.Duration) Option {
+	return func(c *Consumer) {
+		c.baseDelay = base
+		c.maxDelay = max
+	}
+}
+
+// WithPollInterval sets how often the store is polled for due events
+func WithPollInterval(d time.Duration) Option {
+	return func(c *Consumer) {
+		c.pollInterval = d
+	}
+}
+
+// WithBatchSize sets how many due events are fetched per poll
+func WithBatchSize(n int) Option {
+	return func(c *Consumer) {
+		c.batchSize = n
+	}
+}
+
+// WithTolerance sets the accepted webhook timestamp skew in seconds
+func WithTolerance(seconds int64) Option {
+	return func(c *Consumer) {
+		c.tolerance = seconds
+	}
+}
+
+// WithDeadLetterHook registers a function called when an event is dead-lettered
+func WithDeadLetterHook(fn func(ctx context.Context, rec *Record)) Option {
+	return func(c *Consumer) {
+		c.onDeadLetter = fn
+	}
+}
+
+// WithErrorHook registers a function called when polling the store fails
+func WithErrorHook(fn func(err error)) Option {
+	return func(c *Consumer) {
+		c.onError = fn
+	}
+}
+
+// Consumer receives, persists and processes webhook events
+type Consumer struct {
+	store   Store
+	secret  string
+	handler HandlerFunc
+
+	maxAttempts  int
+	baseDelay    time.Duration
+	maxDelay     time.Duration
+	pollInterval time.Duration
+	batchSize    int
+	tolerance    int64
+	onDeadLetter func(ctx context.Context, rec *Record)
+	onError      func(err error)
+	now          func() time.Time
+
+	received     atomic.Uint64
+	duplicates   atomic.Uint64
+	rejected     atomic.Uint64
+	processed    atomic.Uint64
+	failed       atomic.Uint64
+	deadLettered atomic.Uint64
+}
+
+// New creates a Consumer that verifies webhooks with secret and processes them with handler
+func New(store Store, secret string, handler HandlerFunc, opts ...Option) *Consumer {
+	c := &Consumer{
+		store:        store,
+		secret:       secret,
+		handler:      handler,
+		maxAttempts:  8,
+		baseDelay:    time.Second,
+		maxDelay:     10 * time.Minute,
+		pollInterval: time.Second,
+		batchSize:    50,
+		tolerance:    300,
+		now:          time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// ServeHTTP verifies and persists an incoming webhook. It responds 2xx only
+// once the event is durably stored, so the platform redelivers on failure.
+func (c *Consumer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	valid, err := opensase.VerifyWebhookSignature(payload, r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), c.secret, c.tolerance)
+	if err != nil || !valid {
+		c.rejected.Add(1)
+		http.Error(w, "invalid signature", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := c.Accept(r.Context(), payload); err != nil {
+		if errors.Is(err, ErrInvalidEvent) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "unable to persist event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Accept persists an already-verified webhook payload. It reports whether the
+// event was new; duplicates are acknowledged without being stored again.
+// Payloads that are not events with an ID fail with ErrInvalidEvent.
+func (c *Consumer) Accept(ctx context.Context, payload []byte) (bool, error) {
+	var event opensase.WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		c.rejected.Add(1)
+		return false, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	if event.ID == "" {
+		c.rejected.Add(1)
+		return false, fmt.Errorf("%w: missing id", ErrInvalidEvent)
+	}
+
+	now := c.now()
+	inserted, err := c.store.Save(ctx, &Record{
+		ID:            event.ID,
+		Type:          event.Type,
+		Payload:       payload,
+		Status:        StatusPending,
+		ReceivedAt:    now,
+		NextAttemptAt: now,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if inserted {
+		c.received.Add(1)
+	} else {
+		c.duplicates.Add(1)
+	}
+	return inserted, nil
+}
+
+// Run processes due events until ctx is canceled
+func (c *Consumer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Store errors are transient from the consumer's point of view; the
+		// next tick retries.
+		if err := c.ProcessDue(ctx); err != nil && ctx.Err() == nil && c.onError != nil {
+			c.onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue processes one batch of due events
+func (c *Consumer) ProcessDue(ctx context.Context) error {
+	records, err := c.store.Due(ctx, c.now(), c.batchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, rec := range records {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := c.process(ctx, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Consumer) process(ctx context.Context, rec *Record) error {
+	var event opensase.WebhookEvent
+	herr := json.Unmarshal(rec.Payload, &event)
+	if herr == nil {
+		herr = c.handler(ctx, &event)
+	}
+
+	if herr == nil {
+		c.processed.Add(1)
+		return c.store.MarkProcessed(ctx, rec.ID, c.now())
+	}
+
+	c.failed.Add(1)
+	attempts := rec.Attempts + 1
+	if attempts >= c.maxAttempts {
+		if err := c.store.MarkDeadLetter(ctx, rec.ID, attempts, herr.Error()); err != nil {
+			return err
+		}
+		c.deadLettered.Add(1)
+		if c.onDeadLetter != nil {
+			rec.Status = StatusDeadLetter
+			rec.Attempts = attempts
+			rec.LastError = herr.Error()
+			c.onDeadLetter(ctx, rec)
+		}
+		return nil
+	}
+
+	return c.store.MarkRetry(ctx, rec.ID, attempts, c.now().Add(c.backoff(attempts)), herr.Error())
+}
+
+func (c *Consumer) backoff(attempts int) time.Duration {
+	delay := c.baseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= c.maxDelay {
+			return c.maxDelay
+		}
+	}
+	return delay
+}
+
+// Requeue moves a dead-lettered event back into the processing queue
+func (c *Consumer) Requeue(ctx context.Context, id string) error {
+	return c.store.Requeue(ctx, id, c.now())
+}
+
+// Metrics contains consumer counters and lag information
+type Metrics struct {
+	Received     uint64
+	Duplicates   uint64
+	Rejected     uint64
+	Processed    uint64
+	Failed       uint64
+	DeadLettered uint64
+	Pending      int
+	DeadLetters  int
+	// Lag is the age of the oldest unprocessed event
+	Lag time.Duration
+}
+
+// Metrics returns a snapshot of the consumer's counters and queue lag
+func (c *Consumer) Metrics(ctx context.Context) (*Metrics, error) {
+	stats, err := c.store.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Metrics{
+		Received:     c.received.Load(),
+		Duplicates:   c.duplicates.Load(),
+		Rejected:     c.rejected.Load(),
+		Processed:    c.processed.Load(),
+		Failed:       c.failed.Load(),
+		DeadLettered: c.deadLettered.Load(),
+		Pending:      stats.Pending,
+		DeadLetters:  stats.DeadLettered,
+	}
+	if stats.OldestPending != nil {
+		m.Lag = c.now().Sub(*stats.OldestPending)
+	}
+	return m, nil
+}
@@ -0,0 +1,163 @@
+package opensase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// CRM Change Feed
+// =============================================================================
+
+// CRM change feed object types
+const (
+	ChangeObjectContact = "contact"
+	ChangeObjectDeal    = "deal"
+)
+
+// CRM change feed operations
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// CRMChange is a single entry in the CRM change feed
+type CRMChange struct {
+	// Cursor resumes the feed immediately after this change
+	Cursor     string `json:"cursor"`
+	ObjectType string `json:"object_type"`
+	ObjectID   string `json:"object_id"`
+	Operation  string `json:"operation"`
+	// Version increases with every change to the object
+	Version int `json:"version"`
+	// Object is the object after the change; it is empty for deletions
+	Object    json.RawMessage `json:"object,omitempty"`
+	ChangedAt time.Time       `json:"changed_at"`
+}
+
+// Contact decodes the object of a contact change
+func (c *CRMChange) Contact() (*Contact, error) {
+	var contact Contact
+	if err := c.decodeObject(ChangeObjectContact, &contact); err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// Deal decodes the object of a deal change
+func (c *CRMChange) Deal() (*Deal, error) {
+	var deal Deal
+	if err := c.decodeObject(ChangeObjectDeal, &deal); err != nil {
+		return nil, err
+	}
+	return &deal, nil
+}
+
+func (c *CRMChange) decodeObject(objectType string, v interface{}) error {
+	if c.ObjectType != objectType {
+		return fmt.Errorf("opensase: change %s is for a %s, not a %s", c.Cursor, c.ObjectType, objectType)
+	}
+	if len(c.Object) == 0 {
+		return fmt.Errorf("opensase: %s change of %s %s carries no object", c.Operation, c.ObjectType, c.ObjectID)
+	}
+	return json.Unmarshal(c.Object, v)
+}
+
+// CRMChangePage is one page of the change feed, oldest change first
+type CRMChangePage struct {
+	Data       []CRMChange      `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// ChangeFeed iterates over the CRM change feed in commit order. Persist
+// Cursor after processing each change to resume from there later.
+type ChangeFeed struct {
+	ctx         context.Context
+	crm         *CRMService
+	cursor      string
+	objectTypes []string
+	PageSize    int
+
+	buf      []CRMChange
+	caughtUp bool
+}
+
+// Changes opens the change feed for contacts and deals after the since
+// cursor. ctx applies to every page fetched by the feed. An empty since starts at the oldest retained change; an empty
+// objectTypes includes every type.
+//
+//	feed := client.CRM.Changes(ctx, lastCursor, []string{opensase.ChangeObjectDeal})
+//	for {
+//	    change, err := feed.Next()
+//	    if err == io.EOF {
+//	        break // caught up
+//	    }
+//	    ...
+//	    save(feed.Cursor())
+//	}
+func (s *CRMService) Changes(ctx context.Context, since string, objectTypes []string) *ChangeFeed {
+	return &ChangeFeed{ctx: ctx, crm: s, cursor: since, objectTypes: objectTypes, PageSize: 500}
+}
+
+// Next returns the next change. It returns io.EOF once the feed is caught
+// up; calling Next again later picks up changes committed since.
+func (f *ChangeFeed) Next() (*CRMChange, error) {
+	if len(f.buf) == 0 {
+		if f.caughtUp {
+			// The last page has been drained; poll again on the next call
+			f.caughtUp = false
+			return nil, io.EOF
+		}
+		page, err := f.crm.ListChanges(f.ctx, f.cursor, f.objectTypes, f.PageSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(page.Data) == 0 {
+			return nil, io.EOF
+		}
+		f.buf = page.Data
+		f.caughtUp = !page.Pagination.HasMore
+	}
+
+	change := f.buf[0]
+	f.buf = f.buf[1:]
+	f.cursor = change.Cursor
+	return &change, nil
+}
+
+// Cursor returns the position after the last change returned by Next
+func (f *ChangeFeed) Cursor() string {
+	return f.cursor
+}
+
+// ListChanges retrieves a single page of the change feed after the since cursor
+func (s *CRMService) ListChanges(ctx context.Context, since string, objectTypes []string, limit int) (*CRMChangePage, error) {
+	v := url.Values{}
+	if since != "" {
+		v.Set("since", since)
+	}
+	for _, t := range objectTypes {
+		v.Add("object_type", t)
+	}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	data, err := s.client.get(ctx, "/crm/changes", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page CRMChangePage
+	if err := s.client.decode(data, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
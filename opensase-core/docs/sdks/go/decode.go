@@ -0,0 +1,112 @@
+package opensase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// =============================================================================
+// Response Decoding
+// =============================================================================
+
+// WithStrictDecoding makes responses that contain fields the SDK does not
+// know about fail with a *DecodeError instead of being silently dropped.
+// Useful in tests and CI to catch API drift early.
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = true
+	}
+}
+
+// DecodeError is returned when a response body cannot be decoded into the
+// SDK model
+type DecodeError struct {
+	Type string
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("opensase: decoding %s: %v", e.Type, e.Err)
+}
+
+// Unwrap returns the underlying JSON error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decode unmarshals a response body into v. Endpoints differ in whether they
+// wrap their payload as {"data": ...}, so both layouts are accepted:
+//
+//   - a target with its own "data" field, such as a list response, is
+//     decoded from the whole body, and a bare array fills its data field
+//   - any other target is decoded from the "data" member when the body is
+//     an envelope, and from the whole body otherwise
+func (c *Client) decode(data []byte, v interface{}) error {
+	if err := decodeJSON(unwrapEnvelope(data, v), v, c.strictDecoding); err != nil {
+		return &DecodeError{Type: reflect.TypeOf(v).Elem().String(), Err: err}
+	}
+	return nil
+}
+
+func unwrapEnvelope(data []byte, v interface{}) []byte {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return data
+	}
+
+	if field, ok := dataField(v); ok {
+		if trimmed[0] == '[' {
+			// Bare list for a paginated response type
+			if raw, err := json.Marshal(map[string]json.RawMessage{field: trimmed}); err == nil {
+				return raw
+			}
+		}
+		return data
+	}
+
+	if trimmed[0] != '{' {
+		return data
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &envelope); err != nil {
+		return data
+	}
+	if inner, ok := envelope["data"]; ok {
+		return inner
+	}
+	return data
+}
+
+// dataField reports whether v points to a struct with a "data" JSON field,
+// returning the field's JSON name
+func dataField(v interface{}) (string, bool) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = t.Field(i).Name
+		}
+		if strings.EqualFold(name, "data") {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func decodeJSON(data []byte, v interface{}, strict bool) error {
+	if !strict {
+		return json.Unmarshal(data, v)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
@@ -0,0 +1,122 @@
+package opensase
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	site := Site{ID: "site_1", Name: "NYC"}
+	users := UserListResponse{
+		Data:       []User{{ID: "usr_1", Email: "a@example.com"}},
+		Pagination: Pagination{Page: 1, PerPage: 20, Total: 1, TotalPages: 1},
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		into    func() interface{}
+		strict  bool
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "object in envelope",
+			body: `{"data": {"id": "site_1", "name": "NYC"}}`,
+			into: func() interface{} { return &Site{} },
+			want: &site,
+		},
+		{
+			name: "raw object",
+			body: `{"id": "site_1", "name": "NYC"}`,
+			into: func() interface{} { return &Site{} },
+			want: &site,
+		},
+		{
+			name: "array in envelope",
+			body: `{"data": [{"id": "site_1", "name": "NYC"}]}`,
+			into: func() interface{} { return &[]Site{} },
+			want: &[]Site{site},
+		},
+		{
+			name: "raw array",
+			body: `[{"id": "site_1", "name": "NYC"}]`,
+			into: func() interface{} { return &[]Site{} },
+			want: &[]Site{site},
+		},
+		{
+			name: "list response",
+			body: `{"data": [{"id": "usr_1", "email": "a@example.com"}], "pagination": {"page": 1, "per_page": 20, "total": 1, "total_pages": 1}}`,
+			into: func() interface{} { return &UserListResponse{} },
+			want: &users,
+		},
+		{
+			name: "list response from raw array",
+			body: `[{"id": "usr_1", "email": "a@example.com"}]`,
+			into: func() interface{} { return &UserListResponse{} },
+			want: &UserListResponse{Data: users.Data},
+		},
+		{
+			name: "unknown field ignored",
+			body: `{"data": {"id": "site_1", "name": "NYC", "added_later": true}}`,
+			into: func() interface{} { return &Site{} },
+			want: &site,
+		},
+		{
+			name:   "strict known fields",
+			body:   `{"data": {"id": "site_1", "name": "NYC"}}`,
+			into:   func() interface{} { return &Site{} },
+			strict: true,
+			want:   &site,
+		},
+		{
+			name:    "strict unknown field in envelope",
+			body:    `{"data": {"id": "site_1", "name": "NYC", "added_later": true}}`,
+			into:    func() interface{} { return &Site{} },
+			strict:  true,
+			wantErr: true,
+		},
+		{
+			name:    "strict unknown field in raw object",
+			body:    `{"id": "site_1", "name": "NYC", "added_later": true}`,
+			into:    func() interface{} { return &Site{} },
+			strict:  true,
+			wantErr: true,
+		},
+		{
+			name:    "strict unknown field in list response",
+			body:    `{"data": [], "pagination": {}, "next_cursor": "c"}`,
+			into:    func() interface{} { return &UserListResponse{} },
+			strict:  true,
+			wantErr: true,
+		},
+		{
+			name:    "malformed",
+			body:    `{"data": `,
+			into:    func() interface{} { return &Site{} },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{strictDecoding: tt.strict}
+			got := tt.into()
+			err := c.decode([]byte(tt.body), got)
+			if tt.wantErr {
+				var decodeErr *DecodeError
+				if !errors.As(err, &decodeErr) {
+					t.Fatalf("decode() error = %v, want a *DecodeError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decode() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
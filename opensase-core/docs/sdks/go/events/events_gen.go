@@ -0,0 +1,590 @@
+// Code generated by gen.go from catalog.json; DO NOT EDIT.
+
+package events
+
+import (
+	"encoding/json"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Event types
+const (
+	TypeUserCreated                 = "identity.user.created"
+	TypeUserUpdated                 = "identity.user.updated"
+	TypeUserDeleted                 = "identity.user.deleted"
+	TypeContactCreated              = "crm.contact.created"
+	TypeContactUpdated              = "crm.contact.updated"
+	TypeContactDeleted              = "crm.contact.deleted"
+	TypeDealCreated                 = "crm.deal.created"
+	TypeDealUpdated                 = "crm.deal.updated"
+	TypeDealStageChanged            = "crm.deal.stage_changed"
+	TypeQuoteSigned                 = "crm.quote.signed"
+	TypeQuoteDeclined               = "crm.quote.declined"
+	TypeSequenceEnrollmentCreated   = "crm.sequence.enrollment.created"
+	TypeSequenceEnrollmentCompleted = "crm.sequence.enrollment.completed"
+	TypeSequenceEnrollmentExited    = "crm.sequence.enrollment.exited"
+	TypeSequenceStepCompleted       = "crm.sequence.step.completed"
+	TypePaymentIntentSucceeded      = "payments.payment_intent.succeeded"
+	TypePaymentIntentPaymentFailed  = "payments.payment_intent.payment_failed"
+	TypePaymentIntentCanceled       = "payments.payment_intent.canceled"
+	TypeCheckoutSessionCompleted    = "payments.checkout_session.completed"
+	TypeCheckoutSessionExpired      = "payments.checkout_session.expired"
+	TypePaymentLinkCompleted        = "payments.payment_link.completed"
+	TypePaymentLinkExpired          = "payments.payment_link.expired"
+	TypePaymentLinkDeactivated      = "payments.payment_link.deactivated"
+	TypeSubscriptionCreated         = "payments.subscription.created"
+	TypeSubscriptionUpdated         = "payments.subscription.updated"
+	TypeSubscriptionCanceled        = "payments.subscription.canceled"
+	TypeRefundCreated               = "payments.refund.created"
+	TypeTransferCreated             = "payments.transfer.created"
+	TypeDunningRetryFailed          = "payments.dunning.retry_failed"
+)
+
+// Types lists every event type in the catalog
+var Types = []string{
+	TypeUserCreated,
+	TypeUserUpdated,
+	TypeUserDeleted,
+	TypeContactCreated,
+	TypeContactUpdated,
+	TypeContactDeleted,
+	TypeDealCreated,
+	TypeDealUpdated,
+	TypeDealStageChanged,
+	TypeQuoteSigned,
+	TypeQuoteDeclined,
+	TypeSequenceEnrollmentCreated,
+	TypeSequenceEnrollmentCompleted,
+	TypeSequenceEnrollmentExited,
+	TypeSequenceStepCompleted,
+	TypePaymentIntentSucceeded,
+	TypePaymentIntentPaymentFailed,
+	TypePaymentIntentCanceled,
+	TypeCheckoutSessionCompleted,
+	TypeCheckoutSessionExpired,
+	TypePaymentLinkCompleted,
+	TypePaymentLinkExpired,
+	TypePaymentLinkDeactivated,
+	TypeSubscriptionCreated,
+	TypeSubscriptionUpdated,
+	TypeSubscriptionCanceled,
+	TypeRefundCreated,
+	TypeTransferCreated,
+	TypeDunningRetryFailed,
+}
+
+// UserCreated is sent when a user is created
+type UserCreated struct {
+	Envelope
+	Object opensase.User
+}
+
+func (*UserCreated) isEvent() {}
+
+// UserUpdated is sent when a user is updated
+type UserUpdated struct {
+	Envelope
+	Object opensase.User
+}
+
+func (*UserUpdated) isEvent() {}
+
+// UserDeleted is sent when a user is deleted
+type UserDeleted struct {
+	Envelope
+	Object opensase.User
+}
+
+func (*UserDeleted) isEvent() {}
+
+// ContactCreated is sent when a contact is created
+type ContactCreated struct {
+	Envelope
+	Object opensase.Contact
+}
+
+func (*ContactCreated) isEvent() {}
+
+// ContactUpdated is sent when a contact is updated
+type ContactUpdated struct {
+	Envelope
+	Object opensase.Contact
+}
+
+func (*ContactUpdated) isEvent() {}
+
+// ContactDeleted is sent when a contact is deleted
+type ContactDeleted struct {
+	Envelope
+	Object opensase.Contact
+}
+
+func (*ContactDeleted) isEvent() {}
+
+// DealCreated is sent when a deal is created
+type DealCreated struct {
+	Envelope
+	Object opensase.Deal
+}
+
+func (*DealCreated) isEvent() {}
+
+// DealUpdated is sent when a deal is updated
+type DealUpdated struct {
+	Envelope
+	Object opensase.Deal
+}
+
+func (*DealUpdated) isEvent() {}
+
+// DealStageChanged is sent when a deal moves to a different stage
+type DealStageChanged struct {
+	Envelope
+	Object opensase.Deal
+}
+
+func (*DealStageChanged) isEvent() {}
+
+// QuoteSigned is sent when a quote is signed
+type QuoteSigned struct {
+	Envelope
+	Object opensase.Quote
+}
+
+func (*QuoteSigned) isEvent() {}
+
+// QuoteDeclined is sent when a quote signature is declined
+type QuoteDeclined struct {
+	Envelope
+	Object opensase.Quote
+}
+
+func (*QuoteDeclined) isEvent() {}
+
+// SequenceEnrollmentCreated is sent when a contact is enrolled in a sequence
+type SequenceEnrollmentCreated struct {
+	Envelope
+	Object opensase.SequenceEnrollment
+}
+
+func (*SequenceEnrollmentCreated) isEvent() {}
+
+// SequenceEnrollmentCompleted is sent when a contact completes a sequence
+type SequenceEnrollmentCompleted struct {
+	Envelope
+	Object opensase.SequenceEnrollment
+}
+
+func (*SequenceEnrollmentCompleted) isEvent() {}
+
+// SequenceEnrollmentExited is sent when a contact leaves a sequence early
+type SequenceEnrollmentExited struct {
+	Envelope
+	Object opensase.SequenceEnrollment
+}
+
+func (*SequenceEnrollmentExited) isEvent() {}
+
+// SequenceStepCompleted is sent when a sequence step is delivered
+type SequenceStepCompleted struct {
+	Envelope
+	Object opensase.SequenceEnrollment
+}
+
+func (*SequenceStepCompleted) isEvent() {}
+
+// PaymentIntentSucceeded is sent when a payment intent succeeds
+type PaymentIntentSucceeded struct {
+	Envelope
+	Object opensase.PaymentIntent
+}
+
+func (*PaymentIntentSucceeded) isEvent() {}
+
+// PaymentIntentPaymentFailed is sent when a payment attempt fails
+type PaymentIntentPaymentFailed struct {
+	Envelope
+	Object opensase.PaymentIntent
+}
+
+func (*PaymentIntentPaymentFailed) isEvent() {}
+
+// PaymentIntentCanceled is sent when a payment intent is canceled
+type PaymentIntentCanceled struct {
+	Envelope
+	Object opensase.PaymentIntent
+}
+
+func (*PaymentIntentCanceled) isEvent() {}
+
+// CheckoutSessionCompleted is sent when a checkout session is paid
+type CheckoutSessionCompleted struct {
+	Envelope
+	Object opensase.CheckoutSession
+}
+
+func (*CheckoutSessionCompleted) isEvent() {}
+
+// CheckoutSessionExpired is sent when a checkout session expires
+type CheckoutSessionExpired struct {
+	Envelope
+	Object opensase.CheckoutSession
+}
+
+func (*CheckoutSessionExpired) isEvent() {}
+
+// PaymentLinkCompleted is sent when a purchase completes through a payment link
+type PaymentLinkCompleted struct {
+	Envelope
+	Object opensase.PaymentLinkCompletion
+}
+
+func (*PaymentLinkCompleted) isEvent() {}
+
+// PaymentLinkExpired is sent when a payment link expires
+type PaymentLinkExpired struct {
+	Envelope
+	Object opensase.PaymentLink
+}
+
+func (*PaymentLinkExpired) isEvent() {}
+
+// PaymentLinkDeactivated is sent when a payment link is deactivated
+type PaymentLinkDeactivated struct {
+	Envelope
+	Object opensase.PaymentLink
+}
+
+func (*PaymentLinkDeactivated) isEvent() {}
+
+// SubscriptionCreated is sent when a subscription is created
+type SubscriptionCreated struct {
+	Envelope
+	Object opensase.Subscription
+}
+
+func (*SubscriptionCreated) isEvent() {}
+
+// SubscriptionUpdated is sent when a subscription is updated
+type SubscriptionUpdated struct {
+	Envelope
+	Object opensase.Subscription
+}
+
+func (*SubscriptionUpdated) isEvent() {}
+
+// SubscriptionCanceled is sent when a subscription is canceled
+type SubscriptionCanceled struct {
+	Envelope
+	Object opensase.Subscription
+}
+
+func (*SubscriptionCanceled) isEvent() {}
+
+// RefundCreated is sent when a refund is created
+type RefundCreated struct {
+	Envelope
+	Object opensase.Refund
+}
+
+func (*RefundCreated) isEvent() {}
+
+// TransferCreated is sent when funds are transferred to a connected account
+type TransferCreated struct {
+	Envelope
+	Object opensase.Transfer
+}
+
+func (*TransferCreated) isEvent() {}
+
+// DunningRetryFailed is sent when a scheduled payment retry fails
+type DunningRetryFailed struct {
+	Envelope
+	Object opensase.DunningSubscription
+}
+
+func (*DunningRetryFailed) isEvent() {}
+
+// Handler handles every event type in the catalog. Adding an event to the
+// catalog adds a method here, so implementations fail to compile until they
+// handle it.
+type Handler interface {
+	HandleUserCreated(e *UserCreated) error
+	HandleUserUpdated(e *UserUpdated) error
+	HandleUserDeleted(e *UserDeleted) error
+	HandleContactCreated(e *ContactCreated) error
+	HandleContactUpdated(e *ContactUpdated) error
+	HandleContactDeleted(e *ContactDeleted) error
+	HandleDealCreated(e *DealCreated) error
+	HandleDealUpdated(e *DealUpdated) error
+	HandleDealStageChanged(e *DealStageChanged) error
+	HandleQuoteSigned(e *QuoteSigned) error
+	HandleQuoteDeclined(e *QuoteDeclined) error
+	HandleSequenceEnrollmentCreated(e *SequenceEnrollmentCreated) error
+	HandleSequenceEnrollmentCompleted(e *SequenceEnrollmentCompleted) error
+	HandleSequenceEnrollmentExited(e *SequenceEnrollmentExited) error
+	HandleSequenceStepCompleted(e *SequenceStepCompleted) error
+	HandlePaymentIntentSucceeded(e *PaymentIntentSucceeded) error
+	HandlePaymentIntentPaymentFailed(e *PaymentIntentPaymentFailed) error
+	HandlePaymentIntentCanceled(e *PaymentIntentCanceled) error
+	HandleCheckoutSessionCompleted(e *CheckoutSessionCompleted) error
+	HandleCheckoutSessionExpired(e *CheckoutSessionExpired) error
+	HandlePaymentLinkCompleted(e *PaymentLinkCompleted) error
+	HandlePaymentLinkExpired(e *PaymentLinkExpired) error
+	HandlePaymentLinkDeactivated(e *PaymentLinkDeactivated) error
+	HandleSubscriptionCreated(e *SubscriptionCreated) error
+	HandleSubscriptionUpdated(e *SubscriptionUpdated) error
+	HandleSubscriptionCanceled(e *SubscriptionCanceled) error
+	HandleRefundCreated(e *RefundCreated) error
+	HandleTransferCreated(e *TransferCreated) error
+	HandleDunningRetryFailed(e *DunningRetryFailed) error
+	HandleUnknown(e *Unknown) error
+}
+
+// Dispatch calls the Handler method matching the event's concrete type
+func Dispatch(e Event, h Handler) error {
+	switch ev := e.(type) {
+	case *UserCreated:
+		return h.HandleUserCreated(ev)
+	case *UserUpdated:
+		return h.HandleUserUpdated(ev)
+	case *UserDeleted:
+		return h.HandleUserDeleted(ev)
+	case *ContactCreated:
+		return h.HandleContactCreated(ev)
+	case *ContactUpdated:
+		return h.HandleContactUpdated(ev)
+	case *ContactDeleted:
+		return h.HandleContactDeleted(ev)
+	case *DealCreated:
+		return h.HandleDealCreated(ev)
+	case *DealUpdated:
+		return h.HandleDealUpdated(ev)
+	case *DealStageChanged:
+		return h.HandleDealStageChanged(ev)
+	case *QuoteSigned:
+		return h.HandleQuoteSigned(ev)
+	case *QuoteDeclined:
+		return h.HandleQuoteDeclined(ev)
+	case *SequenceEnrollmentCreated:
+		return h.HandleSequenceEnrollmentCreated(ev)
+	case *SequenceEnrollmentCompleted:
+		return h.HandleSequenceEnrollmentCompleted(ev)
+	case *SequenceEnrollmentExited:
+		return h.HandleSequenceEnrollmentExited(ev)
+	case *SequenceStepCompleted:
+		return h.HandleSequenceStepCompleted(ev)
+	case *PaymentIntentSucceeded:
+		return h.HandlePaymentIntentSucceeded(ev)
+	case *PaymentIntentPaymentFailed:
+		return h.HandlePaymentIntentPaymentFailed(ev)
+	case *PaymentIntentCanceled:
+		return h.HandlePaymentIntentCanceled(ev)
+	case *CheckoutSessionCompleted:
+		return h.HandleCheckoutSessionCompleted(ev)
+	case *CheckoutSessionExpired:
+		return h.HandleCheckoutSessionExpired(ev)
+	case *PaymentLinkCompleted:
+		return h.HandlePaymentLinkCompleted(ev)
+	case *PaymentLinkExpired:
+		return h.HandlePaymentLinkExpired(ev)
+	case *PaymentLinkDeactivated:
+		return h.HandlePaymentLinkDeactivated(ev)
+	case *SubscriptionCreated:
+		return h.HandleSubscriptionCreated(ev)
+	case *SubscriptionUpdated:
+		return h.HandleSubscriptionUpdated(ev)
+	case *SubscriptionCanceled:
+		return h.HandleSubscriptionCanceled(ev)
+	case *RefundCreated:
+		return h.HandleRefundCreated(ev)
+	case *TransferCreated:
+		return h.HandleTransferCreated(ev)
+	case *DunningRetryFailed:
+		return h.HandleDunningRetryFailed(ev)
+	case *Unknown:
+		return h.HandleUnknown(ev)
+	}
+	return nil
+}
+
+func decode(env Envelope, object json.RawMessage) (Event, error) {
+	switch env.Type {
+	case TypeUserCreated:
+		e := &UserCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeUserUpdated:
+		e := &UserUpdated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeUserDeleted:
+		e := &UserDeleted{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeContactCreated:
+		e := &ContactCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeContactUpdated:
+		e := &ContactUpdated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeContactDeleted:
+		e := &ContactDeleted{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeDealCreated:
+		e := &DealCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeDealUpdated:
+		e := &DealUpdated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeDealStageChanged:
+		e := &DealStageChanged{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeQuoteSigned:
+		e := &QuoteSigned{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeQuoteDeclined:
+		e := &QuoteDeclined{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSequenceEnrollmentCreated:
+		e := &SequenceEnrollmentCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSequenceEnrollmentCompleted:
+		e := &SequenceEnrollmentCompleted{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSequenceEnrollmentExited:
+		e := &SequenceEnrollmentExited{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSequenceStepCompleted:
+		e := &SequenceStepCompleted{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypePaymentIntentSucceeded:
+		e := &PaymentIntentSucceeded{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypePaymentIntentPaymentFailed:
+		e := &PaymentIntentPaymentFailed{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypePaymentIntentCanceled:
+		e := &PaymentIntentCanceled{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeCheckoutSessionCompleted:
+		e := &CheckoutSessionCompleted{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeCheckoutSessionExpired:
+		e := &CheckoutSessionExpired{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypePaymentLinkCompleted:
+		e := &PaymentLinkCompleted{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypePaymentLinkExpired:
+		e := &PaymentLinkExpired{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypePaymentLinkDeactivated:
+		e := &PaymentLinkDeactivated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSubscriptionCreated:
+		e := &SubscriptionCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSubscriptionUpdated:
+		e := &SubscriptionUpdated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeSubscriptionCanceled:
+		e := &SubscriptionCanceled{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeRefundCreated:
+		e := &RefundCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeTransferCreated:
+		e := &TransferCreated{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case TypeDunningRetryFailed:
+		e := &DunningRetryFailed{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+	return &Unknown{Envelope: env, Object: object}, nil
+}
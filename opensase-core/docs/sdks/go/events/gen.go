@@ -0,0 +1,116 @@
+//go:build ignore
+
+// gen.go generates events_gen.go from catalog.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+type entry struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Payload string `json:"payload"`
+	Doc     string `json:"doc"`
+}
+
+var tmpl = template.Must(template.New("events").Parse(`// Code generated by gen.go from catalog.json; DO NOT EDIT.
+
+package events
+
+import (
+	"encoding/json"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Event types
+const (
+{{- range .}}
+	Type{{.Name}} = "{{.Type}}"
+{{- end}}
+)
+
+// Types lists every event type in the catalog
+var Types = []string{
+{{- range .}}
+	Type{{.Name}},
+{{- end}}
+}
+{{range .}}
+// {{.Name}} is sent when {{.Doc}}
+type {{.Name}} struct {
+	Envelope
+	Object opensase.{{.Payload}}
+}
+
+func (*{{.Name}}) isEvent() {}
+{{end}}
+// Handler handles every event type in the catalog. Adding an event to the
+// catalog adds a method here, so implementations fail to compile until they
+// handle it.
+type Handler interface {
+{{- range .}}
+	Handle{{.Name}}(e *{{.Name}}) error
+{{- end}}
+	HandleUnknown(e *Unknown) error
+}
+
+// Dispatch calls the Handler method matching the event's concrete type
+func Dispatch(e Event, h Handler) error {
+	switch ev := e.(type) {
+{{- range .}}
+	case *{{.Name}}:
+		return h.Handle{{.Name}}(ev)
+{{- end}}
+	case *Unknown:
+		return h.HandleUnknown(ev)
+	}
+	return nil
+}
+
+func decode(env Envelope, object json.RawMessage) (Event, error) {
+	switch env.Type {
+{{- range .}}
+	case Type{{.Name}}:
+		e := &{{.Name}}{Envelope: env}
+		if err := json.Unmarshal(object, &e.Object); err != nil {
+			return nil, err
+		}
+		return e, nil
+{{- end}}
+	}
+	return &Unknown{Envelope: env, Object: object}, nil
+}
+`))
+
+func main() {
+	raw, err := os.ReadFile("catalog.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var entries []entry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, entries); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("events_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,308 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Data Warehouse Exports
+// =============================================================================
+
+// Export datasets
+const (
+	ExportDatasetContacts      = "contacts"
+	ExportDatasetDeals         = "deals"
+	ExportDatasetUsers         = "users"
+	ExportDatasetFlowSummaries = "flow_summaries"
+	ExportDatasetEvents        = "events"
+)
+
+// Export run modes
+const (
+	ExportModeFull        = "full"
+	ExportModeIncremental = "incremental"
+)
+
+// Export destination types
+const (
+	ExportDestinationS3  = "s3"
+	ExportDestinationGCS = "gcs"
+)
+
+// Export run statuses
+const (
+	ExportRunQueued    = "queued"
+	ExportRunRunning   = "running"
+	ExportRunSucceeded = "succeeded"
+	ExportRunFailed    = "failed"
+)
+
+// ExportsService provides access to data warehouse export APIs. Exports are
+// written as Parquet to a customer bucket, laid out with Hive-style
+// partitions that BigQuery external tables and Snowflake external stages
+// can read directly.
+type ExportsService struct {
+	client *Client
+}
+
+// ExportDestination is the bucket an export writes to. S3 destinations are
+// written by assuming RoleARN; GCS destinations by impersonating
+// ServiceAccount.
+type ExportDestination struct {
+	Type           string `json:"type"`
+	Bucket         string `json:"bucket"`
+	Prefix         string `json:"prefix,omitempty"`
+	Region         string `json:"region,omitempty"`
+	RoleARN        string `json:"role_arn,omitempty"`
+	ServiceAccount string `json:"service_account,omitempty"`
+}
+
+// ExportPartitioning controls how files are partitioned by time: "hour",
+// "day", "month" or "none"
+type ExportPartitioning struct {
+	Granularity string `json:"granularity"`
+	// Field is the timestamp column used for partitioning; defaults to the
+	// dataset's update time
+	Field string `json:"field,omitempty"`
+}
+
+// ExportJob is a configured export of one dataset
+type ExportJob struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Dataset      string             `json:"dataset"`
+	Format       string             `json:"format"`
+	Destination  ExportDestination  `json:"destination"`
+	Partitioning ExportPartitioning `json:"partitioning"`
+	// Schedule is a cron expression for server-run incremental exports;
+	// empty means runs are only started on demand
+	Schedule string `json:"schedule,omitempty"`
+	Enabled  bool   `json:"enabled"`
+	// Watermark is the position of the last successful run; incremental
+	// runs export changes after it
+	Watermark     string     `json:"watermark,omitempty"`
+	SchemaVersion int        `json:"schema_version"`
+	LastRunAt     *time.Time `json:"last_run_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// CreateExportJobParams contains parameters for creating an export job
+type CreateExportJobParams struct {
+	Name         string             `json:"name"`
+	Dataset      string             `json:"dataset"`
+	Format       string             `json:"format,omitempty"`
+	Destination  ExportDestination  `json:"destination"`
+	Partitioning ExportPartitioning `json:"partitioning"`
+	Schedule     string             `json:"schedule,omitempty"`
+	Enabled      *bool              `json:"enabled,omitempty"`
+}
+
+// UpdateExportJobParams contains parameters for updating an export job
+type UpdateExportJobParams struct {
+	Name          *string             `json:"name,omitempty"`
+	Destination   *ExportDestination  `json:"destination,omitempty"`
+	Partitioning  *ExportPartitioning `json:"partitioning,omitempty"`
+	Schedule      *string             `json:"schedule,omitempty"`
+	Enabled       *bool               `json:"enabled,omitempty"`
+	SchemaVersion *int                `json:"schema_version,omitempty"`
+}
+
+// ExportRun is a single execution of an export job
+type ExportRun struct {
+	ID            string       `json:"id"`
+	JobID         string       `json:"job_id"`
+	Mode          string       `json:"mode"`
+	Status        string       `json:"status"`
+	SchemaVersion int          `json:"schema_version"`
+	Since         string       `json:"since,omitempty"`
+	Watermark     string       `json:"watermark,omitempty"`
+	Rows          int64        `json:"rows"`
+	Bytes         int64        `json:"bytes"`
+	Files         []ExportFile `json:"files,omitempty"`
+	Error         *Error       `json:"error,omitempty"`
+	StartedAt     *time.Time   `json:"started_at,omitempty"`
+	CompletedAt   *time.Time   `json:"completed_at,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// Done reports whether the run has finished, successfully or not
+func (r *ExportRun) Done() bool {
+	return r.Status == ExportRunSucceeded || r.Status == ExportRunFailed
+}
+
+// ExportFile is a Parquet file written by a run
+type ExportFile struct {
+	Path      string `json:"path"`
+	Partition string `json:"partition,omitempty"`
+	Rows      int64  `json:"rows"`
+	Bytes     int64  `json:"bytes"`
+}
+
+// StartExportRunParams contains parameters for starting an export run
+type StartExportRunParams struct {
+	Mode string `json:"mode"`
+	// SchemaVersion selects the dataset schema to write; defaults to the
+	// job's schema version
+	SchemaVersion int `json:"schema_version,omitempty"`
+}
+
+// ExportSchema is the current column layout of a dataset. Version increases
+// whenever columns are added, removed or change type.
+type ExportSchema struct {
+	Dataset string        `json:"dataset"`
+	Version int           `json:"version"`
+	Fields  []ExportField `json:"fields"`
+}
+
+// ExportField is a column of an export dataset
+type ExportField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+}
+
+// ExportRunListResponse contains export runs, newest first, with cursor pagination
+type ExportRunListResponse struct {
+	Data       []ExportRun      `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// ListJobs retrieves all export jobs
+func (s *ExportsService) ListJobs(ctx context.Context) ([]ExportJob, error) {
+	data, err := s.client.get(ctx, "/exports/jobs", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []ExportJob
+	if err := s.client.decode(data, &jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// CreateJob creates a new export job
+func (s *ExportsService) CreateJob(ctx context.Context, params *CreateExportJobParams) (*ExportJob, error) {
+	data, err := s.client.post(ctx, "/exports/jobs", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job ExportJob
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// GetJob retrieves an export job by ID
+func (s *ExportsService) GetJob(ctx context.Context, jobID string) (*ExportJob, error) {
+	data, err := s.client.get(ctx, "/exports/jobs/"+jobID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job ExportJob
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// UpdateJob updates an export job
+func (s *ExportsService) UpdateJob(ctx context.Context, jobID string, params *UpdateExportJobParams) (*ExportJob, error) {
+	data, err := s.client.patch(ctx, "/exports/jobs/"+jobID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job ExportJob
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// DeleteJob deletes an export job. Files already exported are kept.
+func (s *ExportsService) DeleteJob(ctx context.Context, jobID string) error {
+	return s.client.delete(ctx, "/exports/jobs/"+jobID, nil)
+}
+
+// StartRun starts an export run. Incremental runs export rows changed since
+// the job's watermark.
+func (s *ExportsService) StartRun(ctx context.Context, jobID string, params *StartExportRunParams, opts *RequestOptions) (*ExportRun, error) {
+	data, err := s.client.post(ctx, "/exports/jobs/"+jobID+"/runs", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var run ExportRun
+	if err := s.client.decode(data, &run); err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// GetRun retrieves an export run
+func (s *ExportsService) GetRun(ctx context.Context, jobID, runID string) (*ExportRun, error) {
+	data, err := s.client.get(ctx, "/exports/jobs/"+jobID+"/runs/"+runID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var run ExportRun
+	if err := s.client.decode(data, &run); err != nil {
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+// ListRuns retrieves the runs of an export job
+func (s *ExportsService) ListRuns(ctx context.Context, jobID string, params *ListParams) (*ExportRunListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/exports/jobs/"+jobID+"/runs", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ExportRunListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Schema retrieves the current schema of an export dataset
+func (s *ExportsService) Schema(ctx context.Context, dataset string) (*ExportSchema, error) {
+	data, err := s.client.get(ctx, "/exports/schemas/"+dataset, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema ExportSchema
+	if err := s.client.decode(data, &schema); err != nil {
+		return nil, err
+	}
+
+	return &schema, nil
+}
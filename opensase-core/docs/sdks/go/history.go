@@ -0,0 +1,103 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Configuration History
+// =============================================================================
+
+// AsOf returns a context under which reads of versioned resources (sites,
+// policies) return the configuration as it was at t. Services without
+// history support ignore it.
+func AsOf(ctx context.Context, t time.Time) context.Context {
+	return withCallOptions(ctx, func(o *callOptions) {
+		o.asOf = &t
+	})
+}
+
+func setAsOf(ctx context.Context, v url.Values) {
+	if t := callOptionsFrom(ctx).asOf; t != nil {
+		v.Set("as_of", t.UTC().Format(time.RFC3339))
+	}
+}
+
+// ChangeRecord is a single entry in a resource's change log
+type ChangeRecord struct {
+	ID           string        `json:"id"`
+	ResourceType string        `json:"resource_type"`
+	ResourceID   string        `json:"resource_id"`
+	Action       string        `json:"action"`
+	Version      int           `json:"version"`
+	Actor        ChangeActor   `json:"actor"`
+	Diff         []FieldChange `json:"diff,omitempty"`
+	Comment      string        `json:"comment,omitempty"`
+	RequestID    string        `json:"request_id,omitempty"`
+	Timestamp    time.Time     `json:"timestamp"`
+}
+
+// ChangeActor identifies who made a change
+type ChangeActor struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Email     string `json:"email,omitempty"`
+	Name      string `json:"name,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+	// OnBehalfOf is set when an admin tool acted for an end user
+	OnBehalfOf string `json:"on_behalf_of,omitempty"`
+}
+
+// FieldChange describes how a single field changed
+type FieldChange struct {
+	Path     string      `json:"path"`
+	OldValue interface{} `json:"old_value,omitempty"`
+	NewValue interface{} `json:"new_value,omitempty"`
+}
+
+// HistoryParams contains parameters for listing a change log
+type HistoryParams struct {
+	Since  *time.Time `json:"since,omitempty"`
+	Until  *time.Time `json:"until,omitempty"`
+	Limit  int        `json:"limit,omitempty"`
+	Cursor string     `json:"cursor,omitempty"`
+}
+
+// ChangeLog contains change records, newest first, with cursor pagination
+type ChangeLog struct {
+	Data       []ChangeRecord   `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+func (c *Client) history(ctx context.Context, path string, params *HistoryParams) (*ChangeLog, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Since != nil {
+			v.Set("since", params.Since.UTC().Format(time.RFC3339))
+		}
+		if params.Until != nil {
+			v.Set("until", params.Until.UTC().Format(time.RFC3339))
+		}
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+	}
+
+	data, err := c.get(ctx, path, v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var log ChangeLog
+	if err := c.decode(data, &log); err != nil {
+		return nil, err
+	}
+
+	return &log, nil
+}
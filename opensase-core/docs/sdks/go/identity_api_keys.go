@@ -0,0 +1,146 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// API Keys
+// =============================================================================
+
+// APIKeysService provides access to scoped API key management. The secret
+// of a key is only returned when the key is created or rotated.
+type APIKeysService struct {
+	client *Client
+}
+
+// APIKey is a scoped API key. Prefix is the non-secret leading part of the
+// key, shown in the console to identify it.
+type APIKey struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Permissions []string   `json:"permissions"`
+	AllowedIPs  []string   `json:"allowed_ips,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RotatedAt   *time.Time `json:"rotated_at,omitempty"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// Expired reports whether the key has passed its expiry time
+func (k *APIKey) Expired() bool {
+	return k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)
+}
+
+// APIKeySecret is an API key together with its secret, returned only by
+// Create and Rotate
+type APIKeySecret struct {
+	APIKey
+	Secret string `json:"secret"`
+}
+
+// CreateAPIKeyParams contains parameters for creating an API key.
+// AllowedIPs takes addresses or CIDR ranges; empty allows any source.
+type CreateAPIKeyParams struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	AllowedIPs  []string   `json:"allowed_ips,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// UpdateAPIKeyParams contains parameters for updating an API key
+type UpdateAPIKeyParams struct {
+	Name        *string    `json:"name,omitempty"`
+	Permissions *[]string  `json:"permissions,omitempty"`
+	AllowedIPs  *[]string  `json:"allowed_ips,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// RotateAPIKeyParams contains parameters for rotating an API key.
+// GracePeriodSeconds keeps the previous secret valid for that long.
+type RotateAPIKeyParams struct {
+	GracePeriodSeconds int `json:"grace_period_seconds,omitempty"`
+}
+
+// List retrieves all API keys of the tenant
+func (s *APIKeysService) List(ctx context.Context) ([]APIKey, error) {
+	data, err := s.client.get(ctx, "/identity/api_keys", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []APIKey
+	if err := s.client.decode(data, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// Create creates an API key. The returned secret cannot be retrieved again.
+func (s *APIKeysService) Create(ctx context.Context, params *CreateAPIKeyParams) (*APIKeySecret, error) {
+	data, err := s.client.post(ctx, "/identity/api_keys", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKeySecret
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Get retrieves an API key by ID
+func (s *APIKeysService) Get(ctx context.Context, keyID string) (*APIKey, error) {
+	data, err := s.client.get(ctx, "/identity/api_keys/"+keyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Update updates an API key. Permissions and AllowedIPs replace the existing lists.
+func (s *APIKeysService) Update(ctx context.Context, keyID string, params *UpdateAPIKeyParams) (*APIKey, error) {
+	data, err := s.client.patch(ctx, "/identity/api_keys/"+keyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Rotate issues a new secret for an API key, keeping its ID and scope
+func (s *APIKeysService) Rotate(ctx context.Context, keyID string, params *RotateAPIKeyParams) (*APIKeySecret, error) {
+	data, err := s.client.post(ctx, "/identity/api_keys/"+keyID+"/rotate", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKeySecret
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Revoke revokes an API key immediately
+func (s *APIKeysService) Revoke(ctx context.Context, keyID string) error {
+	return s.client.delete(ctx, "/identity/api_keys/"+keyID, nil)
+}
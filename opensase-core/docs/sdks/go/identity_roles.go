@@ -0,0 +1,192 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Admin Roles & Role Assignments
+// =============================================================================
+
+// Role assignment principal types
+const (
+	PrincipalUser  = "user"
+	PrincipalGroup = "group"
+)
+
+// RolesService provides access to custom administrative roles and their
+// assignment to users and groups. Built-in roles can be assigned but not
+// modified. Catalog.Roles serves a cached copy of the same roles.
+type RolesService struct {
+	client *Client
+}
+
+// RoleAssignment grants a role to a user or group. SiteIDs scopes the
+// role's permissions to the listed sites; empty grants them tenant-wide.
+type RoleAssignment struct {
+	ID            string    `json:"id"`
+	RoleID        string    `json:"role_id"`
+	PrincipalType string    `json:"principal_type"`
+	PrincipalID   string    `json:"principal_id"`
+	SiteIDs       []string  `json:"site_ids,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CreateRoleParams contains parameters for creating a custom role
+type CreateRoleParams struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	Modules     []string `json:"modules,omitempty"`
+	ReadOnly    bool     `json:"read_only,omitempty"`
+}
+
+// UpdateRoleParams contains parameters for updating a custom role
+type UpdateRoleParams struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Permissions *[]string `json:"permissions,omitempty"`
+	Modules     *[]string `json:"modules,omitempty"`
+	ReadOnly    *bool     `json:"read_only,omitempty"`
+}
+
+// CreateRoleAssignmentParams contains parameters for assigning a role
+type CreateRoleAssignmentParams struct {
+	RoleID        string   `json:"role_id"`
+	PrincipalType string   `json:"principal_type"`
+	PrincipalID   string   `json:"principal_id"`
+	SiteIDs       []string `json:"site_ids,omitempty"`
+}
+
+// ListRoleAssignmentsParams filters role assignments
+type ListRoleAssignmentsParams struct {
+	RoleID      string `json:"role_id,omitempty"`
+	PrincipalID string `json:"principal_id,omitempty"`
+}
+
+// List retrieves all roles, built-in and custom
+func (s *RolesService) List(ctx context.Context) ([]Role, error) {
+	data, err := s.client.get(ctx, "/identity/roles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []Role
+	if err := s.client.decode(data, &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// Create creates a custom role
+func (s *RolesService) Create(ctx context.Context, params *CreateRoleParams) (*Role, error) {
+	data, err := s.client.post(ctx, "/identity/roles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var role Role
+	if err := s.client.decode(data, &role); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// Get retrieves a role by ID
+func (s *RolesService) Get(ctx context.Context, roleID string) (*Role, error) {
+	data, err := s.client.get(ctx, "/identity/roles/"+roleID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var role Role
+	if err := s.client.decode(data, &role); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// Update updates a custom role. Permissions and Modules replace the existing lists.
+func (s *RolesService) Update(ctx context.Context, roleID string, params *UpdateRoleParams) (*Role, error) {
+	data, err := s.client.patch(ctx, "/identity/roles/"+roleID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var role Role
+	if err := s.client.decode(data, &role); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// Delete deletes a custom role. Roles that are still assigned cannot be deleted.
+func (s *RolesService) Delete(ctx context.Context, roleID string) error {
+	return s.client.delete(ctx, "/identity/roles/"+roleID, nil)
+}
+
+// ListAssignments retrieves role assignments, optionally filtered by role or principal
+func (s *RolesService) ListAssignments(ctx context.Context, params *ListRoleAssignmentsParams) ([]RoleAssignment, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.RoleID != "" {
+			v.Set("role_id", params.RoleID)
+		}
+		if params.PrincipalID != "" {
+			v.Set("principal_id", params.PrincipalID)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/identity/role_assignments", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []RoleAssignment
+	if err := s.client.decode(data, &assignments); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// Assign grants a role to a user or group
+func (s *RolesService) Assign(ctx context.Context, params *CreateRoleAssignmentParams) (*RoleAssignment, error) {
+	data, err := s.client.post(ctx, "/identity/role_assignments", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignment RoleAssignment
+	if err := s.client.decode(data, &assignment); err != nil {
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+// GetAssignment retrieves a role assignment by ID
+func (s *RolesService) GetAssignment(ctx context.Context, assignmentID string) (*RoleAssignment, error) {
+	data, err := s.client.get(ctx, "/identity/role_assignments/"+assignmentID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignment RoleAssignment
+	if err := s.client.decode(data, &assignment); err != nil {
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+// Unassign revokes a role assignment
+func (s *RolesService) Unassign(ctx context.Context, assignmentID string) error {
+	return s.client.delete(ctx, "/identity/role_assignments/"+assignmentID, nil)
+}
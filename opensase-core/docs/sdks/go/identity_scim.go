@@ -0,0 +1,134 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// SCIM Provisioning
+// =============================================================================
+
+// SCIM deprovisioning actions, applied when the IdP removes a user
+const (
+	SCIMDeprovisionSuspend = "suspend"
+	SCIMDeprovisionDelete  = "delete"
+)
+
+// SCIMService provides access to the tenant's SCIM 2.0 provisioning endpoint,
+// which identity providers such as Okta and Azure AD use to push users and
+// groups. The bearer token is only returned when provisioning is enabled or
+// the token is rotated.
+type SCIMService struct {
+	client *Client
+}
+
+// SCIMConfig is the tenant's SCIM provisioning configuration. BaseURL and
+// the bearer token are entered in the identity provider.
+type SCIMConfig struct {
+	Enabled           bool       `json:"enabled"`
+	BaseURL           string     `json:"base_url"`
+	IdPID             string     `json:"idp_id,omitempty"`
+	SyncGroups        bool       `json:"sync_groups"`
+	DeprovisionAction string     `json:"deprovision_action"`
+	TokenPrefix       string     `json:"token_prefix,omitempty"`
+	TokenExpiresAt    *time.Time `json:"token_expires_at,omitempty"`
+	LastSyncAt        *time.Time `json:"last_sync_at,omitempty"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// SCIMConfigToken is the SCIM configuration together with its bearer
+// token, returned only by Enable and RotateToken
+type SCIMConfigToken struct {
+	SCIMConfig
+	Token string `json:"token"`
+}
+
+// EnableSCIMParams contains parameters for enabling SCIM provisioning.
+// IdPID links provisioned users to an identity provider for sign-in.
+// TokenLifetimeDays of zero issues a token that does not expire.
+type EnableSCIMParams struct {
+	IdPID             string `json:"idp_id,omitempty"`
+	SyncGroups        *bool  `json:"sync_groups,omitempty"`
+	DeprovisionAction string `json:"deprovision_action,omitempty"`
+	TokenLifetimeDays int    `json:"token_lifetime_days,omitempty"`
+}
+
+// UpdateSCIMParams contains parameters for updating SCIM provisioning
+type UpdateSCIMParams struct {
+	IdPID             *string `json:"idp_id,omitempty"`
+	SyncGroups        *bool   `json:"sync_groups,omitempty"`
+	DeprovisionAction *string `json:"deprovision_action,omitempty"`
+}
+
+// RotateSCIMTokenParams contains parameters for rotating the SCIM token
+type RotateSCIMTokenParams struct {
+	TokenLifetimeDays int `json:"token_lifetime_days,omitempty"`
+}
+
+// Get retrieves the SCIM provisioning configuration
+func (s *SCIMService) Get(ctx context.Context) (*SCIMConfig, error) {
+	data, err := s.client.get(ctx, "/identity/scim", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config SCIMConfig
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Enable enables SCIM provisioning and issues a bearer token. The token
+// cannot be retrieved again.
+func (s *SCIMService) Enable(ctx context.Context, params *EnableSCIMParams) (*SCIMConfigToken, error) {
+	data, err := s.client.post(ctx, "/identity/scim", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config SCIMConfigToken
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Update updates the SCIM provisioning configuration
+func (s *SCIMService) Update(ctx context.Context, params *UpdateSCIMParams) (*SCIMConfig, error) {
+	data, err := s.client.patch(ctx, "/identity/scim", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config SCIMConfig
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// RotateToken issues a new bearer token and revokes the previous one
+func (s *SCIMService) RotateToken(ctx context.Context, params *RotateSCIMTokenParams) (*SCIMConfigToken, error) {
+	data, err := s.client.post(ctx, "/identity/scim/token", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config SCIMConfigToken
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Disable disables SCIM provisioning and revokes the bearer token.
+// Provisioned users are kept.
+func (s *SCIMService) Disable(ctx context.Context) error {
+	return s.client.delete(ctx, "/identity/scim", nil)
+}
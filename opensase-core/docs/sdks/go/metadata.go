@@ -0,0 +1,96 @@
+package opensase
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+)
+
+// =============================================================================
+// Metadata Queries
+// =============================================================================
+
+// MetadataQuery selects listed objects by their metadata, so integrations
+// can find the objects they tagged. Conditions are combined with AND; keys
+// and values are compared exactly.
+//
+//	users, err := client.Identity.Users.List(ctx, &opensase.ListUsersParams{
+//	    Metadata: opensase.NewMetadataQuery().Equals("crm_id", "c_123").Has("imported_at"),
+//	})
+//
+// It is sent as metadata[key]=value and has_metadata=key query parameters.
+type MetadataQuery struct {
+	has    []string
+	equals map[string]string
+}
+
+// NewMetadataQuery returns an empty query, which matches every object. The
+// zero MetadataQuery is also empty and ready to use.
+func NewMetadataQuery() *MetadataQuery {
+	return &MetadataQuery{}
+}
+
+// Has requires the metadata to contain key, with any value
+func (q *MetadataQuery) Has(key string) *MetadataQuery {
+	q.has = append(q.has, key)
+	return q
+}
+
+// Equals requires the metadata value of key to be value. Metadata values
+// that are not strings compare by their JSON text, e.g. "42" or "true".
+func (q *MetadataQuery) Equals(key, value string) *MetadataQuery {
+	if q.equals == nil {
+		q.equals = map[string]string{}
+	}
+	q.equals[key] = value
+	return q
+}
+
+// Matches reports whether metadata satisfies the query, e.g. to filter
+// objects returned by APIs that do not accept metadata queries
+func (q *MetadataQuery) Matches(metadata map[string]interface{}) bool {
+	if q == nil {
+		return true
+	}
+	for _, key := range q.has {
+		if _, ok := metadata[key]; !ok {
+			return false
+		}
+	}
+	for key, want := range q.equals {
+		got, ok := metadata[key]
+		if !ok || metadataString(got) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// encode adds the query's parameters to v in a stable order
+func (q *MetadataQuery) encode(v url.Values) {
+	if q == nil {
+		return
+	}
+	for _, key := range q.has {
+		v.Add("has_metadata", key)
+	}
+	keys := make([]string, 0, len(q.equals))
+	for key := range q.equals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		v.Set("metadata["+key+"]", q.equals[key])
+	}
+}
+
+// metadataString formats a decoded metadata value as the API compares it
+func metadataString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
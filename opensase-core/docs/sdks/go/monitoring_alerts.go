@@ -0,0 +1,309 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Alerting
+// =============================================================================
+
+// Alert rule types. Metric rules compare an aggregated metric against the
+// threshold; event rules count matching events.
+const (
+	AlertRuleMetric = "metric"
+	AlertRuleEvent  = "event"
+)
+
+// Alert severities
+const (
+	AlertSeverityCritical = "critical"
+	AlertSeverityWarning  = "warning"
+	AlertSeverityInfo     = "info"
+)
+
+// Alert condition operators
+const (
+	AlertOpGreaterThan        = "gt"
+	AlertOpGreaterThanOrEqual = "gte"
+	AlertOpLessThan           = "lt"
+	AlertOpLessThanOrEqual    = "lte"
+	AlertOpEqual              = "eq"
+)
+
+// Notification channel types
+const (
+	ChannelEmail     = "email"
+	ChannelSlack     = "slack"
+	ChannelPagerDuty = "pagerduty"
+	ChannelWebhook   = "webhook"
+)
+
+// AlertsService provides access to alert rules and the notification
+// channels they deliver to
+type AlertsService struct {
+	client *Client
+}
+
+// AlertCondition fires a rule when the aggregated value compared with
+// Threshold holds for DurationSeconds. Event rules always aggregate with
+// count, over a window of DurationSeconds.
+type AlertCondition struct {
+	Aggregation     string  `json:"aggregation,omitempty"`
+	Operator        string  `json:"operator"`
+	Threshold       float64 `json:"threshold"`
+	DurationSeconds int     `json:"duration_seconds"`
+}
+
+// AlertRule raises an alert on a metric or event condition and notifies
+// its channels. Filters narrow the metric or events, e.g. site_id.
+type AlertRule struct {
+	ID                    string            `json:"id"`
+	Name                  string            `json:"name"`
+	Description           string            `json:"description,omitempty"`
+	Type                  string            `json:"type"`
+	Metric                string            `json:"metric,omitempty"`
+	EventType             string            `json:"event_type,omitempty"`
+	Filters               map[string]string `json:"filters,omitempty"`
+	Condition             AlertCondition    `json:"condition"`
+	Severity              string            `json:"severity"`
+	ChannelIDs            []string          `json:"channel_ids"`
+	NotifyOnResolve       bool              `json:"notify_on_resolve"`
+	RepeatIntervalMinutes int               `json:"repeat_interval_minutes,omitempty"`
+	Enabled               bool              `json:"enabled"`
+	State                 string            `json:"state,omitempty"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+}
+
+// CreateAlertRuleParams contains parameters for creating an alert rule.
+// Metric is required for metric rules and EventType for event rules.
+type CreateAlertRuleParams struct {
+	Name                  string            `json:"name"`
+	Description           string            `json:"description,omitempty"`
+	Type                  string            `json:"type"`
+	Metric                string            `json:"metric,omitempty"`
+	EventType             string            `json:"event_type,omitempty"`
+	Filters               map[string]string `json:"filters,omitempty"`
+	Condition             AlertCondition    `json:"condition"`
+	Severity              string            `json:"severity"`
+	ChannelIDs            []string          `json:"channel_ids"`
+	NotifyOnResolve       bool              `json:"notify_on_resolve,omitempty"`
+	RepeatIntervalMinutes int               `json:"repeat_interval_minutes,omitempty"`
+	Enabled               *bool             `json:"enabled,omitempty"`
+}
+
+// UpdateAlertRuleParams contains parameters for updating an alert rule. The
+// type, metric and event type of a rule cannot be changed. Filters and
+// ChannelIDs replace the existing values.
+type UpdateAlertRuleParams struct {
+	Name                  *string            `json:"name,omitempty"`
+	Description           *string            `json:"description,omitempty"`
+	Filters               *map[string]string `json:"filters,omitempty"`
+	Condition             *AlertCondition    `json:"condition,omitempty"`
+	Severity              *string            `json:"severity,omitempty"`
+	ChannelIDs            *[]string          `json:"channel_ids,omitempty"`
+	NotifyOnResolve       *bool              `json:"notify_on_resolve,omitempty"`
+	RepeatIntervalMinutes *int               `json:"repeat_interval_minutes,omitempty"`
+	Enabled               *bool              `json:"enabled,omitempty"`
+}
+
+// NotificationChannel is a destination alerts are delivered to. Exactly one
+// of the type-specific configs is set, matching Type. Secrets such as
+// webhook URLs and routing keys are write-only and never returned.
+type NotificationChannel struct {
+	ID        string                  `json:"id"`
+	Name      string                  `json:"name"`
+	Type      string                  `json:"type"`
+	Email     *EmailChannelConfig     `json:"email,omitempty"`
+	Slack     *SlackChannelConfig     `json:"slack,omitempty"`
+	PagerDuty *PagerDutyChannelConfig `json:"pagerduty,omitempty"`
+	Webhook   *WebhookChannelConfig   `json:"webhook,omitempty"`
+	Enabled   bool                    `json:"enabled"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+// EmailChannelConfig delivers alerts by email
+type EmailChannelConfig struct {
+	Addresses []string `json:"addresses"`
+}
+
+// SlackChannelConfig delivers alerts to a Slack incoming webhook
+type SlackChannelConfig struct {
+	WebhookURL string `json:"webhook_url,omitempty"`
+	Channel    string `json:"channel,omitempty"`
+}
+
+// PagerDutyChannelConfig delivers alerts to a PagerDuty Events API v2 service
+type PagerDutyChannelConfig struct {
+	RoutingKey string `json:"routing_key,omitempty"`
+}
+
+// WebhookChannelConfig delivers alerts as JSON POSTs. With Secret set,
+// deliveries are signed like API webhooks.
+type WebhookChannelConfig struct {
+	URL     string            `json:"url"`
+	Secret  string            `json:"secret,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// CreateNotificationChannelParams contains parameters for creating a notification channel
+type CreateNotificationChannelParams struct {
+	Name      string                  `json:"name"`
+	Type      string                  `json:"type"`
+	Email     *EmailChannelConfig     `json:"email,omitempty"`
+	Slack     *SlackChannelConfig     `json:"slack,omitempty"`
+	PagerDuty *PagerDutyChannelConfig `json:"pagerduty,omitempty"`
+	Webhook   *WebhookChannelConfig   `json:"webhook,omitempty"`
+	Enabled   *bool                   `json:"enabled,omitempty"`
+}
+
+// UpdateNotificationChannelParams contains parameters for updating a
+// notification channel. A type-specific config replaces the existing one.
+type UpdateNotificationChannelParams struct {
+	Name      *string                 `json:"name,omitempty"`
+	Email     *EmailChannelConfig     `json:"email,omitempty"`
+	Slack     *SlackChannelConfig     `json:"slack,omitempty"`
+	PagerDuty *PagerDutyChannelConfig `json:"pagerduty,omitempty"`
+	Webhook   *WebhookChannelConfig   `json:"webhook,omitempty"`
+	Enabled   *bool                   `json:"enabled,omitempty"`
+}
+
+// ListRules retrieves all alert rules
+func (s *AlertsService) ListRules(ctx context.Context) ([]AlertRule, error) {
+	data, err := s.client.get(ctx, "/monitoring/alert_rules", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []AlertRule
+	if err := s.client.decode(data, &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// CreateRule creates a new alert rule
+func (s *AlertsService) CreateRule(ctx context.Context, params *CreateAlertRuleParams) (*AlertRule, error) {
+	data, err := s.client.post(ctx, "/monitoring/alert_rules", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule AlertRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// GetRule retrieves an alert rule by ID
+func (s *AlertsService) GetRule(ctx context.Context, ruleID string) (*AlertRule, error) {
+	data, err := s.client.get(ctx, "/monitoring/alert_rules/"+ruleID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule AlertRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// UpdateRule updates an alert rule
+func (s *AlertsService) UpdateRule(ctx context.Context, ruleID string, params *UpdateAlertRuleParams) (*AlertRule, error) {
+	data, err := s.client.patch(ctx, "/monitoring/alert_rules/"+ruleID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule AlertRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// DeleteRule deletes an alert rule; open alerts it raised are resolved
+func (s *AlertsService) DeleteRule(ctx context.Context, ruleID string) error {
+	return s.client.delete(ctx, "/monitoring/alert_rules/"+ruleID, nil)
+}
+
+// ListChannels retrieves all notification channels
+func (s *AlertsService) ListChannels(ctx context.Context) ([]NotificationChannel, error) {
+	data, err := s.client.get(ctx, "/monitoring/notification_channels", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []NotificationChannel
+	if err := s.client.decode(data, &channels); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// CreateChannel creates a new notification channel
+func (s *AlertsService) CreateChannel(ctx context.Context, params *CreateNotificationChannelParams) (*NotificationChannel, error) {
+	data, err := s.client.post(ctx, "/monitoring/notification_channels", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var channel NotificationChannel
+	if err := s.client.decode(data, &channel); err != nil {
+		return nil, err
+	}
+
+	return &channel, nil
+}
+
+// GetChannel retrieves a notification channel by ID
+func (s *AlertsService) GetChannel(ctx context.Context, channelID string) (*NotificationChannel, error) {
+	data, err := s.client.get(ctx, "/monitoring/notification_channels/"+channelID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var channel NotificationChannel
+	if err := s.client.decode(data, &channel); err != nil {
+		return nil, err
+	}
+
+	return &channel, nil
+}
+
+// UpdateChannel updates a notification channel
+func (s *AlertsService) UpdateChannel(ctx context.Context, channelID string, params *UpdateNotificationChannelParams) (*NotificationChannel, error) {
+	data, err := s.client.patch(ctx, "/monitoring/notification_channels/"+channelID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var channel NotificationChannel
+	if err := s.client.decode(data, &channel); err != nil {
+		return nil, err
+	}
+
+	return &channel, nil
+}
+
+// DeleteChannel deletes a notification channel. Channels referenced by an
+// alert rule cannot be deleted.
+func (s *AlertsService) DeleteChannel(ctx context.Context, channelID string) error {
+	return s.client.delete(ctx, "/monitoring/notification_channels/"+channelID, nil)
+}
+
+// TestChannel sends a test notification through a channel
+func (s *AlertsService) TestChannel(ctx context.Context, channelID string) error {
+	_, err := s.client.post(ctx, "/monitoring/notification_channels/"+channelID+"/test", nil, nil)
+	return err
+}
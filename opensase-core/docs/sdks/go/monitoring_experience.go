@@ -0,0 +1,228 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Digital Experience Monitoring
+// =============================================================================
+
+// Experience score contributing factor segments
+const (
+	SegmentDevice    = "device"
+	SegmentWiFi      = "wifi"
+	SegmentLAN       = "lan"
+	SegmentWAN       = "wan"
+	SegmentPoP       = "pop"
+	SegmentAppServer = "app_server"
+)
+
+// ExperienceService provides access to digital experience monitoring (DEM) APIs
+type ExperienceService struct {
+	client *Client
+}
+
+// ExperienceScore is a user's experience of an application, from 0 (unusable) to 100
+type ExperienceScore struct {
+	UserID    string                 `json:"user_id"`
+	UserEmail string                 `json:"user_email,omitempty"`
+	AppID     string                 `json:"app_id"`
+	AppName   string                 `json:"app_name,omitempty"`
+	Score     float64                `json:"score"`
+	Grade     string                 `json:"grade"`
+	Factors   []ExperienceFactor     `json:"factors,omitempty"`
+	Trend     []ExperienceTrendPoint `json:"trend,omitempty"`
+	SiteID    string                 `json:"site_id,omitempty"`
+	PoPID     string                 `json:"pop_id,omitempty"`
+	From      time.Time              `json:"from"`
+	To        time.Time              `json:"to"`
+}
+
+// ExperienceFactor is how much one network segment lowered a score
+type ExperienceFactor struct {
+	Segment string             `json:"segment"`
+	Impact  float64            `json:"impact"`
+	Summary string             `json:"summary,omitempty"`
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// ExperienceTrendPoint is a score at a point in time
+type ExperienceTrendPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Score     float64   `json:"score"`
+}
+
+// ListExperienceScoresParams contains parameters for listing experience scores
+type ListExperienceScoresParams struct {
+	Limit    int        `json:"limit,omitempty"`
+	Cursor   string     `json:"cursor,omitempty"`
+	UserID   *string    `json:"user_id,omitempty"`
+	AppID    *string    `json:"app_id,omitempty"`
+	SiteID   *string    `json:"site_id,omitempty"`
+	MaxScore *float64   `json:"max_score,omitempty"`
+	From     *time.Time `json:"from,omitempty"`
+	To       *time.Time `json:"to,omitempty"`
+}
+
+// ExperienceScoreListResponse contains experience scores with cursor pagination
+type ExperienceScoreListResponse struct {
+	Data       []ExperienceScore `json:"data"`
+	Pagination CursorPagination  `json:"pagination"`
+}
+
+// ExperienceTrace is a hop-by-hop measurement of a single user session to an app
+type ExperienceTrace struct {
+	ID         string        `json:"id"`
+	UserID     string        `json:"user_id"`
+	AppID      string        `json:"app_id"`
+	DeviceID   string        `json:"device_id,omitempty"`
+	Hops       []TraceHop    `json:"hops"`
+	Device     *DeviceHealth `json:"device,omitempty"`
+	CapturedAt time.Time     `json:"captured_at"`
+}
+
+// TraceHop is a single hop of an experience trace. Timings are in milliseconds.
+type TraceHop struct {
+	Segment    string  `json:"segment"`
+	Name       string  `json:"name,omitempty"`
+	Address    string  `json:"address,omitempty"`
+	LatencyMs  float64 `json:"latency_ms"`
+	JitterMs   float64 `json:"jitter_ms,omitempty"`
+	PacketLoss float64 `json:"packet_loss,omitempty"`
+}
+
+// DeviceHealth describes the endpoint and its local network during a trace
+type DeviceHealth struct {
+	CPUPercent     float64 `json:"cpu_percent,omitempty"`
+	MemoryPercent  float64 `json:"memory_percent,omitempty"`
+	WiFiSSID       string  `json:"wifi_ssid,omitempty"`
+	WiFiSignalDBm  int     `json:"wifi_signal_dbm,omitempty"`
+	WiFiChannel    int     `json:"wifi_channel,omitempty"`
+	ConnectionType string  `json:"connection_type,omitempty"`
+}
+
+// ListTracesParams contains parameters for listing experience traces
+type ListTracesParams struct {
+	Limit  int        `json:"limit,omitempty"`
+	Cursor string     `json:"cursor,omitempty"`
+	AppID  *string    `json:"app_id,omitempty"`
+	From   *time.Time `json:"from,omitempty"`
+	To     *time.Time `json:"to,omitempty"`
+}
+
+// TraceListResponse contains experience traces with cursor pagination
+type TraceListResponse struct {
+	Data       []ExperienceTrace `json:"data"`
+	Pagination CursorPagination  `json:"pagination"`
+}
+
+// ListScores retrieves per-user, per-app experience scores, worst first
+func (s *ExperienceService) ListScores(ctx context.Context, params *ListExperienceScoresParams) (*ExperienceScoreListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+		if params.UserID != nil {
+			v.Set("user_id", *params.UserID)
+		}
+		if params.AppID != nil {
+			v.Set("app_id", *params.AppID)
+		}
+		if params.SiteID != nil {
+			v.Set("site_id", *params.SiteID)
+		}
+		if params.MaxScore != nil {
+			v.Set("max_score", strconv.FormatFloat(*params.MaxScore, 'f', -1, 64))
+		}
+		if params.From != nil {
+			v.Set("from", params.From.UTC().Format(time.RFC3339))
+		}
+		if params.To != nil {
+			v.Set("to", params.To.UTC().Format(time.RFC3339))
+		}
+	}
+
+	data, err := s.client.get(ctx, "/monitoring/experience/scores", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ExperienceScoreListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetScore retrieves a user's experience score for an app with its contributing factors and trend
+func (s *ExperienceService) GetScore(ctx context.Context, userID, appID string) (*ExperienceScore, error) {
+	data, err := s.client.get(ctx, "/monitoring/experience/users/"+userID+"/apps/"+appID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var score ExperienceScore
+	if err := s.client.decode(data, &score); err != nil {
+		return nil, err
+	}
+
+	return &score, nil
+}
+
+// ListTraces retrieves drill-down traces for a user
+func (s *ExperienceService) ListTraces(ctx context.Context, userID string, params *ListTracesParams) (*TraceListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+		if params.AppID != nil {
+			v.Set("app_id", *params.AppID)
+		}
+		if params.From != nil {
+			v.Set("from", params.From.UTC().Format(time.RFC3339))
+		}
+		if params.To != nil {
+			v.Set("to", params.To.UTC().Format(time.RFC3339))
+		}
+	}
+
+	data, err := s.client.get(ctx, "/monitoring/experience/users/"+userID+"/traces", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response TraceListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetTrace retrieves a single experience trace by ID
+func (s *ExperienceService) GetTrace(ctx context.Context, traceID string) (*ExperienceTrace, error) {
+	data, err := s.client.get(ctx, "/monitoring/experience/traces/"+traceID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var trace ExperienceTrace
+	if err := s.client.decode(data, &trace); err != nil {
+		return nil, err
+	}
+
+	return &trace, nil
+}
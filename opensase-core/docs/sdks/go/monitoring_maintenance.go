@@ -0,0 +1,152 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Maintenance Windows
+// =============================================================================
+
+// Maintenance window recurrence frequencies
+const (
+	RecurDaily   = "daily"
+	RecurWeekly  = "weekly"
+	RecurMonthly = "monthly"
+)
+
+// MaintenanceWindowsService provides access to the tenant's scheduled
+// maintenance windows. While a window is active, alerts of the suppressed
+// types are not raised for its sites and device upgrades may run.
+type MaintenanceWindowsService struct {
+	client *Client
+}
+
+// MaintenanceWindow is a one-off or recurring period of planned work.
+// Occurrences start at the local time of StartsAt in Timezone, so recurring
+// windows keep their wall-clock time across daylight saving changes.
+type MaintenanceWindow struct {
+	ID              string                 `json:"id"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description,omitempty"`
+	StartsAt        time.Time              `json:"starts_at"`
+	DurationMinutes int                    `json:"duration_minutes"`
+	Timezone        string                 `json:"timezone"`
+	Recurrence      *MaintenanceRecurrence `json:"recurrence,omitempty"`
+	SiteIDs         []string               `json:"site_ids,omitempty"`
+	// SuppressedAlertTypes are the metrics and event types of alert rules
+	// that do not fire during the window. Empty suppresses every alert for
+	// the window's sites.
+	SuppressedAlertTypes []string   `json:"suppressed_alert_types,omitempty"`
+	Active               bool       `json:"active"`
+	NextStartAt          *time.Time `json:"next_start_at,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+}
+
+// MaintenanceRecurrence repeats a window every Interval days, weeks or
+// months. Weekly windows occur on DaysOfWeek (mon through sun) and monthly
+// windows on DayOfMonth; a DayOfMonth of -1 is the last day of the month.
+// The window stops recurring after Until.
+type MaintenanceRecurrence struct {
+	Frequency  string     `json:"frequency"`
+	Interval   int        `json:"interval,omitempty"`
+	DaysOfWeek []string   `json:"days_of_week,omitempty"`
+	DayOfMonth int        `json:"day_of_month,omitempty"`
+	Until      *time.Time `json:"until,omitempty"`
+}
+
+// CreateMaintenanceWindowParams contains parameters for creating a
+// maintenance window. An empty SiteIDs covers every site.
+type CreateMaintenanceWindowParams struct {
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description,omitempty"`
+	StartsAt             time.Time              `json:"starts_at"`
+	DurationMinutes      int                    `json:"duration_minutes"`
+	Timezone             string                 `json:"timezone"`
+	Recurrence           *MaintenanceRecurrence `json:"recurrence,omitempty"`
+	SiteIDs              []string               `json:"site_ids,omitempty"`
+	SuppressedAlertTypes []string               `json:"suppressed_alert_types,omitempty"`
+}
+
+// UpdateMaintenanceWindowParams contains parameters for updating a
+// maintenance window. Recurrence and lists replace the existing values; use
+// ClearRecurrence to make a recurring window one-off.
+type UpdateMaintenanceWindowParams struct {
+	Name                 *string                `json:"name,omitempty"`
+	Description          *string                `json:"description,omitempty"`
+	StartsAt             *time.Time             `json:"starts_at,omitempty"`
+	DurationMinutes      *int                   `json:"duration_minutes,omitempty"`
+	Timezone             *string                `json:"timezone,omitempty"`
+	Recurrence           *MaintenanceRecurrence `json:"recurrence,omitempty"`
+	ClearRecurrence      bool                   `json:"clear_recurrence,omitempty"`
+	SiteIDs              *[]string              `json:"site_ids,omitempty"`
+	SuppressedAlertTypes *[]string              `json:"suppressed_alert_types,omitempty"`
+}
+
+// List retrieves all maintenance windows
+func (s *MaintenanceWindowsService) List(ctx context.Context) ([]MaintenanceWindow, error) {
+	data, err := s.client.get(ctx, "/monitoring/maintenance_windows", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var windows []MaintenanceWindow
+	if err := s.client.decode(data, &windows); err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+// Create creates a new maintenance window
+func (s *MaintenanceWindowsService) Create(ctx context.Context, params *CreateMaintenanceWindowParams) (*MaintenanceWindow, error) {
+	data, err := s.client.post(ctx, "/monitoring/maintenance_windows", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var window MaintenanceWindow
+	if err := s.client.decode(data, &window); err != nil {
+		return nil, err
+	}
+
+	return &window, nil
+}
+
+// Get retrieves a maintenance window by ID
+func (s *MaintenanceWindowsService) Get(ctx context.Context, windowID string) (*MaintenanceWindow, error) {
+	data, err := s.client.get(ctx, "/monitoring/maintenance_windows/"+windowID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var window MaintenanceWindow
+	if err := s.client.decode(data, &window); err != nil {
+		return nil, err
+	}
+
+	return &window, nil
+}
+
+// Update updates a maintenance window. Changes to an active window take
+// effect from its next occurrence.
+func (s *MaintenanceWindowsService) Update(ctx context.Context, windowID string, params *UpdateMaintenanceWindowParams) (*MaintenanceWindow, error) {
+	data, err := s.client.patch(ctx, "/monitoring/maintenance_windows/"+windowID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var window MaintenanceWindow
+	if err := s.client.decode(data, &window); err != nil {
+		return nil, err
+	}
+
+	return &window, nil
+}
+
+// Delete deletes a maintenance window, ending it if active
+func (s *MaintenanceWindowsService) Delete(ctx context.Context, windowID string) error {
+	return s.client.delete(ctx, "/monitoring/maintenance_windows/"+windowID, nil)
+}
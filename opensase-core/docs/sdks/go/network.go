@@ -0,0 +1,231 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/billyronks/opensase-go/enum"
+)
+
+// =============================================================================
+// Network Service
+// =============================================================================
+
+// NetworkService provides access to SD-WAN site and networking APIs
+type NetworkService struct {
+	client    *Client
+	Sites     *SitesService
+	Templates *SiteTemplatesService
+	Tunnels   *TunnelsService
+	WANLinks  *WANLinksService
+	VLANs     *VLANsService
+	DHCP      *DHCPServersService
+	SNMP      *SNMPService
+	Syslog    *SyslogServersService
+	NAT       *NATRulesService
+	Routes    *StaticRoutesService
+	BGP       *BGPPeersService
+	Traffic   *TrafficPoliciesService
+	QoS       *QoSProfilesService
+	Devices   *EdgeDevicesService
+	HAPairs   *HAPairsService
+	Hosts     *HostsService
+	ClientVPN *ClientVPNProfilesService
+	LAN       *LANService
+	Segments  *SegmentsService
+	Vouchers  *GuestVouchersService
+	Cellular  *CellularService
+}
+
+// SitesService provides access to site APIs
+type SitesService struct {
+	client *Client
+}
+
+// Site represents a branch, data center or cloud site. A site with a
+// TemplateID takes its security and QoS profiles from that site template.
+type Site struct {
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Location   string                 `json:"location"`
+	Status     enum.SiteStatus        `json:"status"`
+	TemplateID string                 `json:"template_id,omitempty"`
+	WANLinks   []WANLink              `json:"wan_links,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	Version    int                    `json:"version,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+// WANLink represents a WAN uplink of a site
+type WANLink struct {
+	ID               string             `json:"id,omitempty"`
+	Name             string             `json:"name"`
+	Type             enum.WANLinkType   `json:"type"`
+	Provider         string             `json:"provider,omitempty"`
+	BandwidthMbps    int                `json:"bandwidth_mbps,omitempty"`
+	FailoverPriority int                `json:"failover_priority,omitempty"`
+	Status           enum.WANLinkStatus `json:"status,omitempty"`
+}
+
+// DecommissionSiteParams contains parameters for decommissioning a site.
+// DrainTimeoutSeconds bounds how long existing sessions may keep using the
+// site's tunnels; it defaults to 300 when nil, and 0 cuts them off at once.
+type DecommissionSiteParams struct {
+	DrainTimeoutSeconds *int   `json:"drain_timeout_seconds,omitempty"`
+	Reason              string `json:"reason,omitempty"`
+}
+
+// CreateSiteParams contains parameters for creating a site. A site created
+// from a template without WANLinks gets the template's WAN links.
+type CreateSiteParams struct {
+	Name       string                 `json:"name"`
+	Location   string                 `json:"location"`
+	TemplateID string                 `json:"template_id,omitempty"`
+	WANLinks   []WANLink              `json:"wan_links,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// UpdateSiteParams contains parameters for updating a site. An empty
+// TemplateID detaches the site from its template.
+type UpdateSiteParams struct {
+	Name       *string                `json:"name,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	TemplateID *string                `json:"template_id,omitempty"`
+	WANLinks   []WANLink              `json:"wan_links,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ListSitesParams contains parameters for listing sites
+type ListSitesParams struct {
+	Page    int              `json:"page,omitempty"`
+	PerPage int              `json:"per_page,omitempty"`
+	Search  *string          `json:"search,omitempty"`
+	Status  *enum.SiteStatus `json:"status,omitempty"`
+
+	Metadata *MetadataQuery `json:"-"`
+}
+
+// SiteListResponse contains a list of sites with pagination
+type SiteListResponse struct {
+	Data       []Site     `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// List retrieves all sites with pagination. Honors AsOf.
+func (s *SitesService) List(ctx context.Context, params *ListSitesParams) (*SiteListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Search != nil {
+			v.Set("search", *params.Search)
+		}
+		if params.Status != nil {
+			v.Set("status", params.Status.String())
+		}
+		params.Metadata.encode(v)
+	}
+	setAsOf(ctx, v)
+
+	data, err := s.client.get(ctx, "/sites", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SiteListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Create creates a new site
+func (s *SitesService) Create(ctx context.Context, params *CreateSiteParams) (*Site, error) {
+	data, err := s.client.post(ctx, "/sites", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var site Site
+	if err := s.client.decode(data, &site); err != nil {
+		return nil, err
+	}
+
+	return &site, nil
+}
+
+// BulkCreate creates several sites in one request. Items that fail are
+// reported in the result rather than as an error; see BulkResult.
+func (s *SitesService) BulkCreate(ctx context.Context, params []CreateSiteParams, opts *RequestOptions) (*BulkResult, error) {
+	return s.client.bulk(ctx, "/sites/bulk", params, opts)
+}
+
+// Get retrieves a site by ID. Honors AsOf.
+func (s *SitesService) Get(ctx context.Context, siteID string) (*Site, error) {
+	v := url.Values{}
+	setAsOf(ctx, v)
+
+	data, err := s.client.get(ctx, "/sites/"+siteID, v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var site Site
+	if err := s.client.decode(data, &site); err != nil {
+		return nil, err
+	}
+
+	return &site, nil
+}
+
+// Update updates a site
+func (s *SitesService) Update(ctx context.Context, siteID string, params *UpdateSiteParams) (*Site, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var site Site
+	if err := s.client.decode(data, &site); err != nil {
+		return nil, err
+	}
+
+	return &site, nil
+}
+
+// Delete deletes a site
+func (s *SitesService) Delete(ctx context.Context, siteID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID, nil)
+}
+
+// Decommission gracefully takes a site out of service in a background job.
+// New sessions are steered away from the site's tunnels, existing ones
+// drain, the tunnels are torn down and a snapshot of the site's
+// configuration is archived in its history. Use Jobs.Wait to wait for the
+// site to reach enum.SiteStatusDecommissioned before deleting it.
+func (s *SitesService) Decommission(ctx context.Context, siteID string, params *DecommissionSiteParams) (*Job, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/decommission", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// History retrieves the change log of a site
+func (s *SitesService) History(ctx context.Context, siteID string, params *HistoryParams) (*ChangeLog, error) {
+	return s.client.history(ctx, "/sites/"+siteID+"/history", params)
+}
@@ -0,0 +1,300 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Cellular WAN
+// =============================================================================
+
+// SIM types
+const (
+	SIMPhysical = "physical"
+	SIMESIM     = "esim"
+)
+
+// SIM statuses
+const (
+	SIMActive    = "active"
+	SIMInactive  = "inactive"
+	SIMSuspended = "suspended"
+)
+
+// APN authentication methods
+const (
+	APNAuthNone = "none"
+	APNAuthPAP  = "pap"
+	APNAuthCHAP = "chap"
+)
+
+// Cellular failover modes. Standby links stay down until the primary
+// links fail; hot standby links stay attached and carry probes only.
+const (
+	CellularFailoverStandby    = "standby"
+	CellularFailoverHotStandby = "hot_standby"
+	CellularFailoverActive     = "active"
+)
+
+// CellularService provides access to the cellular WAN links of edge
+// devices: SIM inventory, data plan usage, APN and failover settings
+type CellularService struct {
+	client *Client
+}
+
+// SIM is a physical SIM or eSIM profile in an edge device's modem
+type SIM struct {
+	ID          string     `json:"id"`
+	ICCID       string     `json:"iccid"`
+	Type        string     `json:"type"`
+	Carrier     string     `json:"carrier,omitempty"`
+	PhoneNumber string     `json:"phone_number,omitempty"`
+	Status      string     `json:"status"`
+	SiteID      string     `json:"site_id,omitempty"`
+	DeviceID    string     `json:"device_id,omitempty"`
+	WANLinkID   string     `json:"wan_link_id,omitempty"`
+	Slot        int        `json:"slot"`
+	PlanLimitMB int        `json:"plan_limit_mb,omitempty"`
+	LastSeenAt  *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// ListSIMsParams filters the SIM inventory
+type ListSIMsParams struct {
+	SiteID   string `json:"site_id,omitempty"`
+	DeviceID string `json:"device_id,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+// ProvisionESIMParams contains parameters for downloading an eSIM profile
+// to a device's eUICC. ActivationCode is the LPA string from the carrier,
+// e.g. LPA:1$smdp.example.com$MATCHING-ID.
+type ProvisionESIMParams struct {
+	DeviceID            string `json:"device_id"`
+	ActivationCode      string `json:"activation_code"`
+	ConfirmationCode    string `json:"confirmation_code,omitempty"`
+	PlanLimitMB         int    `json:"plan_limit_mb,omitempty"`
+	ActivateImmediately bool   `json:"activate_immediately,omitempty"`
+}
+
+// CellularUsage is the data used on a SIM in the current billing period
+type CellularUsage struct {
+	SIMID        string    `json:"sim_id"`
+	PeriodStart  time.Time `json:"period_start"`
+	PeriodEnd    time.Time `json:"period_end"`
+	UsedMB       float64   `json:"used_mb"`
+	PlanLimitMB  int       `json:"plan_limit_mb,omitempty"`
+	ProjectedMB  float64   `json:"projected_mb"`
+	DailyUsageMB []float64 `json:"daily_usage_mb,omitempty"`
+}
+
+// PercentUsed returns the share of the plan used, or 0 for unlimited plans
+func (u *CellularUsage) PercentUsed() float64 {
+	if u.PlanLimitMB <= 0 {
+		return 0
+	}
+	return u.UsedMB / float64(u.PlanLimitMB) * 100
+}
+
+// APNConfig is the access point name a cellular link attaches with. The
+// password is write-only.
+type APNConfig struct {
+	Name     string `json:"name"`
+	Auth     string `json:"auth,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	IPType   string `json:"ip_type,omitempty"`
+}
+
+// CellularFailover controls when a cellular link takes over from, and
+// hands back to, the site's other WAN links. A primary link is considered
+// failed when its loss or latency exceeds the thresholds for
+// ActivateAfterSeconds.
+type CellularFailover struct {
+	Mode                 string `json:"mode"`
+	LossThresholdPercent int    `json:"loss_threshold_percent,omitempty"`
+	LatencyThresholdMs   int    `json:"latency_threshold_ms,omitempty"`
+	ActivateAfterSeconds int    `json:"activate_after_seconds,omitempty"`
+	FailbackAfterSeconds int    `json:"failback_after_seconds,omitempty"`
+	// DataCapPercent stops non-critical traffic over the link once the SIM
+	// has used this share of its plan. Zero disables the cap.
+	DataCapPercent int `json:"data_cap_percent,omitempty"`
+}
+
+// CellularConfig is the cellular configuration of a WAN link
+type CellularConfig struct {
+	WANLinkID        string            `json:"wan_link_id"`
+	APN              *APNConfig        `json:"apn,omitempty"`
+	PreferredSIMSlot int               `json:"preferred_sim_slot,omitempty"`
+	Failover         *CellularFailover `json:"failover,omitempty"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+}
+
+// UpdateCellularConfigParams contains parameters for updating the cellular
+// configuration of a WAN link. APN and Failover replace the existing values.
+type UpdateCellularConfigParams struct {
+	APN              *APNConfig        `json:"apn,omitempty"`
+	PreferredSIMSlot *int              `json:"preferred_sim_slot,omitempty"`
+	Failover         *CellularFailover `json:"failover,omitempty"`
+}
+
+// CellularStatus is the live radio state of a cellular WAN link. Signal
+// values are in dBm, except RSRQ and SINR which are in dB.
+type CellularStatus struct {
+	WANLinkID   string    `json:"wan_link_id"`
+	Registered  bool      `json:"registered"`
+	Carrier     string    `json:"carrier,omitempty"`
+	Technology  string    `json:"technology,omitempty"`
+	Band        string    `json:"band,omitempty"`
+	ActiveSIMID string    `json:"active_sim_id,omitempty"`
+	RSSI        int       `json:"rssi"`
+	RSRP        int       `json:"rsrp"`
+	RSRQ        int       `json:"rsrq"`
+	SINR        int       `json:"sinr"`
+	Active      bool      `json:"active"`
+	ObservedAt  time.Time `json:"observed_at"`
+}
+
+// ListSIMs retrieves the SIM inventory
+func (s *CellularService) ListSIMs(ctx context.Context, params *ListSIMsParams) ([]SIM, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.SiteID != "" {
+			v.Set("site_id", params.SiteID)
+		}
+		if params.DeviceID != "" {
+			v.Set("device_id", params.DeviceID)
+		}
+		if params.Status != "" {
+			v.Set("status", params.Status)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/cellular/sims", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sims []SIM
+	if err := s.client.decode(data, &sims); err != nil {
+		return nil, err
+	}
+
+	return sims, nil
+}
+
+// GetSIM retrieves a SIM by ID
+func (s *CellularService) GetSIM(ctx context.Context, simID string) (*SIM, error) {
+	data, err := s.client.get(ctx, "/cellular/sims/"+simID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sim SIM
+	if err := s.client.decode(data, &sim); err != nil {
+		return nil, err
+	}
+
+	return &sim, nil
+}
+
+// ActivateSIM activates a SIM with its carrier
+func (s *CellularService) ActivateSIM(ctx context.Context, simID string) (*SIM, error) {
+	return s.simAction(ctx, simID, "activate")
+}
+
+// SuspendSIM suspends a SIM with its carrier, e.g. for a device in storage
+func (s *CellularService) SuspendSIM(ctx context.Context, simID string) (*SIM, error) {
+	return s.simAction(ctx, simID, "suspend")
+}
+
+func (s *CellularService) simAction(ctx context.Context, simID, action string) (*SIM, error) {
+	data, err := s.client.post(ctx, "/cellular/sims/"+simID+"/"+action, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sim SIM
+	if err := s.client.decode(data, &sim); err != nil {
+		return nil, err
+	}
+
+	return &sim, nil
+}
+
+// ProvisionESIM downloads an eSIM profile to a device and adds it to the
+// inventory
+func (s *CellularService) ProvisionESIM(ctx context.Context, params *ProvisionESIMParams) (*SIM, error) {
+	data, err := s.client.post(ctx, "/cellular/esim_profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sim SIM
+	if err := s.client.decode(data, &sim); err != nil {
+		return nil, err
+	}
+
+	return &sim, nil
+}
+
+// Usage retrieves the data used on a SIM in the current billing period
+func (s *CellularService) Usage(ctx context.Context, simID string) (*CellularUsage, error) {
+	data, err := s.client.get(ctx, "/cellular/sims/"+simID+"/usage", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage CellularUsage
+	if err := s.client.decode(data, &usage); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// GetConfig retrieves the cellular configuration of an lte WAN link
+func (s *CellularService) GetConfig(ctx context.Context, siteID, linkID string) (*CellularConfig, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/wan_links/"+linkID+"/cellular", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config CellularConfig
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// UpdateConfig updates the APN and failover settings of an lte WAN link
+func (s *CellularService) UpdateConfig(ctx context.Context, siteID, linkID string, params *UpdateCellularConfigParams) (*CellularConfig, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/wan_links/"+linkID+"/cellular", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config CellularConfig
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Status retrieves the live radio state of an lte WAN link
+func (s *CellularService) Status(ctx context.Context, siteID, linkID string) (*CellularStatus, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/wan_links/"+linkID+"/cellular/status", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status CellularStatus
+	if err := s.client.decode(data, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,131 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// High-Availability Pairs
+// =============================================================================
+
+// HA pair states
+const (
+	HAStateSynced   = "synced"
+	HAStateDegraded = "degraded"
+	HAStateFailover = "failed_over"
+)
+
+// HAPairsService provides access to the high-availability pairs of edge
+// devices at individual sites
+type HAPairsService struct {
+	client *Client
+}
+
+// HAPair pairs two edge devices at a site. The secondary takes over after
+// FailoverThreshold consecutive heartbeats are missed on HeartbeatInterface.
+// With Preempt set, the primary takes traffic back PreemptDelaySeconds after
+// it recovers. Pairing sets the devices' HARole.
+type HAPair struct {
+	ID                  string    `json:"id"`
+	SiteID              string    `json:"site_id"`
+	PrimaryDeviceID     string    `json:"primary_device_id"`
+	SecondaryDeviceID   string    `json:"secondary_device_id"`
+	HeartbeatInterface  string    `json:"heartbeat_interface"`
+	HeartbeatIntervalMs int       `json:"heartbeat_interval_ms"`
+	FailoverThreshold   int       `json:"failover_threshold"`
+	Preempt             bool      `json:"preempt"`
+	PreemptDelaySeconds int       `json:"preempt_delay_seconds"`
+	ActiveDeviceID      string    `json:"active_device_id,omitempty"`
+	State               string    `json:"state,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// CreateHAPairParams contains parameters for pairing two edge devices at a site
+type CreateHAPairParams struct {
+	PrimaryDeviceID     string `json:"primary_device_id"`
+	SecondaryDeviceID   string `json:"secondary_device_id"`
+	HeartbeatInterface  string `json:"heartbeat_interface"`
+	HeartbeatIntervalMs int    `json:"heartbeat_interval_ms,omitempty"`
+	FailoverThreshold   int    `json:"failover_threshold,omitempty"`
+	Preempt             *bool  `json:"preempt,omitempty"`
+	PreemptDelaySeconds int    `json:"preempt_delay_seconds,omitempty"`
+}
+
+// UpdateHAPairParams contains parameters for updating an HA pair. Swapping
+// PrimaryDeviceID and SecondaryDeviceID changes roles without a failover.
+type UpdateHAPairParams struct {
+	PrimaryDeviceID     *string `json:"primary_device_id,omitempty"`
+	SecondaryDeviceID   *string `json:"secondary_device_id,omitempty"`
+	HeartbeatInterface  *string `json:"heartbeat_interface,omitempty"`
+	HeartbeatIntervalMs *int    `json:"heartbeat_interval_ms,omitempty"`
+	FailoverThreshold   *int    `json:"failover_threshold,omitempty"`
+	Preempt             *bool   `json:"preempt,omitempty"`
+	PreemptDelaySeconds *int    `json:"preempt_delay_seconds,omitempty"`
+}
+
+// List retrieves the HA pairs of a site
+func (s *HAPairsService) List(ctx context.Context, siteID string) ([]HAPair, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/ha_pairs", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []HAPair
+	if err := s.client.decode(data, &pairs); err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// Create pairs two edge devices at a site
+func (s *HAPairsService) Create(ctx context.Context, siteID string, params *CreateHAPairParams) (*HAPair, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/ha_pairs", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pair HAPair
+	if err := s.client.decode(data, &pair); err != nil {
+		return nil, err
+	}
+
+	return &pair, nil
+}
+
+// Get retrieves an HA pair of a site
+func (s *HAPairsService) Get(ctx context.Context, siteID, pairID string) (*HAPair, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/ha_pairs/"+pairID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pair HAPair
+	if err := s.client.decode(data, &pair); err != nil {
+		return nil, err
+	}
+
+	return &pair, nil
+}
+
+// Update updates an HA pair
+func (s *HAPairsService) Update(ctx context.Context, siteID, pairID string, params *UpdateHAPairParams) (*HAPair, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/ha_pairs/"+pairID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pair HAPair
+	if err := s.client.decode(data, &pair); err != nil {
+		return nil, err
+	}
+
+	return &pair, nil
+}
+
+// Delete dissolves an HA pair; both devices return to standalone
+func (s *HAPairsService) Delete(ctx context.Context, siteID, pairID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/ha_pairs/"+pairID, nil)
+}
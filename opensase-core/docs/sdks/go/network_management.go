@@ -0,0 +1,256 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Device Management: SNMP & Syslog
+// =============================================================================
+
+// SNMP versions
+const (
+	SNMPVersion2c = "v2c"
+	SNMPVersion3  = "v3"
+)
+
+// SNMPv3 authentication and privacy protocols
+const (
+	SNMPAuthSHA    = "sha"
+	SNMPAuthSHA256 = "sha256"
+	SNMPAuthSHA512 = "sha512"
+	SNMPPrivAES128 = "aes128"
+	SNMPPrivAES256 = "aes256"
+)
+
+// Syslog transport protocols
+const (
+	SyslogProtocolUDP = "udp"
+	SyslogProtocolTCP = "tcp"
+	SyslogProtocolTLS = "tls"
+)
+
+// Syslog message formats
+const (
+	SyslogFormatRFC5424 = "rfc5424"
+	SyslogFormatRFC3164 = "rfc3164"
+)
+
+// Syslog severities, from most to least severe
+const (
+	SyslogSeverityEmergency = "emergency"
+	SyslogSeverityAlert     = "alert"
+	SyslogSeverityCritical  = "critical"
+	SyslogSeverityError     = "error"
+	SyslogSeverityWarning   = "warning"
+	SyslogSeverityNotice    = "notice"
+	SyslogSeverityInfo      = "info"
+	SyslogSeverityDebug     = "debug"
+)
+
+// SNMPService provides access to the SNMP agent settings of individual
+// sites. Every site has settings; SNMP is off until enabled.
+type SNMPService struct {
+	client *Client
+}
+
+// SNMPSettings configure the SNMP agent of a site's edge devices. Only
+// hosts in AllowedSources may poll the agent. With SNMPVersion2c the agent
+// answers to a community, which is write-only and never returned; with
+// SNMPVersion3 it authenticates Users.
+type SNMPSettings struct {
+	SiteID         string             `json:"site_id"`
+	Enabled        bool               `json:"enabled"`
+	Version        string             `json:"version"`
+	Location       string             `json:"location,omitempty"`
+	Contact        string             `json:"contact,omitempty"`
+	AllowedSources []string           `json:"allowed_sources"`
+	Users          []SNMPUser         `json:"users,omitempty"`
+	TrapReceivers  []SNMPTrapReceiver `json:"trap_receivers,omitempty"`
+	UpdatedAt      *time.Time         `json:"updated_at,omitempty"`
+}
+
+// SNMPUser is an SNMPv3 user. A user without a PrivProtocol authenticates
+// but is not encrypted. Passwords are write-only, at least 8 characters,
+// and never returned.
+type SNMPUser struct {
+	Username     string `json:"username"`
+	AuthProtocol string `json:"auth_protocol"`
+	AuthPassword string `json:"auth_password,omitempty"`
+	PrivProtocol string `json:"priv_protocol,omitempty"`
+	PrivPassword string `json:"priv_password,omitempty"`
+}
+
+// SNMPTrapReceiver is a host traps are sent to. With SNMPv3, traps are
+// sent as Username, which must be one of the configured users.
+type SNMPTrapReceiver struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// UpdateSNMPParams contains parameters for changing a site's SNMP settings.
+// Lists replace the existing ones; a user listed without passwords keeps
+// its current ones.
+type UpdateSNMPParams struct {
+	Enabled        *bool               `json:"enabled,omitempty"`
+	Version        *string             `json:"version,omitempty"`
+	Community      *string             `json:"community,omitempty"`
+	Location       *string             `json:"location,omitempty"`
+	Contact        *string             `json:"contact,omitempty"`
+	AllowedSources *[]string           `json:"allowed_sources,omitempty"`
+	Users          *[]SNMPUser         `json:"users,omitempty"`
+	TrapReceivers  *[]SNMPTrapReceiver `json:"trap_receivers,omitempty"`
+}
+
+// Get retrieves the SNMP settings of a site
+func (s *SNMPService) Get(ctx context.Context, siteID string) (*SNMPSettings, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/snmp", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings SNMPSettings
+	if err := s.client.decode(data, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// Update changes the SNMP settings of a site and pushes them to its edge
+// devices
+func (s *SNMPService) Update(ctx context.Context, siteID string, params *UpdateSNMPParams) (*SNMPSettings, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/snmp", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings SNMPSettings
+	if err := s.client.decode(data, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// SyslogServersService provides access to the syslog servers the edge
+// devices of individual sites send their system logs to. Traffic and
+// security logs are exported tenant-wide with LogExportsService.
+type SyslogServersService struct {
+	client *Client
+}
+
+// SyslogServer is a syslog server receiving device logs of a site.
+// Messages less severe than MinSeverity are not sent. CACertificate
+// verifies the server with SyslogProtocolTLS.
+type SyslogServer struct {
+	ID            string    `json:"id"`
+	SiteID        string    `json:"site_id"`
+	Name          string    `json:"name"`
+	Host          string    `json:"host"`
+	Port          int       `json:"port"`
+	Protocol      string    `json:"protocol"`
+	Format        string    `json:"format"`
+	Facility      string    `json:"facility"`
+	MinSeverity   string    `json:"min_severity"`
+	CACertificate string    `json:"ca_certificate,omitempty"`
+	Enabled       bool      `json:"enabled"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// CreateSyslogServerParams contains parameters for adding a syslog
+// server to a site. A zero Port is 514, or 6514 with TLS; Format
+// defaults to RFC 5424, Facility to local7 and MinSeverity to info.
+type CreateSyslogServerParams struct {
+	Name          string `json:"name"`
+	Host          string `json:"host"`
+	Port          int    `json:"port,omitempty"`
+	Protocol      string `json:"protocol"`
+	Format        string `json:"format,omitempty"`
+	Facility      string `json:"facility,omitempty"`
+	MinSeverity   string `json:"min_severity,omitempty"`
+	CACertificate string `json:"ca_certificate,omitempty"`
+	Enabled       *bool  `json:"enabled,omitempty"`
+}
+
+// UpdateSyslogServerParams contains parameters for updating a syslog
+// server
+type UpdateSyslogServerParams struct {
+	Name          *string `json:"name,omitempty"`
+	Host          *string `json:"host,omitempty"`
+	Port          *int    `json:"port,omitempty"`
+	Protocol      *string `json:"protocol,omitempty"`
+	Format        *string `json:"format,omitempty"`
+	Facility      *string `json:"facility,omitempty"`
+	MinSeverity   *string `json:"min_severity,omitempty"`
+	CACertificate *string `json:"ca_certificate,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+}
+
+// List retrieves the syslog servers of a site
+func (s *SyslogServersService) List(ctx context.Context, siteID string) ([]SyslogServer, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/syslog_servers", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []SyslogServer
+	if err := s.client.decode(data, &servers); err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// Create adds a syslog server to a site
+func (s *SyslogServersService) Create(ctx context.Context, siteID string, params *CreateSyslogServerParams) (*SyslogServer, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/syslog_servers", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server SyslogServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Get retrieves a syslog server of a site
+func (s *SyslogServersService) Get(ctx context.Context, siteID, serverID string) (*SyslogServer, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/syslog_servers/"+serverID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server SyslogServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Update updates a syslog server
+func (s *SyslogServersService) Update(ctx context.Context, siteID, serverID string, params *UpdateSyslogServerParams) (*SyslogServer, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/syslog_servers/"+serverID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server SyslogServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Delete removes a syslog server from a site
+func (s *SyslogServersService) Delete(ctx context.Context, siteID, serverID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/syslog_servers/"+serverID, nil)
+}
@@ -0,0 +1,237 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Static Routes & BGP Peers
+// =============================================================================
+
+// StaticRoutesService provides access to the static routes of individual sites
+type StaticRoutesService struct {
+	client *Client
+}
+
+// StaticRoute represents a static route on a site. Among routes to the same
+// prefix, the one with the lowest Metric is installed.
+type StaticRoute struct {
+	ID          string    `json:"id"`
+	SiteID      string    `json:"site_id"`
+	Prefix      string    `json:"prefix"`
+	NextHop     string    `json:"next_hop"`
+	WANLinkID   string    `json:"wan_link_id,omitempty"`
+	Metric      int       `json:"metric"`
+	Description string    `json:"description,omitempty"`
+	Enabled     bool      `json:"enabled"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateStaticRouteParams contains parameters for adding a static route to a site
+type CreateStaticRouteParams struct {
+	Prefix      string `json:"prefix"`
+	NextHop     string `json:"next_hop"`
+	WANLinkID   string `json:"wan_link_id,omitempty"`
+	Metric      int    `json:"metric,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// UpdateStaticRouteParams contains parameters for updating a static route
+type UpdateStaticRouteParams struct {
+	Prefix      *string `json:"prefix,omitempty"`
+	NextHop     *string `json:"next_hop,omitempty"`
+	WANLinkID   *string `json:"wan_link_id,omitempty"`
+	Metric      *int    `json:"metric,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// List retrieves the static routes of a site
+func (s *StaticRoutesService) List(ctx context.Context, siteID string) ([]StaticRoute, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/static_routes", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []StaticRoute
+	if err := s.client.decode(data, &routes); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+// Create adds a static route to a site
+func (s *StaticRoutesService) Create(ctx context.Context, siteID string, params *CreateStaticRouteParams) (*StaticRoute, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/static_routes", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var route StaticRoute
+	if err := s.client.decode(data, &route); err != nil {
+		return nil, err
+	}
+
+	return &route, nil
+}
+
+// Get retrieves a static route of a site
+func (s *StaticRoutesService) Get(ctx context.Context, siteID, routeID string) (*StaticRoute, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/static_routes/"+routeID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var route StaticRoute
+	if err := s.client.decode(data, &route); err != nil {
+		return nil, err
+	}
+
+	return &route, nil
+}
+
+// Update updates a static route
+func (s *StaticRoutesService) Update(ctx context.Context, siteID, routeID string, params *UpdateStaticRouteParams) (*StaticRoute, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/static_routes/"+routeID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var route StaticRoute
+	if err := s.client.decode(data, &route); err != nil {
+		return nil, err
+	}
+
+	return &route, nil
+}
+
+// Delete removes a static route from a site
+func (s *StaticRoutesService) Delete(ctx context.Context, siteID, routeID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/static_routes/"+routeID, nil)
+}
+
+// BGPPeersService provides access to the BGP peers of individual sites
+type BGPPeersService struct {
+	client *Client
+}
+
+// BGPPeer represents a BGP session between a site and a neighbor. The MD5
+// password is write-only; AuthEnabled reports whether one is configured.
+// RouteMapIn and RouteMapOut name route maps applied to received and
+// advertised routes. State is the current session state, e.g. established.
+type BGPPeer struct {
+	ID               string     `json:"id"`
+	SiteID           string     `json:"site_id"`
+	Name             string     `json:"name"`
+	PeerASN          int64      `json:"peer_asn"`
+	PeerAddress      string     `json:"peer_address"`
+	LocalASN         int64      `json:"local_asn,omitempty"`
+	AuthEnabled      bool       `json:"auth_enabled"`
+	RouteMapIn       string     `json:"route_map_in,omitempty"`
+	RouteMapOut      string     `json:"route_map_out,omitempty"`
+	KeepaliveSeconds int        `json:"keepalive_seconds"`
+	HoldTimeSeconds  int        `json:"hold_time_seconds"`
+	Enabled          bool       `json:"enabled"`
+	State            string     `json:"state,omitempty"`
+	EstablishedAt    *time.Time `json:"established_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// CreateBGPPeerParams contains parameters for adding a BGP peer to a site
+type CreateBGPPeerParams struct {
+	Name             string `json:"name"`
+	PeerASN          int64  `json:"peer_asn"`
+	PeerAddress      string `json:"peer_address"`
+	LocalASN         int64  `json:"local_asn,omitempty"`
+	AuthPassword     string `json:"auth_password,omitempty"`
+	RouteMapIn       string `json:"route_map_in,omitempty"`
+	RouteMapOut      string `json:"route_map_out,omitempty"`
+	KeepaliveSeconds int    `json:"keepalive_seconds,omitempty"`
+	HoldTimeSeconds  int    `json:"hold_time_seconds,omitempty"`
+	Enabled          *bool  `json:"enabled,omitempty"`
+}
+
+// UpdateBGPPeerParams contains parameters for updating a BGP peer. Set
+// AuthPassword to an empty string to remove authentication.
+type UpdateBGPPeerParams struct {
+	Name             *string `json:"name,omitempty"`
+	PeerASN          *int64  `json:"peer_asn,omitempty"`
+	PeerAddress      *string `json:"peer_address,omitempty"`
+	LocalASN         *int64  `json:"local_asn,omitempty"`
+	AuthPassword     *string `json:"auth_password,omitempty"`
+	RouteMapIn       *string `json:"route_map_in,omitempty"`
+	RouteMapOut      *string `json:"route_map_out,omitempty"`
+	KeepaliveSeconds *int    `json:"keepalive_seconds,omitempty"`
+	HoldTimeSeconds  *int    `json:"hold_time_seconds,omitempty"`
+	Enabled          *bool   `json:"enabled,omitempty"`
+}
+
+// List retrieves the BGP peers of a site
+func (s *BGPPeersService) List(ctx context.Context, siteID string) ([]BGPPeer, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/bgp_peers", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var peers []BGPPeer
+	if err := s.client.decode(data, &peers); err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// Create adds a BGP peer to a site
+func (s *BGPPeersService) Create(ctx context.Context, siteID string, params *CreateBGPPeerParams) (*BGPPeer, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/bgp_peers", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var peer BGPPeer
+	if err := s.client.decode(data, &peer); err != nil {
+		return nil, err
+	}
+
+	return &peer, nil
+}
+
+// Get retrieves a BGP peer of a site
+func (s *BGPPeersService) Get(ctx context.Context, siteID, peerID string) (*BGPPeer, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/bgp_peers/"+peerID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var peer BGPPeer
+	if err := s.client.decode(data, &peer); err != nil {
+		return nil, err
+	}
+
+	return &peer, nil
+}
+
+// Update updates a BGP peer. Changing the peer address or ASN resets the session.
+func (s *BGPPeersService) Update(ctx context.Context, siteID, peerID string, params *UpdateBGPPeerParams) (*BGPPeer, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/bgp_peers/"+peerID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var peer BGPPeer
+	if err := s.client.decode(data, &peer); err != nil {
+		return nil, err
+	}
+
+	return &peer, nil
+}
+
+// Delete removes a BGP peer from a site
+func (s *BGPPeersService) Delete(ctx context.Context, siteID, peerID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/bgp_peers/"+peerID, nil)
+}
@@ -0,0 +1,129 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Site Templates
+// =============================================================================
+
+// SiteTemplatesService provides access to site templates, which bundle the
+// WAN link, security and QoS settings shared by many branch sites
+type SiteTemplatesService struct {
+	client *Client
+}
+
+// SiteTemplate holds default settings for the sites created from it.
+// WANLinks are copied to a site when it is created without links of its own;
+// later changes to them do not affect existing sites. The profiles apply to
+// every site using the template and follow changes to the template.
+// SiteCount is the number of sites using the template.
+type SiteTemplate struct {
+	ID           string               `json:"id"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description,omitempty"`
+	WANLinks     []WANLink            `json:"wan_links,omitempty"`
+	Security     SiteTemplateSecurity `json:"security"`
+	QoSProfileID string               `json:"qos_profile_id,omitempty"`
+	SiteCount    int                  `json:"site_count"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// SiteTemplateSecurity holds the IDs of the security profiles applied to
+// traffic of a template's sites. An empty ID leaves the tenant default.
+type SiteTemplateSecurity struct {
+	ThreatPreventionProfileID string `json:"threat_prevention_profile_id,omitempty"`
+	URLFilteringProfileID     string `json:"url_filtering_profile_id,omitempty"`
+	DNSSecurityProfileID      string `json:"dns_security_profile_id,omitempty"`
+	SSLInspectionProfileID    string `json:"ssl_inspection_profile_id,omitempty"`
+}
+
+// CreateSiteTemplateParams contains parameters for creating a site template
+type CreateSiteTemplateParams struct {
+	Name         string               `json:"name"`
+	Description  string               `json:"description,omitempty"`
+	WANLinks     []WANLink            `json:"wan_links,omitempty"`
+	Security     SiteTemplateSecurity `json:"security"`
+	QoSProfileID string               `json:"qos_profile_id,omitempty"`
+}
+
+// UpdateSiteTemplateParams contains parameters for updating a site template.
+// WANLinks and Security replace the existing values; an empty QoSProfileID
+// removes the QoS profile.
+type UpdateSiteTemplateParams struct {
+	Name         *string               `json:"name,omitempty"`
+	Description  *string               `json:"description,omitempty"`
+	WANLinks     *[]WANLink            `json:"wan_links,omitempty"`
+	Security     *SiteTemplateSecurity `json:"security,omitempty"`
+	QoSProfileID *string               `json:"qos_profile_id,omitempty"`
+}
+
+// List retrieves all site templates
+func (s *SiteTemplatesService) List(ctx context.Context) ([]SiteTemplate, error) {
+	data, err := s.client.get(ctx, "/site_templates", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []SiteTemplate
+	if err := s.client.decode(data, &templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// Create creates a new site template
+func (s *SiteTemplatesService) Create(ctx context.Context, params *CreateSiteTemplateParams) (*SiteTemplate, error) {
+	data, err := s.client.post(ctx, "/site_templates", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template SiteTemplate
+	if err := s.client.decode(data, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// Get retrieves a site template by ID
+func (s *SiteTemplatesService) Get(ctx context.Context, templateID string) (*SiteTemplate, error) {
+	data, err := s.client.get(ctx, "/site_templates/"+templateID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template SiteTemplate
+	if err := s.client.decode(data, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// Update updates a site template. Profile changes are pushed to every site
+// using the template.
+func (s *SiteTemplatesService) Update(ctx context.Context, templateID string, params *UpdateSiteTemplateParams) (*SiteTemplate, error) {
+	data, err := s.client.patch(ctx, "/site_templates/"+templateID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template SiteTemplate
+	if err := s.client.decode(data, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// Delete deletes a site template. Templates still used by a site cannot be
+// deleted.
+func (s *SiteTemplatesService) Delete(ctx context.Context, templateID string) error {
+	return s.client.delete(ctx, "/site_templates/"+templateID, nil)
+}
@@ -0,0 +1,193 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// IPsec Tunnels
+// =============================================================================
+
+// Tunnel authentication methods
+const (
+	TunnelAuthPSK         = "psk"
+	TunnelAuthCertificate = "certificate"
+)
+
+// TunnelsService provides access to IPsec tunnel APIs
+type TunnelsService struct {
+	client *Client
+}
+
+// IPsecTunnel represents an IPsec tunnel between a site and a PoP or another site
+type IPsecTunnel struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	Local         TunnelEndpoint     `json:"local"`
+	Remote        TunnelEndpoint     `json:"remote"`
+	IKEVersion    string             `json:"ike_version"`
+	IKEProposals  []CryptoProposal   `json:"ike_proposals"`
+	ESPProposals  []CryptoProposal   `json:"esp_proposals"`
+	IKELifetime   int                `json:"ike_lifetime_seconds"`
+	ESPLifetime   int                `json:"esp_lifetime_seconds"`
+	AuthMethod    string             `json:"auth_method"`
+	CertificateID string             `json:"certificate_id,omitempty"`
+	DPD           *DeadPeerDetection `json:"dpd,omitempty"`
+	Status        string             `json:"status"`
+	Phase1Status  string             `json:"phase1_status,omitempty"`
+	Phase2Status  string             `json:"phase2_status,omitempty"`
+	EstablishedAt *time.Time         `json:"established_at,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// TunnelEndpoint is one side of a tunnel. Set SiteID (and optionally WANLink)
+// for a site, or PoPID for a PoP.
+type TunnelEndpoint struct {
+	SiteID   string `json:"site_id,omitempty"`
+	WANLink  string `json:"wan_link,omitempty"`
+	PoPID    string `json:"pop_id,omitempty"`
+	Address  string `json:"address,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// CryptoProposal is an IKE or ESP transform set
+type CryptoProposal struct {
+	Encryption string `json:"encryption"`
+	Integrity  string `json:"integrity,omitempty"`
+	DHGroup    string `json:"dh_group,omitempty"`
+}
+
+// DeadPeerDetection controls how a dead peer is detected and handled
+type DeadPeerDetection struct {
+	IntervalSeconds int    `json:"interval_seconds"`
+	TimeoutSeconds  int    `json:"timeout_seconds"`
+	Action          string `json:"action"`
+}
+
+// CreateTunnelParams contains parameters for creating an IPsec tunnel.
+// Exactly one of PreSharedKey or CertificateID must be set.
+type CreateTunnelParams struct {
+	Name          string             `json:"name"`
+	Local         TunnelEndpoint     `json:"local"`
+	Remote        TunnelEndpoint     `json:"remote"`
+	IKEVersion    string             `json:"ike_version,omitempty"`
+	IKEProposals  []CryptoProposal   `json:"ike_proposals,omitempty"`
+	ESPProposals  []CryptoProposal   `json:"esp_proposals,omitempty"`
+	IKELifetime   int                `json:"ike_lifetime_seconds,omitempty"`
+	ESPLifetime   int                `json:"esp_lifetime_seconds,omitempty"`
+	PreSharedKey  string             `json:"pre_shared_key,omitempty"`
+	CertificateID string             `json:"certificate_id,omitempty"`
+	DPD           *DeadPeerDetection `json:"dpd,omitempty"`
+}
+
+// UpdateTunnelParams contains parameters for updating an IPsec tunnel
+type UpdateTunnelParams struct {
+	Name          *string            `json:"name,omitempty"`
+	IKEVersion    *string            `json:"ike_version,omitempty"`
+	IKEProposals  []CryptoProposal   `json:"ike_proposals,omitempty"`
+	ESPProposals  []CryptoProposal   `json:"esp_proposals,omitempty"`
+	IKELifetime   *int               `json:"ike_lifetime_seconds,omitempty"`
+	ESPLifetime   *int               `json:"esp_lifetime_seconds,omitempty"`
+	PreSharedKey  *string            `json:"pre_shared_key,omitempty"`
+	CertificateID *string            `json:"certificate_id,omitempty"`
+	DPD           *DeadPeerDetection `json:"dpd,omitempty"`
+}
+
+// ListTunnelsParams contains parameters for listing tunnels
+type ListTunnelsParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	SiteID  *string `json:"site_id,omitempty"`
+	Status  *string `json:"status,omitempty"`
+}
+
+// TunnelListResponse contains a list of tunnels with pagination
+type TunnelListResponse struct {
+	Data       []IPsecTunnel `json:"data"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// List retrieves all tunnels with pagination
+func (s *TunnelsService) List(ctx context.Context, params *ListTunnelsParams) (*TunnelListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.SiteID != nil {
+			v.Set("site_id", *params.SiteID)
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/tunnels", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response TunnelListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Create creates a new IPsec tunnel
+func (s *TunnelsService) Create(ctx context.Context, params *CreateTunnelParams) (*IPsecTunnel, error) {
+	data, err := s.client.post(ctx, "/tunnels", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tunnel IPsecTunnel
+	if err := s.client.decode(data, &tunnel); err != nil {
+		return nil, err
+	}
+
+	return &tunnel, nil
+}
+
+// Get retrieves a tunnel by ID
+func (s *TunnelsService) Get(ctx context.Context, tunnelID string) (*IPsecTunnel, error) {
+	data, err := s.client.get(ctx, "/tunnels/"+tunnelID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tunnel IPsecTunnel
+	if err := s.client.decode(data, &tunnel); err != nil {
+		return nil, err
+	}
+
+	return &tunnel, nil
+}
+
+// Update updates a tunnel. Changing crypto settings renegotiates the tunnel.
+func (s *TunnelsService) Update(ctx context.Context, tunnelID string, params *UpdateTunnelParams) (*IPsecTunnel, error) {
+	data, err := s.client.patch(ctx, "/tunnels/"+tunnelID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tunnel IPsecTunnel
+	if err := s.client.decode(data, &tunnel); err != nil {
+		return nil, err
+	}
+
+	return &tunnel, nil
+}
+
+// Delete deletes a tunnel
+func (s *TunnelsService) Delete(ctx context.Context, tunnelID string) error {
+	return s.client.delete(ctx, "/tunnels/"+tunnelID, nil)
+}
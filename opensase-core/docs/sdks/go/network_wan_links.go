@@ -0,0 +1,99 @@
+package opensase
+
+import (
+	"context"
+
+	"github.com/billyronks/opensase-go/enum"
+)
+
+// =============================================================================
+// WAN Links
+// =============================================================================
+
+// WANLinksService provides access to the WAN links of individual sites
+type WANLinksService struct {
+	client *Client
+}
+
+// CreateWANLinkParams contains parameters for adding a WAN link to a site
+type CreateWANLinkParams struct {
+	Name             string           `json:"name"`
+	Type             enum.WANLinkType `json:"type"`
+	Provider         string           `json:"provider,omitempty"`
+	BandwidthMbps    int              `json:"bandwidth_mbps,omitempty"`
+	FailoverPriority int              `json:"failover_priority,omitempty"`
+}
+
+// UpdateWANLinkParams contains parameters for updating a WAN link
+type UpdateWANLinkParams struct {
+	Name             *string           `json:"name,omitempty"`
+	Type             *enum.WANLinkType `json:"type,omitempty"`
+	Provider         *string           `json:"provider,omitempty"`
+	BandwidthMbps    *int              `json:"bandwidth_mbps,omitempty"`
+	FailoverPriority *int              `json:"failover_priority,omitempty"`
+}
+
+// List retrieves the WAN links of a site
+func (s *WANLinksService) List(ctx context.Context, siteID string) ([]WANLink, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/wan_links", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []WANLink
+	if err := s.client.decode(data, &links); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// Create adds a WAN link to a site
+func (s *WANLinksService) Create(ctx context.Context, siteID string, params *CreateWANLinkParams) (*WANLink, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/wan_links", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link WANLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Get retrieves a WAN link of a site
+func (s *WANLinksService) Get(ctx context.Context, siteID, linkID string) (*WANLink, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/wan_links/"+linkID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link WANLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Update updates a WAN link without touching the site's other links
+func (s *WANLinksService) Update(ctx context.Context, siteID, linkID string, params *UpdateWANLinkParams) (*WANLink, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/wan_links/"+linkID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link WANLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Delete removes a WAN link from a site
+func (s *WANLinksService) Delete(ctx context.Context, siteID, linkID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/wan_links/"+linkID, nil)
+}
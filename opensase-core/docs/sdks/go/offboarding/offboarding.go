@@ -0,0 +1,394 @@
+// Package offboarding removes a departing user's access across services.
+//
+// An Offboarder suspends the user, revokes their sessions and the API keys
+// they created, takes them out of the groups that grant ZTNA access and
+// exports their data before cancelling the subscriptions of their customer
+// account:
+//
+//	ob := offboarding.New(client,
+//	    offboarding.WithProgress(func(e offboarding.Event) {
+//	        log.Printf("%s: %s %s", e.UserID, e.Step, e.Status)
+//	    }),
+//	)
+//	res, err := ob.Run(ctx, offboarding.Request{
+//	    UserID:     "usr_123",
+//	    CustomerID: "cus_456",
+//	    Reason:     "left the company",
+//	})
+//
+// Every credential is cut off before the export, which can take a while.
+// If a step up to and including the export fails, the user is reinstated:
+// their status and group memberships are restored, although revoked
+// sessions and API keys are not; the result lists the keys so replacements
+// can be issued. Cancelling subscriptions cannot be undone, so a failure
+// from then on leaves the user suspended and Run can simply be called
+// again; steps already done find nothing left to do.
+package offboarding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/enum"
+	"github.com/billyronks/opensase-go/internal/saga"
+)
+
+// Defaults used when no option overrides them
+const (
+	DefaultPollInterval    = 5 * time.Second
+	DefaultRollbackTimeout = 2 * time.Minute
+)
+
+// userStatusSuspended is the user status that blocks sign-in
+const userStatusSuspended = "suspended"
+
+// Step is a stage of offboarding a user. Steps run in the order declared.
+type Step string
+
+const (
+	StepSuspend       Step = "suspend"
+	StepSessions      Step = "sessions"
+	StepAPIKeys       Step = "api_keys"
+	StepZTNA          Step = "ztna"
+	StepExport        Step = "export"
+	StepSubscriptions Step = "subscriptions"
+)
+
+// Status is the state of a step reported in an Event
+type Status string
+
+const (
+	StatusStarted    Status = "started"
+	StatusDone       Status = "done"
+	StatusFailed     Status = "failed"
+	StatusRolledBack Status = "rolled_back"
+)
+
+// Request describes a user to offboard
+type Request struct {
+	UserID string `json:"user_id"`
+	// CustomerID is the billing customer whose subscriptions are
+	// cancelled. Subscriptions are left alone when it is empty.
+	CustomerID string `json:"customer_id,omitempty"`
+	// Reason is recorded on cancelled subscriptions
+	Reason string `json:"reason,omitempty"`
+	// Reference is recorded on the data export, e.g. a ticket number
+	Reference string `json:"reference,omitempty"`
+}
+
+// Event reports progress on a step. Err is set on failed events. A rolled
+// back event follows a failure before subscriptions are cancelled: Step is
+// the step that failed and Err holds anything that kept the user from being
+// reinstated.
+type Event struct {
+	UserID string
+	Step   Step
+	Status Status
+	Err    error
+}
+
+// Result is the outcome of offboarding a user
+type Result struct {
+	UserID string `json:"user_id"`
+	// Completed are the steps that succeeded, in order
+	Completed []Step `json:"completed"`
+	// Export is the finished data export
+	Export *opensase.DSAROperation `json:"export,omitempty"`
+	// RemovedFromGroups are the IDs of the groups the user was taken out of
+	RemovedFromGroups []string `json:"removed_from_groups,omitempty"`
+	// RevokedAPIKeys and CanceledSubscriptions are IDs, including those
+	// handled before a later failure or rollback
+	RevokedAPIKeys        []string `json:"revoked_api_keys,omitempty"`
+	CanceledSubscriptions []string `json:"canceled_subscriptions,omitempty"`
+	Err                   error    `json:"-"`
+	// RolledBack is set when the user was reinstated after a failure.
+	// RollbackErr holds anything that could not be undone.
+	RolledBack  bool  `json:"rolled_back"`
+	RollbackErr error `json:"-"`
+}
+
+// MarshalJSON includes the errors of a result as strings
+func (r Result) MarshalJSON() ([]byte, error) {
+	type result Result
+	return json.Marshal(struct {
+		result
+		saga.Errors
+	}{result(r), saga.ErrorStrings(r.Err, r.RollbackErr)})
+}
+
+// Option configures an Offboarder
+type Option func(*Offboarder)
+
+// WithProgress calls fn for every step started, finished, failed or rolled
+// back. fn is called from the goroutine running Run and should return
+// quickly.
+func WithProgress(fn func(Event)) Option {
+	return func(o *Offboarder) {
+		o.progress = fn
+	}
+}
+
+// WithCancelImmediately ends subscriptions straight away instead of at the
+// end of the period already paid for
+func WithCancelImmediately() Option {
+	return func(o *Offboarder) {
+		o.cancelImmediately = true
+	}
+}
+
+// WithoutExport skips exporting the user's data
+func WithoutExport() Option {
+	return func(o *Offboarder) {
+		o.export = false
+	}
+}
+
+// WithExportStores limits the export to stores, e.g.
+// opensase.DataStoreIdentity. Every store is exported by default.
+func WithExportStores(stores ...string) Option {
+	return func(o *Offboarder) {
+		o.exportStores = stores
+	}
+}
+
+// WithPollInterval sets how often the export is checked for completion
+func WithPollInterval(d time.Duration) Option {
+	return func(o *Offboarder) {
+		if d > 0 {
+			o.pollInterval = d
+		}
+	}
+}
+
+// WithRollbackTimeout bounds the time spent reinstating a user. Rollback
+// runs even after the context passed to Run is cancelled.
+func WithRollbackTimeout(d time.Duration) Option {
+	return func(o *Offboarder) {
+		o.rollbackTimeout = d
+	}
+}
+
+// Offboarder offboards users
+type Offboarder struct {
+	client            *opensase.Client
+	progress          func(Event)
+	cancelImmediately bool
+	export            bool
+	exportStores      []string
+	pollInterval      time.Duration
+	rollbackTimeout   time.Duration
+}
+
+// New returns an Offboarder using client
+func New(client *opensase.Client, opts ...Option) *Offboarder {
+	o := &Offboarder{
+		client:          client,
+		export:          true,
+		pollInterval:    DefaultPollInterval,
+		rollbackTimeout: DefaultRollbackTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Run offboards the user of req. The returned error is the result's Err.
+func (o *Offboarder) Run(ctx context.Context, req Request) (*Result, error) {
+	if req.UserID == "" {
+		return nil, errors.New("offboarding: user ID is required")
+	}
+
+	res := &Result{UserID: req.UserID, Completed: []Step{}}
+	var undo saga.Log
+	users := o.client.Identity.Users
+
+	steps := []struct {
+		step Step
+		skip bool
+		// final steps cannot be undone; once one starts a failure no
+		// longer rolls back
+		final bool
+		run   func() error
+	}{
+		{StepSuspend, false, false, func() error {
+			user, err := users.Get(ctx, req.UserID)
+			if err != nil {
+				return err
+			}
+			if user.Status == userStatusSuspended {
+				return nil
+			}
+			if _, err := users.Update(ctx, req.UserID, &opensase.UpdateUserParams{Status: opensase.String(userStatusSuspended)}); err != nil {
+				return err
+			}
+			previous := user.Status
+			undo.Add(func(ctx context.Context) error {
+				_, err := users.Update(ctx, req.UserID, &opensase.UpdateUserParams{Status: opensase.String(previous)})
+				return err
+			})
+			return nil
+		}},
+		{StepSessions, false, false, func() error {
+			return users.RevokeSessions(ctx, req.UserID)
+		}},
+		{StepAPIKeys, false, false, func() error {
+			return o.revokeAPIKeys(ctx, res)
+		}},
+		{StepZTNA, false, false, func() error {
+			return o.removeZTNAAccess(ctx, res, &undo)
+		}},
+		{StepExport, !o.export, false, func() error {
+			op, err := o.client.Privacy.ExportUserData(ctx, &opensase.DataSubjectRequestParams{
+				Subject:     opensase.DataSubject{UserID: req.UserID},
+				Stores:      o.exportStores,
+				ExternalRef: req.Reference,
+			}, nil)
+			if err != nil {
+				return err
+			}
+			op, err = o.client.Privacy.WaitOperation(ctx, op.ID, o.pollInterval)
+			if err != nil {
+				return err
+			}
+			res.Export = op
+			return nil
+		}},
+		{StepSubscriptions, req.CustomerID == "", true, func() error {
+			return o.cancelSubscriptions(ctx, req, res)
+		}},
+	}
+
+	for _, s := range steps {
+		if s.skip {
+			continue
+		}
+		if s.final {
+			undo.Forget()
+		}
+		o.emit(Event{UserID: req.UserID, Step: s.step, Status: StatusStarted})
+		if err := s.run(); err != nil {
+			res.Err = fmt.Errorf("offboarding: user %s: %s: %w", req.UserID, s.step, err)
+			o.emit(Event{UserID: req.UserID, Step: s.step, Status: StatusFailed, Err: res.Err})
+			if undo.Len() > 0 {
+				res.RollbackErr = undo.Rollback(ctx, o.rollbackTimeout)
+				res.RolledBack = res.RollbackErr == nil
+				o.emit(Event{UserID: req.UserID, Step: s.step, Status: StatusRolledBack, Err: res.RollbackErr})
+			}
+			return res, res.Err
+		}
+		res.Completed = append(res.Completed, s.step)
+		o.emit(Event{UserID: req.UserID, Step: s.step, Status: StatusDone})
+	}
+	return res, nil
+}
+
+// removeZTNAAccess takes the user out of every group an allow ZTNA access
+// policy grants access to. Policies may name groups by ID or by name.
+func (o *Offboarder) removeZTNAAccess(ctx context.Context, res *Result, undo *saga.Log) error {
+	policies, err := o.client.Security.ZTNAAccessPolicies.List(ctx)
+	if err != nil {
+		return err
+	}
+	granting := map[string]bool{}
+	for _, p := range policies {
+		if p.Action != "allow" {
+			continue
+		}
+		for _, g := range p.UserGroups {
+			granting[g] = true
+		}
+	}
+	if len(granting) == 0 {
+		return nil
+	}
+
+	user, err := o.client.Identity.Users.Get(ctx, res.UserID)
+	if err != nil {
+		return err
+	}
+	groups := o.client.Identity.Groups
+	for _, g := range user.Groups {
+		if !granting[g.ID] && !granting[g.Name] {
+			continue
+		}
+		if err := groups.RemoveMembers(ctx, g.ID, []string{res.UserID}); err != nil {
+			return fmt.Errorf("group %q: %w", g.Name, err)
+		}
+		res.RemovedFromGroups = append(res.RemovedFromGroups, g.ID)
+		groupID := g.ID
+		undo.Add(func(ctx context.Context) error {
+			return groups.AddMembers(ctx, groupID, []string{res.UserID})
+		})
+	}
+	return nil
+}
+
+// revokeAPIKeys revokes the API keys the user created
+func (o *Offboarder) revokeAPIKeys(ctx context.Context, res *Result) error {
+	keys, err := o.client.Identity.APIKeys.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if k.CreatedBy != res.UserID {
+			continue
+		}
+		if err := o.client.Identity.APIKeys.Revoke(ctx, k.ID); err != nil && !saga.IsNotFound(err) {
+			return fmt.Errorf("API key %s: %w", k.Prefix, err)
+		}
+		res.RevokedAPIKeys = append(res.RevokedAPIKeys, k.ID)
+	}
+	return nil
+}
+
+// cancelSubscriptions cancels the customer's subscriptions that are still
+// running. Subscriptions already set to end with their period are left
+// alone unless they are to be cancelled immediately.
+func (o *Offboarder) cancelSubscriptions(ctx context.Context, req Request, res *Result) error {
+	subs := o.client.Payments.Subscriptions
+	var reason *string
+	if req.Reason != "" {
+		reason = opensase.String(req.Reason)
+	}
+
+	var found []opensase.Subscription
+	for page := 1; ; page++ {
+		list, err := subs.List(ctx, &opensase.ListSubscriptionsParams{
+			Page:       page,
+			PerPage:    100,
+			CustomerID: opensase.String(req.CustomerID),
+		})
+		if err != nil {
+			return err
+		}
+		found = append(found, list.Data...)
+		if page >= list.Pagination.TotalPages {
+			break
+		}
+	}
+
+	for _, sub := range found {
+		switch {
+		case sub.Status == enum.SubscriptionStatusCanceled,
+			sub.Status == enum.SubscriptionStatusIncompleteExpired,
+			sub.CancelAtPeriodEnd && !o.cancelImmediately:
+			continue
+		}
+		if _, err := subs.Cancel(ctx, sub.ID, !o.cancelImmediately, reason); err != nil {
+			return fmt.Errorf("subscription %s: %w", sub.ID, err)
+		}
+		res.CanceledSubscriptions = append(res.CanceledSubscriptions, sub.ID)
+	}
+	return nil
+}
+
+func (o *Offboarder) emit(e Event) {
+	if o.progress != nil {
+		o.progress(e)
+	}
+}
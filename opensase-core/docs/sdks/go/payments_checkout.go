@@ -0,0 +1,111 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Payments Checkout Sessions
+// =============================================================================
+
+// CheckoutSessionsService provides access to hosted checkout APIs
+type CheckoutSessionsService struct {
+	client *Client
+}
+
+// CheckoutSession represents a hosted payment page session
+type CheckoutSession struct {
+	ID              string                 `json:"id"`
+	URL             string                 `json:"url"`
+	Mode            string                 `json:"mode"`
+	Status          string                 `json:"status"`
+	PaymentStatus   string                 `json:"payment_status"`
+	Currency        string                 `json:"currency"`
+	AmountSubtotal  int64                  `json:"amount_subtotal"`
+	AmountTotal     int64                  `json:"amount_total"`
+	AmountTax       int64                  `json:"amount_tax,omitempty"`
+	AutomaticTax    *AutomaticTax          `json:"automatic_tax,omitempty"`
+	TaxLines        []TaxLine              `json:"tax_lines,omitempty"`
+	LineItems       []CheckoutLineItem     `json:"line_items,omitempty"`
+	CustomerID      string                 `json:"customer_id,omitempty"`
+	CustomerEmail   string                 `json:"customer_email,omitempty"`
+	PaymentIntentID string                 `json:"payment_intent_id,omitempty"`
+	SubscriptionID  string                 `json:"subscription_id,omitempty"`
+	SuccessURL      string                 `json:"success_url"`
+	CancelURL       string                 `json:"cancel_url"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	ExpiresAt       time.Time              `json:"expires_at"`
+	CreatedAt       time.Time              `json:"created_at"`
+}
+
+// CheckoutLineItem represents a line item on a checkout session
+type CheckoutLineItem struct {
+	PriceID     string `json:"price_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	UnitAmount  int64  `json:"unit_amount,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+	Quantity    int    `json:"quantity"`
+	AmountTotal int64  `json:"amount_total,omitempty"`
+}
+
+// CreateCheckoutSessionParams contains parameters for creating a checkout session
+type CreateCheckoutSessionParams struct {
+	Mode               string                 `json:"mode"`
+	LineItems          []CheckoutLineItem     `json:"line_items"`
+	SuccessURL         string                 `json:"success_url"`
+	CancelURL          string                 `json:"cancel_url"`
+	CustomerID         string                 `json:"customer_id,omitempty"`
+	CustomerEmail      string                 `json:"customer_email,omitempty"`
+	PaymentMethodTypes []string               `json:"payment_method_types,omitempty"`
+	ExpiresAt          *int64                 `json:"expires_at,omitempty"`
+	ClientReferenceID  string                 `json:"client_reference_id,omitempty"`
+	AutomaticTax       *AutomaticTax          `json:"automatic_tax,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Create creates a new checkout session
+func (s *CheckoutSessionsService) Create(ctx context.Context, params *CreateCheckoutSessionParams, opts *RequestOptions) (*CheckoutSession, error) {
+	data, err := s.client.post(ctx, "/payments/checkout/sessions", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var session CheckoutSession
+	if err := s.client.decode(data, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// Get retrieves a checkout session by ID
+func (s *CheckoutSessionsService) Get(ctx context.Context, sessionID string) (*CheckoutSession, error) {
+	data, err := s.client.get(ctx, "/payments/checkout/sessions/"+sessionID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var session CheckoutSession
+	if err := s.client.decode(data, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// Expire expires an open checkout session so it can no longer be paid
+func (s *CheckoutSessionsService) Expire(ctx context.Context, sessionID string) (*CheckoutSession, error) {
+	data, err := s.client.post(ctx, "/payments/checkout/sessions/"+sessionID+"/expire", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var session CheckoutSession
+	if err := s.client.decode(data, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,258 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Connected Accounts & Transfers
+// =============================================================================
+
+// ConnectedAccountsService provides access to marketplace connected account APIs
+type ConnectedAccountsService struct {
+	client *Client
+}
+
+// ConnectedAccount represents a reseller or partner account that receives payouts
+type ConnectedAccount struct {
+	ID               string                 `json:"id"`
+	Type             string                 `json:"type"`
+	Email            string                 `json:"email,omitempty"`
+	BusinessName     string                 `json:"business_name,omitempty"`
+	Country          string                 `json:"country"`
+	DefaultCurrency  string                 `json:"default_currency"`
+	ChargesEnabled   bool                   `json:"charges_enabled"`
+	PayoutsEnabled   bool                   `json:"payouts_enabled"`
+	DetailsSubmitted bool                   `json:"details_submitted"`
+	Requirements     *AccountRequirements   `json:"requirements,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt        time.Time              `json:"created_at"`
+}
+
+// AccountRequirements lists information still needed to onboard a connected account
+type AccountRequirements struct {
+	CurrentlyDue        []string   `json:"currently_due"`
+	PastDue             []string   `json:"past_due,omitempty"`
+	DisabledReason      string     `json:"disabled_reason,omitempty"`
+	CurrentDeadline     *time.Time `json:"current_deadline,omitempty"`
+	PendingVerification []string   `json:"pending_verification,omitempty"`
+}
+
+// CreateConnectedAccountParams contains parameters for creating a connected account
+type CreateConnectedAccountParams struct {
+	Type            string                 `json:"type"`
+	Email           string                 `json:"email,omitempty"`
+	BusinessName    string                 `json:"business_name,omitempty"`
+	Country         string                 `json:"country"`
+	DefaultCurrency string                 `json:"default_currency,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ListConnectedAccountsParams contains parameters for listing connected accounts
+type ListConnectedAccountsParams struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ConnectedAccountListResponse contains a list of connected accounts with cursor pagination
+type ConnectedAccountListResponse struct {
+	Data       []ConnectedAccount `json:"data"`
+	Pagination CursorPagination   `json:"pagination"`
+}
+
+// OnboardingLink is a single-use hosted onboarding URL for a connected account
+type OnboardingLink struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateOnboardingLinkParams contains parameters for creating an onboarding link
+type CreateOnboardingLinkParams struct {
+	RefreshURL string `json:"refresh_url"`
+	ReturnURL  string `json:"return_url"`
+	Type       string `json:"type,omitempty"`
+}
+
+// Balance contains the funds held for an account
+type Balance struct {
+	Available []BalanceAmount `json:"available"`
+	Pending   []BalanceAmount `json:"pending"`
+}
+
+// BalanceAmount is a balance in a single currency
+type BalanceAmount struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// Create creates a new connected account
+func (s *ConnectedAccountsService) Create(ctx context.Context, params *CreateConnectedAccountParams, opts *RequestOptions) (*ConnectedAccount, error) {
+	data, err := s.client.post(ctx, "/payments/accounts", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var account ConnectedAccount
+	if err := s.client.decode(data, &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// Get retrieves a connected account by ID
+func (s *ConnectedAccountsService) Get(ctx context.Context, accountID string) (*ConnectedAccount, error) {
+	data, err := s.client.get(ctx, "/payments/accounts/"+accountID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var account ConnectedAccount
+	if err := s.client.decode(data, &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// List retrieves connected accounts with cursor pagination
+func (s *ConnectedAccountsService) List(ctx context.Context, params *ListConnectedAccountsParams) (*ConnectedAccountListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/payments/accounts", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ConnectedAccountListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateOnboardingLink creates a hosted onboarding link for a connected account
+func (s *ConnectedAccountsService) CreateOnboardingLink(ctx context.Context, accountID string, params *CreateOnboardingLinkParams) (*OnboardingLink, error) {
+	data, err := s.client.post(ctx, "/payments/accounts/"+accountID+"/onboarding_links", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link OnboardingLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// GetBalance retrieves the balance held for a connected account
+func (s *ConnectedAccountsService) GetBalance(ctx context.Context, accountID string) (*Balance, error) {
+	data, err := s.client.get(ctx, "/payments/accounts/"+accountID+"/balance", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var balance Balance
+	if err := s.client.decode(data, &balance); err != nil {
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// Delete deletes a connected account
+func (s *ConnectedAccountsService) Delete(ctx context.Context, accountID string) error {
+	return s.client.delete(ctx, "/payments/accounts/"+accountID, nil)
+}
+
+// TransfersService provides access to transfers between the platform and connected accounts
+type TransfersService struct {
+	client *Client
+}
+
+// Transfer represents funds moved to a connected account
+type Transfer struct {
+	ID                string                 `json:"id"`
+	Amount            int64                  `json:"amount"`
+	AmountReversed    int64                  `json:"amount_reversed"`
+	Currency          string                 `json:"currency"`
+	DestinationID     string                 `json:"destination_id"`
+	SourceTransaction string                 `json:"source_transaction,omitempty"`
+	TransferGroup     string                 `json:"transfer_group,omitempty"`
+	Reversed          bool                   `json:"reversed"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt         time.Time              `json:"created_at"`
+}
+
+// CreateTransferParams contains parameters for creating a transfer
+type CreateTransferParams struct {
+	Amount            int64                  `json:"amount"`
+	Currency          string                 `json:"currency"`
+	DestinationID     string                 `json:"destination_id"`
+	SourceTransaction string                 `json:"source_transaction,omitempty"`
+	TransferGroup     string                 `json:"transfer_group,omitempty"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Create creates a new transfer to a connected account
+func (s *TransfersService) Create(ctx context.Context, params *CreateTransferParams, opts *RequestOptions) (*Transfer, error) {
+	data, err := s.client.post(ctx, "/payments/transfers", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfer Transfer
+	if err := s.client.decode(data, &transfer); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// Get retrieves a transfer by ID
+func (s *TransfersService) Get(ctx context.Context, transferID string) (*Transfer, error) {
+	data, err := s.client.get(ctx, "/payments/transfers/"+transferID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfer Transfer
+	if err := s.client.decode(data, &transfer); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// Reverse reverses all or part of a transfer
+func (s *TransfersService) Reverse(ctx context.Context, transferID string, amount *int64, opts *RequestOptions) (*Transfer, error) {
+	params := map[string]interface{}{}
+	if amount != nil {
+		params["amount"] = *amount
+	}
+
+	data, err := s.client.post(ctx, "/payments/transfers/"+transferID+"/reversals", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfer Transfer
+	if err := s.client.decode(data, &transfer); err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
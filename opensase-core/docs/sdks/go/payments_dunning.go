@@ -0,0 +1,212 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Payments Dunning
+// =============================================================================
+
+// DunningService provides access to failed-payment retry and dunning APIs
+type DunningService struct {
+	client *Client
+}
+
+// RetrySchedule controls how failed subscription payments are retried
+type RetrySchedule struct {
+	Mode            string         `json:"mode"`
+	Attempts        []RetryAttempt `json:"attempts,omitempty"`
+	MaxDurationDays int            `json:"max_duration_days"`
+	FinalAction     string         `json:"final_action"`
+	UpdatedAt       *time.Time     `json:"updated_at,omitempty"`
+}
+
+// RetryAttempt is a single retry in a fixed schedule, relative to the first failure
+type RetryAttempt struct {
+	DelayDays  int    `json:"delay_days"`
+	TemplateID string `json:"email_template_id,omitempty"`
+}
+
+// DunningTemplate represents an email sent to customers during dunning
+type DunningTemplate struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Trigger   string    `json:"trigger"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	Locale    string    `json:"locale,omitempty"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UpsertDunningTemplateParams contains parameters for creating or updating a dunning template
+type UpsertDunningTemplateParams struct {
+	Name    string `json:"name"`
+	Trigger string `json:"trigger"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	Locale  string `json:"locale,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// DunningSubscription is a subscription with an outstanding failed payment
+type DunningSubscription struct {
+	SubscriptionID string     `json:"subscription_id"`
+	CustomerID     string     `json:"customer_id"`
+	InvoiceID      string     `json:"invoice_id"`
+	AmountDue      int64      `json:"amount_due"`
+	Currency       string     `json:"currency"`
+	AttemptCount   int        `json:"attempt_count"`
+	LastFailure    string     `json:"last_failure_code,omitempty"`
+	FirstFailedAt  time.Time  `json:"first_failed_at"`
+	NextRetryAt    *time.Time `json:"next_retry_at,omitempty"`
+	FinalActionAt  *time.Time `json:"final_action_at,omitempty"`
+}
+
+// ListDunningParams contains parameters for listing subscriptions in dunning
+type ListDunningParams struct {
+	Page          int     `json:"page,omitempty"`
+	PerPage       int     `json:"per_page,omitempty"`
+	CustomerID    *string `json:"customer_id,omitempty"`
+	NextRetryFrom *string `json:"next_retry_from,omitempty"`
+	NextRetryTo   *string `json:"next_retry_to,omitempty"`
+}
+
+// DunningListResponse contains a list of subscriptions in dunning with pagination
+type DunningListResponse struct {
+	Data       []DunningSubscription `json:"data"`
+	Pagination Pagination            `json:"pagination"`
+}
+
+// GetRetrySchedule retrieves the tenant's payment retry schedule
+func (s *DunningService) GetRetrySchedule(ctx context.Context) (*RetrySchedule, error) {
+	data, err := s.client.get(ctx, "/payments/dunning/retry_schedule", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule RetrySchedule
+	if err := s.client.decode(data, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// UpdateRetrySchedule replaces the tenant's payment retry schedule
+func (s *DunningService) UpdateRetrySchedule(ctx context.Context, schedule *RetrySchedule) (*RetrySchedule, error) {
+	data, err := s.client.request(ctx, "PUT", "/payments/dunning/retry_schedule", schedule, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated RetrySchedule
+	if err := s.client.decode(data, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// ListTemplates retrieves all dunning email templates
+func (s *DunningService) ListTemplates(ctx context.Context) ([]DunningTemplate, error) {
+	data, err := s.client.get(ctx, "/payments/dunning/templates", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []DunningTemplate
+	if err := s.client.decode(data, &templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// CreateTemplate creates a new dunning email template
+func (s *DunningService) CreateTemplate(ctx context.Context, params *UpsertDunningTemplateParams) (*DunningTemplate, error) {
+	data, err := s.client.post(ctx, "/payments/dunning/templates", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template DunningTemplate
+	if err := s.client.decode(data, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// UpdateTemplate updates a dunning email template
+func (s *DunningService) UpdateTemplate(ctx context.Context, templateID string, params *UpsertDunningTemplateParams) (*DunningTemplate, error) {
+	data, err := s.client.patch(ctx, "/payments/dunning/templates/"+templateID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template DunningTemplate
+	if err := s.client.decode(data, &template); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// DeleteTemplate deletes a dunning email template
+func (s *DunningService) DeleteTemplate(ctx context.Context, templateID string) error {
+	return s.client.delete(ctx, "/payments/dunning/templates/"+templateID, nil)
+}
+
+// ListSubscriptions retrieves subscriptions currently in dunning with their next retry times
+func (s *DunningService) ListSubscriptions(ctx context.Context, params *ListDunningParams) (*DunningListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.CustomerID != nil {
+			v.Set("customer_id", *params.CustomerID)
+		}
+		if params.NextRetryFrom != nil {
+			v.Set("next_retry_from", *params.NextRetryFrom)
+		}
+		if params.NextRetryTo != nil {
+			v.Set("next_retry_to", *params.NextRetryTo)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/payments/dunning/subscriptions", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response DunningListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RetryNow immediately retries the outstanding payment of a subscription in dunning
+func (s *DunningService) RetryNow(ctx context.Context, subscriptionID string, opts *RequestOptions) (*DunningSubscription, error) {
+	data, err := s.client.post(ctx, "/payments/dunning/subscriptions/"+subscriptionID+"/retry", nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var sub DunningSubscription
+	if err := s.client.decode(data, &sub); err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
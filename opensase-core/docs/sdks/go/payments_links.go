@@ -0,0 +1,200 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Payment Links
+// =============================================================================
+
+// Payment link webhook event types
+const (
+	EventPaymentLinkCompleted   = "payments.payment_link.completed"
+	EventPaymentLinkExpired     = "payments.payment_link.expired"
+	EventPaymentLinkDeactivated = "payments.payment_link.deactivated"
+)
+
+// PaymentLinksService provides access to shareable payment link APIs
+type PaymentLinksService struct {
+	client *Client
+}
+
+// PaymentLink represents a shareable payment link
+type PaymentLink struct {
+	ID              string                 `json:"id"`
+	URL             string                 `json:"url"`
+	Active          bool                   `json:"active"`
+	Amount          int64                  `json:"amount,omitempty"`
+	Currency        string                 `json:"currency,omitempty"`
+	PriceID         string                 `json:"price_id,omitempty"`
+	Description     string                 `json:"description,omitempty"`
+	Quantity        *PaymentLinkQuantity   `json:"quantity,omitempty"`
+	MaxCompletions  int                    `json:"max_completions,omitempty"`
+	CompletionCount int                    `json:"completion_count"`
+	AfterCompletion string                 `json:"after_completion_url,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	ExpiresAt       *time.Time             `json:"expires_at,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+}
+
+// PaymentLinkQuantity controls the quantity a customer may purchase
+type PaymentLinkQuantity struct {
+	Default    int  `json:"default"`
+	Adjustable bool `json:"adjustable"`
+	Minimum    int  `json:"minimum,omitempty"`
+	Maximum    int  `json:"maximum,omitempty"`
+}
+
+// PaymentLinkCompletion describes a completed purchase through a payment link
+type PaymentLinkCompletion struct {
+	PaymentLinkID   string                 `json:"payment_link_id"`
+	PaymentIntentID string                 `json:"payment_intent_id"`
+	CustomerID      string                 `json:"customer_id,omitempty"`
+	CustomerEmail   string                 `json:"customer_email,omitempty"`
+	Quantity        int                    `json:"quantity"`
+	AmountTotal     int64                  `json:"amount_total"`
+	Currency        string                 `json:"currency"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	CompletedAt     time.Time              `json:"completed_at"`
+}
+
+// CreatePaymentLinkParams contains parameters for creating a payment link.
+// Either Amount and Currency or PriceID must be set.
+type CreatePaymentLinkParams struct {
+	Amount             int64                  `json:"amount,omitempty"`
+	Currency           string                 `json:"currency,omitempty"`
+	PriceID            string                 `json:"price_id,omitempty"`
+	Description        string                 `json:"description,omitempty"`
+	Quantity           *PaymentLinkQuantity   `json:"quantity,omitempty"`
+	MaxCompletions     int                    `json:"max_completions,omitempty"`
+	AfterCompletionURL string                 `json:"after_completion_url,omitempty"`
+	ExpiresAt          *int64                 `json:"expires_at,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// UpdatePaymentLinkParams contains parameters for updating a payment link
+type UpdatePaymentLinkParams struct {
+	Active         *bool                  `json:"active,omitempty"`
+	Description    *string                `json:"description,omitempty"`
+	MaxCompletions *int                   `json:"max_completions,omitempty"`
+	ExpiresAt      *int64                 `json:"expires_at,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ListPaymentLinksParams contains parameters for listing payment links
+type ListPaymentLinksParams struct {
+	Limit  int     `json:"limit,omitempty"`
+	Cursor string  `json:"cursor,omitempty"`
+	Active *bool   `json:"active,omitempty"`
+	Search *string `json:"search,omitempty"`
+}
+
+// PaymentLinkListResponse contains a list of payment links with cursor pagination
+type PaymentLinkListResponse struct {
+	Data       []PaymentLink    `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// Create creates a new payment link
+func (s *PaymentLinksService) Create(ctx context.Context, params *CreatePaymentLinkParams, opts *RequestOptions) (*PaymentLink, error) {
+	if params.PriceID == "" && params.Amount <= 0 {
+		return nil, fmt.Errorf("opensase: payment link requires an amount or a price_id")
+	}
+
+	data, err := s.client.post(ctx, "/payments/links", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var link PaymentLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Get retrieves a payment link by ID
+func (s *PaymentLinksService) Get(ctx context.Context, linkID string) (*PaymentLink, error) {
+	data, err := s.client.get(ctx, "/payments/links/"+linkID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link PaymentLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Update updates a payment link
+func (s *PaymentLinksService) Update(ctx context.Context, linkID string, params *UpdatePaymentLinkParams) (*PaymentLink, error) {
+	data, err := s.client.patch(ctx, "/payments/links/"+linkID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link PaymentLink
+	if err := s.client.decode(data, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Deactivate disables a payment link so it can no longer be used
+func (s *PaymentLinksService) Deactivate(ctx context.Context, linkID string) (*PaymentLink, error) {
+	return s.Update(ctx, linkID, &UpdatePaymentLinkParams{Active: Bool(false)})
+}
+
+// List retrieves payment links with cursor pagination
+func (s *PaymentLinksService) List(ctx context.Context, params *ListPaymentLinksParams) (*PaymentLinkListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+		if params.Active != nil {
+			v.Set("active", strconv.FormatBool(*params.Active))
+		}
+		if params.Search != nil {
+			v.Set("search", *params.Search)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/payments/links", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response PaymentLinkListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// PaymentLinkCompletionFromEvent extracts the completion carried by a payment link webhook event
+func PaymentLinkCompletionFromEvent(event *WebhookEvent) (*PaymentLinkCompletion, error) {
+	if event.Type != EventPaymentLinkCompleted {
+		return nil, fmt.Errorf("unexpected event type %q", event.Type)
+	}
+
+	var completion PaymentLinkCompletion
+	if err := decodeEventObject(event, &completion); err != nil {
+		return nil, err
+	}
+
+	return &completion, nil
+}
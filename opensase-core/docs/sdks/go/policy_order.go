@@ -0,0 +1,135 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// =============================================================================
+// Policy Ordering
+// =============================================================================
+
+// PolicyMove places a policy directly after another. An empty AfterID moves
+// the policy to the top of the rule base.
+type PolicyMove struct {
+	PolicyID string `json:"policy_id"`
+	AfterID  string `json:"after_id,omitempty"`
+}
+
+// Reorder atomically sets the evaluation order of all policies. orderedIDs
+// must contain every policy exactly once; priorities are reassigned
+// server-side in a single transaction.
+func (s *PoliciesService) Reorder(ctx context.Context, orderedIDs []string) ([]Policy, error) {
+	params := map[string]interface{}{
+		"order": orderedIDs,
+	}
+
+	data, err := s.client.post(ctx, "/policies/reorder", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Move atomically applies a sequence of moves, in order. Combined with
+// ComputePolicyMoves this touches only the policies whose position changes.
+func (s *PoliciesService) Move(ctx context.Context, moves []PolicyMove) ([]Policy, error) {
+	params := map[string]interface{}{
+		"moves": moves,
+	}
+
+	data, err := s.client.post(ctx, "/policies/reorder", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// ComputePolicyMoves returns the smallest set of moves that transforms the
+// current order into the desired one. Policies forming the longest run
+// already in the right relative order stay put; every other policy is moved
+// once, after its desired predecessor. Both slices must contain the same IDs.
+func ComputePolicyMoves(current, desired []string) ([]PolicyMove, error) {
+	if len(current) != len(desired) {
+		return nil, fmt.Errorf("opensase: current has %d policies, desired has %d", len(current), len(desired))
+	}
+
+	target := make(map[string]int, len(desired))
+	for i, id := range desired {
+		if _, dup := target[id]; dup {
+			return nil, fmt.Errorf("opensase: policy %s appears twice in desired order", id)
+		}
+		target[id] = i
+	}
+
+	seq := make([]int, len(current))
+	for i, id := range current {
+		pos, ok := target[id]
+		if !ok {
+			return nil, fmt.Errorf("opensase: policy %s missing from desired order", id)
+		}
+		seq[i] = pos
+	}
+
+	keep := make(map[int]bool, len(seq))
+	for _, pos := range longestIncreasing(seq) {
+		keep[pos] = true
+	}
+
+	var moves []PolicyMove
+	for i, id := range desired {
+		if keep[i] {
+			continue
+		}
+		move := PolicyMove{PolicyID: id}
+		if i > 0 {
+			move.AfterID = desired[i-1]
+		}
+		moves = append(moves, move)
+	}
+
+	return moves, nil
+}
+
+// longestIncreasing returns the values of a longest strictly increasing subsequence
+func longestIncreasing(seq []int) []int {
+	var tails []int // index into seq of the smallest tail of each length
+	prev := make([]int, len(seq))
+
+	for i, v := range seq {
+		n := sort.Search(len(tails), func(j int) bool { return seq[tails[j]] >= v })
+		if n > 0 {
+			prev[i] = tails[n-1]
+		} else {
+			prev[i] = -1
+		}
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	out := make([]int, len(tails))
+	if len(tails) == 0 {
+		return out
+	}
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i-- {
+		out[i] = seq[k]
+		k = prev[k]
+	}
+	return out
+}
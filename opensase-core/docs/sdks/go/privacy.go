@@ -0,0 +1,221 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Privacy Service
+// =============================================================================
+
+// De-anonymization request statuses
+const (
+	DeanonymizationPending  = "pending"
+	DeanonymizationApproved = "approved"
+	DeanonymizationRejected = "rejected"
+	DeanonymizationExpired  = "expired"
+)
+
+// PrivacyService provides access to privacy and data protection APIs
+type PrivacyService struct {
+	client           *Client
+	Pseudonymization *PseudonymizationService
+}
+
+// PseudonymizationService provides access to log pseudonymization and the
+// dual-control de-anonymization flow
+type PseudonymizationService struct {
+	client *Client
+}
+
+// PseudonymizationSettings controls how user identities appear in logs
+type PseudonymizationSettings struct {
+	Enabled           bool       `json:"enabled"`
+	Fields            []string   `json:"fields"`
+	Regions           []string   `json:"regions,omitempty"`
+	RequiredApprovers int        `json:"required_approvers"`
+	ApproverGroupID   string     `json:"approver_group_id,omitempty"`
+	GrantTTLMinutes   int        `json:"grant_ttl_minutes"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// UpdatePseudonymizationParams contains parameters for changing pseudonymization settings
+type UpdatePseudonymizationParams struct {
+	Enabled           *bool    `json:"enabled,omitempty"`
+	Fields            []string `json:"fields,omitempty"`
+	Regions           []string `json:"regions,omitempty"`
+	RequiredApprovers *int     `json:"required_approvers,omitempty"`
+	ApproverGroupID   *string  `json:"approver_group_id,omitempty"`
+	GrantTTLMinutes   *int     `json:"grant_ttl_minutes,omitempty"`
+}
+
+// DeanonymizationRequest asks to reveal the identities behind pseudonyms.
+// It is granted only after the required number of approvers, none of whom
+// may be the requester, have approved it.
+type DeanonymizationRequest struct {
+	ID             string                    `json:"id"`
+	Status         string                    `json:"status"`
+	Pseudonyms     []string                  `json:"pseudonyms"`
+	Justification  string                    `json:"justification"`
+	CaseRef        string                    `json:"case_reference,omitempty"`
+	RequestedBy    string                    `json:"requested_by"`
+	Decisions      []DeanonymizationDecision `json:"decisions,omitempty"`
+	Identities     map[string]string         `json:"identities,omitempty"`
+	GrantExpiresAt *time.Time                `json:"grant_expires_at,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+}
+
+// DeanonymizationDecision is an approver's decision on a de-anonymization request
+type DeanonymizationDecision struct {
+	ApproverID string    `json:"approver_id"`
+	Approved   bool      `json:"approved"`
+	Comment    string    `json:"comment,omitempty"`
+	DecidedAt  time.Time `json:"decided_at"`
+}
+
+// CreateDeanonymizationParams contains parameters for requesting de-anonymization
+type CreateDeanonymizationParams struct {
+	Pseudonyms    []string `json:"pseudonyms"`
+	Justification string   `json:"justification"`
+	CaseRef       string   `json:"case_reference,omitempty"`
+}
+
+// ListDeanonymizationParams contains parameters for listing de-anonymization requests
+type ListDeanonymizationParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	Status  *string `json:"status,omitempty"`
+}
+
+// DeanonymizationListResponse contains a list of de-anonymization requests with pagination
+type DeanonymizationListResponse struct {
+	Data       []DeanonymizationRequest `json:"data"`
+	Pagination Pagination               `json:"pagination"`
+}
+
+// GetSettings retrieves the tenant's pseudonymization settings
+func (s *PseudonymizationService) GetSettings(ctx context.Context) (*PseudonymizationSettings, error) {
+	data, err := s.client.get(ctx, "/privacy/pseudonymization", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings PseudonymizationSettings
+	if err := s.client.decode(data, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateSettings changes the tenant's pseudonymization settings
+func (s *PseudonymizationService) UpdateSettings(ctx context.Context, params *UpdatePseudonymizationParams) (*PseudonymizationSettings, error) {
+	data, err := s.client.patch(ctx, "/privacy/pseudonymization", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings PseudonymizationSettings
+	if err := s.client.decode(data, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// RequestDeanonymization opens a de-anonymization request for approval
+func (s *PseudonymizationService) RequestDeanonymization(ctx context.Context, params *CreateDeanonymizationParams) (*DeanonymizationRequest, error) {
+	data, err := s.client.post(ctx, "/privacy/deanonymization_requests", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var request DeanonymizationRequest
+	if err := s.client.decode(data, &request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// GetDeanonymization retrieves a de-anonymization request. Identities is
+// populated only for the requester while the grant is active.
+func (s *PseudonymizationService) GetDeanonymization(ctx context.Context, requestID string) (*DeanonymizationRequest, error) {
+	data, err := s.client.get(ctx, "/privacy/deanonymization_requests/"+requestID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var request DeanonymizationRequest
+	if err := s.client.decode(data, &request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// ListDeanonymizations retrieves de-anonymization requests with pagination
+func (s *PseudonymizationService) ListDeanonymizations(ctx context.Context, params *ListDeanonymizationParams) (*DeanonymizationListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/privacy/deanonymization_requests", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response DeanonymizationListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Approve records the caller's approval of a de-anonymization request
+func (s *PseudonymizationService) Approve(ctx context.Context, requestID, comment string) (*DeanonymizationRequest, error) {
+	return s.decide(ctx, requestID, "approve", comment)
+}
+
+// Reject rejects a de-anonymization request
+func (s *PseudonymizationService) Reject(ctx context.Context, requestID, comment string) (*DeanonymizationRequest, error) {
+	return s.decide(ctx, requestID, "reject", comment)
+}
+
+func (s *PseudonymizationService) decide(ctx context.Context, requestID, action, comment string) (*DeanonymizationRequest, error) {
+	params := map[string]interface{}{}
+	if comment != "" {
+		params["comment"] = comment
+	}
+
+	data, err := s.client.post(ctx, "/privacy/deanonymization_requests/"+requestID+"/"+action, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var request DeanonymizationRequest
+	if err := s.client.decode(data, &request); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// AuditTrail retrieves the audit trail of a de-anonymization request,
+// including every reveal of the resulting identities
+func (s *PseudonymizationService) AuditTrail(ctx context.Context, requestID string, params *HistoryParams) (*ChangeLog, error) {
+	return s.client.history(ctx, "/privacy/deanonymization_requests/"+requestID+"/audit", params)
+}
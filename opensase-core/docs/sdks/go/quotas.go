@@ -0,0 +1,120 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// Quotas
+// =============================================================================
+
+// Common quota resource names
+const (
+	QuotaSites            = "sites"
+	QuotaTunnels          = "tunnels"
+	QuotaFirewallRules    = "firewall_rules"
+	QuotaPolicies         = "policies"
+	QuotaUsers            = "users"
+	QuotaAddressObjects   = "address_objects"
+	QuotaServiceObjects   = "service_objects"
+	QuotaZTNAApplications = "ztna_applications"
+)
+
+// QuotasService provides access to the tenant's limits and current consumption
+type QuotasService struct {
+	client *Client
+}
+
+// Quotas contains the tenant's API rate limits and object count limits
+// together with current consumption
+type Quotas struct {
+	RateLimits []RateLimitQuota `json:"rate_limits"`
+	Objects    []ObjectQuota    `json:"objects"`
+	AsOf       time.Time        `json:"as_of"`
+}
+
+// RateLimitQuota is an API rate limit and the consumption of its current window
+type RateLimitQuota struct {
+	Scope         string    `json:"scope"`
+	Limit         int       `json:"limit"`
+	Remaining     int       `json:"remaining"`
+	WindowSeconds int       `json:"window_seconds"`
+	ResetAt       time.Time `json:"reset_at"`
+}
+
+// ObjectQuota is the maximum number of objects of a type the tenant may
+// have. A Limit of zero or less means the type is unlimited.
+type ObjectQuota struct {
+	Resource string `json:"resource"`
+	Limit    int    `json:"limit"`
+	Used     int    `json:"used"`
+}
+
+// Unlimited reports whether the object type has no limit
+func (q *ObjectQuota) Unlimited() bool {
+	return q.Limit <= 0
+}
+
+// Available returns how many more objects may be created, or -1 if unlimited
+func (q *ObjectQuota) Available() int {
+	if q.Unlimited() {
+		return -1
+	}
+	if q.Used >= q.Limit {
+		return 0
+	}
+	return q.Limit - q.Used
+}
+
+// Object returns the quota for a resource type
+func (q *Quotas) Object(resource string) (*ObjectQuota, bool) {
+	for i := range q.Objects {
+		if q.Objects[i].Resource == resource {
+			return &q.Objects[i], true
+		}
+	}
+	return nil, false
+}
+
+// RateLimit returns the rate limit for a scope
+func (q *Quotas) RateLimit(scope string) (*RateLimitQuota, bool) {
+	for i := range q.RateLimits {
+		if q.RateLimits[i].Scope == scope {
+			return &q.RateLimits[i], true
+		}
+	}
+	return nil, false
+}
+
+// Check reports whether n more objects of a resource type fit within the
+// tenant's quota. It returns an *Error with code quota_exceeded, the same
+// error the API would return part-way through the work. Resource types
+// without a quota always fit.
+func (q *Quotas) Check(resource string, n int) error {
+	obj, ok := q.Object(resource)
+	if !ok || obj.Unlimited() || obj.Used+n <= obj.Limit {
+		return nil
+	}
+	return &Error{
+		Code: string(ErrCodeQuotaExceeded),
+		Message: fmt.Sprintf("creating %d %s would exceed the tenant limit of %d (%d in use, %d available)",
+			n, resource, obj.Limit, obj.Used, obj.Available()),
+	}
+}
+
+// Get retrieves the tenant's current limits and consumption
+func (s *QuotasService) Get(ctx context.Context) (*Quotas, error) {
+	data, err := s.client.get(ctx, "/quotas", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quotas Quotas
+	if err := s.client.decode(data, &quotas); err != nil {
+		return nil, err
+	}
+
+	return &quotas, nil
+}
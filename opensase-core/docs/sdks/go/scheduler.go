@@ -0,0 +1,237 @@
+package opensase
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// Request Scheduler
+// =============================================================================
+
+// Priority orders outgoing requests competing for the client's rate limit
+type Priority int
+
+// Request priorities
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+
+	numPriorities = 3
+)
+
+// WithPriority returns a context under which requests are scheduled with the
+// given priority. It only has an effect on clients configured WithRateLimit.
+//
+//	// nightly sync must not starve interactive traffic
+//	ctx = opensase.WithPriority(ctx, opensase.PriorityLow)
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	p = p.clamp()
+	return withCallOptions(ctx, func(o *callOptions) {
+		o.priority = p
+		o.prioritySet = true
+	})
+}
+
+// clamp limits p to the defined priorities
+func (p Priority) clamp() Priority {
+	if p < PriorityLow {
+		return PriorityLow
+	}
+	if p > PriorityHigh {
+		return PriorityHigh
+	}
+	return p
+}
+
+// WithRateLimit limits the client to rps requests per second with the given
+// burst, shared by all goroutines using the client. Requests waiting for
+// budget are released strictly in priority order, FIFO within a priority.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		if rps <= 0 {
+			c.scheduler = nil
+			return
+		}
+		c.scheduler = newScheduler(rps, burst)
+	}
+}
+
+// RateLimiter is a request budget that can be shared by several clients
+// and by code pacing its own work, such as worker pools, so that bulk jobs
+// using one API key draw on one quota
+type RateLimiter struct {
+	s *scheduler
+}
+
+// NewRateLimiter returns a limiter allowing rps requests per second with
+// the given burst. Waiters are released in priority order as with
+// WithRateLimit.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if rps <= 0 {
+		rps = 1
+	}
+	return &RateLimiter{s: newScheduler(rps, burst)}
+}
+
+// Wait blocks until a request of priority p may be sent or ctx is done.
+// Priorities outside PriorityLow..PriorityHigh count as the nearest one.
+func (l *RateLimiter) Wait(ctx context.Context, p Priority) error {
+	return l.s.acquire(ctx, p.clamp())
+}
+
+// WithSharedRateLimit makes the client draw on l instead of a budget of its
+// own. It replaces any WithRateLimit setting.
+func WithSharedRateLimit(l *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.scheduler = l.s
+	}
+}
+
+// RateLimiter returns the limiter of a client configured WithRateLimit or
+// WithSharedRateLimit, or nil
+func (c *Client) RateLimiter() *RateLimiter {
+	if c.scheduler == nil {
+		return nil
+	}
+	return &RateLimiter{s: c.scheduler}
+}
+
+type waiter struct {
+	ch      chan struct{}
+	granted bool
+}
+
+// scheduler is a token bucket whose waiters are served by priority
+type scheduler struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	queues  [numPriorities][]*waiter
+	running bool
+}
+
+func newScheduler(rps float64, burst int) *scheduler {
+	if burst < 1 {
+		burst = 1
+	}
+	return &scheduler{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// acquire blocks until a request of priority p may be sent
+func (s *scheduler) acquire(ctx context.Context, p Priority) error {
+	p = p.clamp()
+	s.mu.Lock()
+	s.refill()
+	if s.tokens >= 1 && !s.hasWaitersAtOrAbove(p) {
+		s.tokens--
+		s.mu.Unlock()
+		return nil
+	}
+
+	w := &waiter{ch: make(chan struct{})}
+	s.queues[p] = append(s.queues[p], w)
+	if !s.running {
+		s.running = true
+		go s.dispatch()
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-w.ch:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if w.granted {
+			// Lost the race with dispatch; hand the token back.
+			s.tokens++
+		} else {
+			s.remove(p, w)
+		}
+		return ctx.Err()
+	}
+}
+
+// dispatch releases waiters as tokens become available and exits once idle
+func (s *scheduler) dispatch() {
+	for {
+		s.mu.Lock()
+		s.refill()
+		for s.tokens >= 1 {
+			w := s.pop()
+			if w == nil {
+				break
+			}
+			w.granted = true
+			close(w.ch)
+			s.tokens--
+		}
+		if s.empty() {
+			s.running = false
+			s.mu.Unlock()
+			return
+		}
+		wait := time.Duration((1 - s.tokens) / s.rate * float64(time.Second))
+		s.mu.Unlock()
+
+		time.Sleep(wait)
+	}
+}
+
+func (s *scheduler) refill() {
+	now := time.Now()
+	s.tokens += now.Sub(s.last).Seconds() * s.rate
+	if s.tokens > s.burst {
+		s.tokens = s.burst
+	}
+	s.last = now
+}
+
+func (s *scheduler) hasWaitersAtOrAbove(p Priority) bool {
+	for i := p; i < numPriorities; i++ {
+		if len(s.queues[i]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *scheduler) pop() *waiter {
+	for i := numPriorities - 1; i >= 0; i-- {
+		if len(s.queues[i]) > 0 {
+			w := s.queues[i][0]
+			s.queues[i] = s.queues[i][1:]
+			return w
+		}
+	}
+	return nil
+}
+
+func (s *scheduler) remove(p Priority, w *waiter) {
+	q := s.queues[p]
+	for i := range q {
+		if q[i] == w {
+			s.queues[p] = append(q[:i], q[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *scheduler) empty() bool {
+	for i := range s.queues {
+		if len(s.queues[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
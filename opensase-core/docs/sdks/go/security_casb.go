@@ -0,0 +1,159 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// CASB
+// =============================================================================
+
+// SaaS activities controlled by CASB policies
+const (
+	CASBActivityUpload   = "upload"
+	CASBActivityDownload = "download"
+	CASBActivityShare    = "share"
+	CASBActivityDelete   = "delete"
+	CASBActivityLogin    = "login"
+)
+
+// CASBService provides access to cloud access security broker policy APIs
+type CASBService struct {
+	client *Client
+}
+
+// CASBPolicy governs SaaS usage: which apps are allowed, which tenants of
+// those apps may be used, and what users may do in them
+type CASBPolicy struct {
+	ID                 string                  `json:"id"`
+	Name               string                  `json:"name"`
+	Description        string                  `json:"description,omitempty"`
+	SanctionedApps     []string                `json:"sanctioned_apps"`
+	UnsanctionedApps   []string                `json:"unsanctioned_apps"`
+	TenantRestrictions []CASBTenantRestriction `json:"tenant_restrictions"`
+	ActivityControls   []CASBActivityControl   `json:"activity_controls"`
+	ShadowIT           CASBShadowITSettings    `json:"shadow_it"`
+	Enabled            bool                    `json:"enabled"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+}
+
+// CASBTenantRestriction limits an app to the listed tenants, such as the
+// corporate Microsoft 365 tenant, blocking personal or foreign accounts
+type CASBTenantRestriction struct {
+	App            string   `json:"app"`
+	AllowedTenants []string `json:"allowed_tenants"`
+}
+
+// CASBActivityControl is the action taken when users perform an activity in
+// an app. User groups narrow the control; empty applies to everyone.
+type CASBActivityControl struct {
+	App        string   `json:"app"`
+	Activity   string   `json:"activity"`
+	Action     string   `json:"action"`
+	UserGroups []string `json:"user_groups,omitempty"`
+}
+
+// CASBShadowITSettings controls discovery of unmanaged SaaS apps. An app is
+// reported once it crosses any of the thresholds; risk scores range from 0
+// (safe) to 100.
+type CASBShadowITSettings struct {
+	Enabled            bool `json:"enabled"`
+	MinUsers           int  `json:"min_users,omitempty"`
+	MinUploadMB        int  `json:"min_upload_mb,omitempty"`
+	RiskScoreThreshold int  `json:"risk_score_threshold,omitempty"`
+	// BlockRisky blocks discovered apps at or above RiskScoreThreshold
+	BlockRisky bool `json:"block_risky"`
+}
+
+// CreateCASBPolicyParams contains parameters for creating a CASB policy
+type CreateCASBPolicyParams struct {
+	Name               string                  `json:"name"`
+	Description        string                  `json:"description,omitempty"`
+	SanctionedApps     []string                `json:"sanctioned_apps,omitempty"`
+	UnsanctionedApps   []string                `json:"unsanctioned_apps,omitempty"`
+	TenantRestrictions []CASBTenantRestriction `json:"tenant_restrictions,omitempty"`
+	ActivityControls   []CASBActivityControl   `json:"activity_controls,omitempty"`
+	ShadowIT           *CASBShadowITSettings   `json:"shadow_it,omitempty"`
+	Enabled            *bool                   `json:"enabled,omitempty"`
+}
+
+// UpdateCASBPolicyParams contains parameters for updating a CASB policy.
+// List fields replace the existing list; set them to an empty slice to
+// clear it.
+type UpdateCASBPolicyParams struct {
+	Name               *string                  `json:"name,omitempty"`
+	Description        *string                  `json:"description,omitempty"`
+	SanctionedApps     *[]string                `json:"sanctioned_apps,omitempty"`
+	UnsanctionedApps   *[]string                `json:"unsanctioned_apps,omitempty"`
+	TenantRestrictions *[]CASBTenantRestriction `json:"tenant_restrictions,omitempty"`
+	ActivityControls   *[]CASBActivityControl   `json:"activity_controls,omitempty"`
+	ShadowIT           *CASBShadowITSettings    `json:"shadow_it,omitempty"`
+	Enabled            *bool                    `json:"enabled,omitempty"`
+}
+
+// List retrieves all CASB policies
+func (s *CASBService) List(ctx context.Context) ([]CASBPolicy, error) {
+	data, err := s.client.get(ctx, "/security/casb/policies", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []CASBPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Create creates a new CASB policy
+func (s *CASBService) Create(ctx context.Context, params *CreateCASBPolicyParams) (*CASBPolicy, error) {
+	data, err := s.client.post(ctx, "/security/casb/policies", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy CASBPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Get retrieves a CASB policy by ID
+func (s *CASBService) Get(ctx context.Context, policyID string) (*CASBPolicy, error) {
+	data, err := s.client.get(ctx, "/security/casb/policies/"+policyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy CASBPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update updates a CASB policy
+func (s *CASBService) Update(ctx context.Context, policyID string, params *UpdateCASBPolicyParams) (*CASBPolicy, error) {
+	data, err := s.client.patch(ctx, "/security/casb/policies/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy CASBPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Delete deletes a CASB policy
+func (s *CASBService) Delete(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/security/casb/policies/"+policyID, nil)
+}
@@ -0,0 +1,140 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Custom Applications
+// =============================================================================
+
+// CustomAppsService provides access to tenant-defined applications. Custom
+// applications are listed in the application catalog with Custom set, so
+// policies and app rules reference them like built-in applications.
+type CustomAppsService struct {
+	client *Client
+}
+
+// CustomApplication is an in-house application identified by L7 match
+// criteria rather than a built-in signature
+type CustomApplication struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Category    string         `json:"category"`
+	Description string         `json:"description,omitempty"`
+	RiskLevel   int            `json:"risk_level"`
+	Match       CustomAppMatch `json:"match"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// CustomAppMatch identifies the traffic of a custom application. Traffic
+// matching any domain, port or IP range is classified as the app. Domains
+// are matched against TLS SNI, HTTP Host and DNS queries and may start with
+// a *. wildcard label. IPRanges are CIDRs.
+type CustomAppMatch struct {
+	Domains  []string  `json:"domains,omitempty"`
+	Ports    []AppPort `json:"ports,omitempty"`
+	IPRanges []string  `json:"ip_ranges,omitempty"`
+}
+
+// CreateCustomAppParams contains parameters for creating a custom
+// application. Category must be a category of the application catalog.
+type CreateCustomAppParams struct {
+	Name        string         `json:"name"`
+	Category    string         `json:"category"`
+	Description string         `json:"description,omitempty"`
+	RiskLevel   int            `json:"risk_level"`
+	Match       CustomAppMatch `json:"match"`
+}
+
+// UpdateCustomAppParams contains parameters for updating a custom
+// application. Match replaces the existing match criteria.
+type UpdateCustomAppParams struct {
+	Name        *string         `json:"name,omitempty"`
+	Category    *string         `json:"category,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	RiskLevel   *int            `json:"risk_level,omitempty"`
+	Match       *CustomAppMatch `json:"match,omitempty"`
+}
+
+// List retrieves all custom applications
+func (s *CustomAppsService) List(ctx context.Context) ([]CustomApplication, error) {
+	data, err := s.client.get(ctx, "/security/custom_applications", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []CustomApplication
+	if err := s.client.decode(data, &apps); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// Create creates a new custom application
+func (s *CustomAppsService) Create(ctx context.Context, params *CreateCustomAppParams) (*CustomApplication, error) {
+	data, err := s.client.post(ctx, "/security/custom_applications", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCatalog()
+
+	var app CustomApplication
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Get retrieves a custom application by ID
+func (s *CustomAppsService) Get(ctx context.Context, appID string) (*CustomApplication, error) {
+	data, err := s.client.get(ctx, "/security/custom_applications/"+appID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app CustomApplication
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Update updates a custom application
+func (s *CustomAppsService) Update(ctx context.Context, appID string, params *UpdateCustomAppParams) (*CustomApplication, error) {
+	data, err := s.client.patch(ctx, "/security/custom_applications/"+appID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCatalog()
+
+	var app CustomApplication
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Delete deletes a custom application. Applications referenced by a policy
+// or app rule cannot be deleted.
+func (s *CustomAppsService) Delete(ctx context.Context, appID string) error {
+	if err := s.client.delete(ctx, "/security/custom_applications/"+appID, nil); err != nil {
+		return err
+	}
+	s.invalidateCatalog()
+	return nil
+}
+
+// invalidateCatalog drops the cached application catalog so the next read
+// reflects the change
+func (s *CustomAppsService) invalidateCatalog() {
+	if rc := s.client.refCache; rc != nil {
+		_ = rc.cache.Delete(s.client.cacheKey(catalogApplicationsPath))
+	}
+}
@@ -0,0 +1,156 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Geo Restriction
+// =============================================================================
+
+// Geo restriction modes
+const (
+	GeoModeBlockList = "block_list" // traffic with the listed countries is restricted
+	GeoModeAllowList = "allow_list" // traffic with any other country is restricted
+)
+
+// Geo restriction actions applied to restricted traffic
+const (
+	GeoActionBlock = "block"
+	GeoActionAlert = "alert" // allowed, but logged as a security event
+)
+
+// GeoRestrictionService provides access to geo restriction policies
+type GeoRestrictionService struct {
+	client *Client
+}
+
+// GeoRestrictionPolicy restricts traffic by the country of the remote
+// address, as located by the platform's IP geolocation database. Inbound
+// checks the source of connections into sites, Outbound the destination of
+// connections from them; a nil direction is not restricted. The policy
+// applies to SiteIDs, or to every site if empty. Exempt traffic is never
+// restricted.
+type GeoRestrictionPolicy struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Enabled     bool              `json:"enabled"`
+	SiteIDs     []string          `json:"site_ids,omitempty"`
+	Inbound     *GeoDirectionRule `json:"inbound,omitempty"`
+	Outbound    *GeoDirectionRule `json:"outbound,omitempty"`
+	Exemptions  GeoExemptions     `json:"exemptions"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// GeoDirectionRule restricts one direction of traffic. Countries are ISO
+// 3166-1 alpha-2 codes.
+type GeoDirectionRule struct {
+	Mode      string   `json:"mode"`
+	Countries []string `json:"countries"`
+	Action    string   `json:"action"`
+}
+
+// GeoExemptions are the local addresses and users whose traffic a geo
+// restriction policy does not restrict, e.g. a partner's VPN endpoint or a
+// travelling team. AddressObjectIDs and GroupIDs reference address objects
+// and identity groups.
+type GeoExemptions struct {
+	CIDRs            []string `json:"cidrs,omitempty"`
+	AddressObjectIDs []string `json:"address_object_ids,omitempty"`
+	GroupIDs         []string `json:"group_ids,omitempty"`
+}
+
+// CreateGeoRestrictionPolicyParams contains parameters for creating a geo
+// restriction policy
+type CreateGeoRestrictionPolicyParams struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	SiteIDs     []string          `json:"site_ids,omitempty"`
+	Inbound     *GeoDirectionRule `json:"inbound,omitempty"`
+	Outbound    *GeoDirectionRule `json:"outbound,omitempty"`
+	Exemptions  *GeoExemptions    `json:"exemptions,omitempty"`
+}
+
+// UpdateGeoRestrictionPolicyParams contains parameters for updating a geo
+// restriction policy. Lists and directions replace the existing ones;
+// ClearInbound and ClearOutbound stop restricting a direction.
+type UpdateGeoRestrictionPolicyParams struct {
+	Name          *string           `json:"name,omitempty"`
+	Description   *string           `json:"description,omitempty"`
+	Enabled       *bool             `json:"enabled,omitempty"`
+	SiteIDs       *[]string         `json:"site_ids,omitempty"`
+	Inbound       *GeoDirectionRule `json:"inbound,omitempty"`
+	ClearInbound  bool              `json:"clear_inbound,omitempty"`
+	Outbound      *GeoDirectionRule `json:"outbound,omitempty"`
+	ClearOutbound bool              `json:"clear_outbound,omitempty"`
+	Exemptions    *GeoExemptions    `json:"exemptions,omitempty"`
+}
+
+// List retrieves all geo restriction policies
+func (s *GeoRestrictionService) List(ctx context.Context) ([]GeoRestrictionPolicy, error) {
+	data, err := s.client.get(ctx, "/security/geo_restrictions", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []GeoRestrictionPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Create creates a geo restriction policy
+func (s *GeoRestrictionService) Create(ctx context.Context, params *CreateGeoRestrictionPolicyParams) (*GeoRestrictionPolicy, error) {
+	data, err := s.client.post(ctx, "/security/geo_restrictions", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy GeoRestrictionPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Get retrieves a geo restriction policy by ID
+func (s *GeoRestrictionService) Get(ctx context.Context, policyID string) (*GeoRestrictionPolicy, error) {
+	data, err := s.client.get(ctx, "/security/geo_restrictions/"+policyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy GeoRestrictionPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update updates a geo restriction policy
+func (s *GeoRestrictionService) Update(ctx context.Context, policyID string, params *UpdateGeoRestrictionPolicyParams) (*GeoRestrictionPolicy, error) {
+	data, err := s.client.patch(ctx, "/security/geo_restrictions/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy GeoRestrictionPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Delete deletes a geo restriction policy
+func (s *GeoRestrictionService) Delete(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/security/geo_restrictions/"+policyID, nil)
+}
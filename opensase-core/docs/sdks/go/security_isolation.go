@@ -0,0 +1,151 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Remote Browser Isolation
+// =============================================================================
+
+// Browser isolation modes
+const (
+	IsolationModeFull     = "full"      // pages are rendered remotely but fully interactive
+	IsolationModeReadOnly = "read_only" // no keyboard input, form submission or uploads
+	IsolationModeNoUpload = "no_upload" // interactive, but file uploads are blocked
+)
+
+// Browser isolation clipboard controls
+const (
+	IsolationClipboardAllow     = "allow"
+	IsolationClipboardDeny      = "deny"
+	IsolationClipboardCopyOnly  = "copy_only"  // from the isolated page to the local device only
+	IsolationClipboardPasteOnly = "paste_only" // from the local device to the isolated page only
+)
+
+// BrowserIsolationService provides access to remote browser isolation policies
+type BrowserIsolationService struct {
+	client *Client
+}
+
+// BrowserIsolationPolicy sends matching web traffic to a remote browser, so
+// only a rendering of the page reaches the user's device. Traffic matches
+// when its URL is in one of URLCategories or Domains and the user is in one
+// of UserGroups, or any group if empty. Policies are evaluated by Priority,
+// lowest first, and the first match applies.
+type BrowserIsolationPolicy struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description,omitempty"`
+	Enabled       bool              `json:"enabled"`
+	Priority      int               `json:"priority"`
+	URLCategories []string          `json:"url_categories,omitempty"`
+	Domains       []string          `json:"domains,omitempty"`
+	UserGroups    []string          `json:"user_groups,omitempty"`
+	Mode          string            `json:"mode"`
+	Controls      IsolationControls `json:"controls"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// IsolationControls limits what leaves or enters an isolated page
+type IsolationControls struct {
+	Clipboard string `json:"clipboard"`
+	Printing  bool   `json:"printing"`
+	Downloads bool   `json:"downloads"`
+}
+
+// CreateBrowserIsolationPolicyParams contains parameters for creating a
+// browser isolation policy. Category IDs are listed by
+// CatalogService.URLCategories.
+type CreateBrowserIsolationPolicyParams struct {
+	Name          string             `json:"name"`
+	Description   string             `json:"description,omitempty"`
+	Enabled       *bool              `json:"enabled,omitempty"`
+	Priority      int                `json:"priority,omitempty"`
+	URLCategories []string           `json:"url_categories,omitempty"`
+	Domains       []string           `json:"domains,omitempty"`
+	UserGroups    []string           `json:"user_groups,omitempty"`
+	Mode          string             `json:"mode,omitempty"`
+	Controls      *IsolationControls `json:"controls,omitempty"`
+}
+
+// UpdateBrowserIsolationPolicyParams contains parameters for updating a
+// browser isolation policy. Lists and Controls replace the existing ones.
+type UpdateBrowserIsolationPolicyParams struct {
+	Name          *string            `json:"name,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	Enabled       *bool              `json:"enabled,omitempty"`
+	Priority      *int               `json:"priority,omitempty"`
+	URLCategories *[]string          `json:"url_categories,omitempty"`
+	Domains       *[]string          `json:"domains,omitempty"`
+	UserGroups    *[]string          `json:"user_groups,omitempty"`
+	Mode          *string            `json:"mode,omitempty"`
+	Controls      *IsolationControls `json:"controls,omitempty"`
+}
+
+// List retrieves all browser isolation policies in priority order
+func (s *BrowserIsolationService) List(ctx context.Context) ([]BrowserIsolationPolicy, error) {
+	data, err := s.client.get(ctx, "/security/browser_isolation/policies", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []BrowserIsolationPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Create creates a browser isolation policy
+func (s *BrowserIsolationService) Create(ctx context.Context, params *CreateBrowserIsolationPolicyParams) (*BrowserIsolationPolicy, error) {
+	data, err := s.client.post(ctx, "/security/browser_isolation/policies", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy BrowserIsolationPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Get retrieves a browser isolation policy by ID
+func (s *BrowserIsolationService) Get(ctx context.Context, policyID string) (*BrowserIsolationPolicy, error) {
+	data, err := s.client.get(ctx, "/security/browser_isolation/policies/"+policyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy BrowserIsolationPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update updates a browser isolation policy
+func (s *BrowserIsolationService) Update(ctx context.Context, policyID string, params *UpdateBrowserIsolationPolicyParams) (*BrowserIsolationPolicy, error) {
+	data, err := s.client.patch(ctx, "/security/browser_isolation/policies/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy BrowserIsolationPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Delete deletes a browser isolation policy
+func (s *BrowserIsolationService) Delete(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/security/browser_isolation/policies/"+policyID, nil)
+}
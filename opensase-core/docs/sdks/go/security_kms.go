@@ -0,0 +1,222 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Customer-Managed Keys
+// =============================================================================
+
+// KeyStatus is the lifecycle state of a customer-managed key
+type KeyStatus string
+
+// Customer-managed key statuses
+const (
+	KeyStatusPendingValidation KeyStatus = "pending_validation"
+	KeyStatusActive            KeyStatus = "active"
+	KeyStatusRotating          KeyStatus = "rotating"
+	KeyStatusDisabled          KeyStatus = "disabled"
+	KeyStatusUnreachable       KeyStatus = "unreachable"
+)
+
+// RotationStatus is the state of a key rotation
+type RotationStatus string
+
+// Key rotation statuses
+const (
+	RotationStatusPending      RotationStatus = "pending"
+	RotationStatusReencrypting RotationStatus = "reencrypting"
+	RotationStatusCompleted    RotationStatus = "completed"
+	RotationStatusFailed       RotationStatus = "failed"
+)
+
+// KMSService provides access to customer-managed encryption key (BYOK) APIs
+type KMSService struct {
+	client *Client
+}
+
+// ManagedKey represents a customer-managed key held in an external KMS
+type ManagedKey struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Provider    string       `json:"provider"`
+	KeyURI      string       `json:"key_uri"`
+	Region      string       `json:"region,omitempty"`
+	Status      KeyStatus    `json:"status"`
+	DataClasses []string     `json:"data_classes"`
+	Version     int          `json:"version"`
+	Rotation    *KeyRotation `json:"rotation,omitempty"`
+	LastUsedAt  *time.Time   `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// KeyRotation reports the progress of re-encrypting data under a new key version
+type KeyRotation struct {
+	ID           string         `json:"id"`
+	Status       RotationStatus `json:"status"`
+	FromVersion  int            `json:"from_version"`
+	ToVersion    int            `json:"to_version"`
+	ObjectsTotal int64          `json:"objects_total"`
+	ObjectsDone  int64          `json:"objects_done"`
+	Error        string         `json:"error,omitempty"`
+	StartedAt    time.Time      `json:"started_at"`
+	CompletedAt  *time.Time     `json:"completed_at,omitempty"`
+}
+
+// Progress returns the fraction of objects re-encrypted, between 0 and 1
+func (r *KeyRotation) Progress() float64 {
+	if r.ObjectsTotal == 0 {
+		if r.Status == RotationStatusCompleted {
+			return 1
+		}
+		return 0
+	}
+	return float64(r.ObjectsDone) / float64(r.ObjectsTotal)
+}
+
+// RegisterKeyParams contains parameters for registering a customer-managed key
+type RegisterKeyParams struct {
+	Name        string   `json:"name"`
+	Provider    string   `json:"provider"`
+	KeyURI      string   `json:"key_uri"`
+	Region      string   `json:"region,omitempty"`
+	RoleARN     string   `json:"role_arn,omitempty"`
+	DataClasses []string `json:"data_classes"`
+}
+
+// DataClassBinding shows which key encrypts a data class
+type DataClassBinding struct {
+	DataClass  string `json:"data_class"`
+	KeyID      string `json:"key_id,omitempty"`
+	KeyVersion int    `json:"key_version,omitempty"`
+	// PlatformManaged is true when no customer key is bound to the class
+	PlatformManaged bool `json:"platform_managed"`
+}
+
+// Register registers a customer-managed key. The platform validates access
+// to the key before it becomes active.
+func (s *KMSService) Register(ctx context.Context, params *RegisterKeyParams, opts *RequestOptions) (*ManagedKey, error) {
+	data, err := s.client.post(ctx, "/security/kms/keys", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var key ManagedKey
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// List retrieves all customer-managed keys
+func (s *KMSService) List(ctx context.Context) ([]ManagedKey, error) {
+	data, err := s.client.get(ctx, "/security/kms/keys", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []ManagedKey
+	if err := s.client.decode(data, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// Get retrieves a customer-managed key by ID
+func (s *KMSService) Get(ctx context.Context, keyID string) (*ManagedKey, error) {
+	data, err := s.client.get(ctx, "/security/kms/keys/"+keyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key ManagedKey
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// UpdateDataClasses changes the data classes encrypted under a key
+func (s *KMSService) UpdateDataClasses(ctx context.Context, keyID string, dataClasses []string) (*ManagedKey, error) {
+	params := map[string]interface{}{
+		"data_classes": dataClasses,
+	}
+
+	data, err := s.client.patch(ctx, "/security/kms/keys/"+keyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key ManagedKey
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Rotate starts re-encryption of all data under the key's latest version
+func (s *KMSService) Rotate(ctx context.Context, keyID string, opts *RequestOptions) (*KeyRotation, error) {
+	data, err := s.client.post(ctx, "/security/kms/keys/"+keyID+"/rotations", nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotation KeyRotation
+	if err := s.client.decode(data, &rotation); err != nil {
+		return nil, err
+	}
+
+	return &rotation, nil
+}
+
+// GetRotation retrieves the progress of a key rotation
+func (s *KMSService) GetRotation(ctx context.Context, keyID, rotationID string) (*KeyRotation, error) {
+	data, err := s.client.get(ctx, "/security/kms/keys/"+keyID+"/rotations/"+rotationID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotation KeyRotation
+	if err := s.client.decode(data, &rotation); err != nil {
+		return nil, err
+	}
+
+	return &rotation, nil
+}
+
+// Disable disables a customer-managed key. Data classes bound to it fall
+// back to platform-managed keys only after re-encryption completes.
+func (s *KMSService) Disable(ctx context.Context, keyID string) (*ManagedKey, error) {
+	data, err := s.client.post(ctx, "/security/kms/keys/"+keyID+"/disable", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key ManagedKey
+	if err := s.client.decode(data, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// DataClasses lists every data class and the key that encrypts it
+func (s *KMSService) DataClasses(ctx context.Context) ([]DataClassBinding, error) {
+	data, err := s.client.get(ctx, "/security/kms/data_classes", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []DataClassBinding
+	if err := s.client.decode(data, &bindings); err != nil {
+		return nil, err
+	}
+
+	return bindings, nil
+}
@@ -0,0 +1,138 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// URL Filtering
+// =============================================================================
+
+// URL filtering actions
+const (
+	URLActionAllow = "allow"
+	URLActionBlock = "block"
+	URLActionWarn  = "warn"
+)
+
+// URLFilteringService provides access to URL filtering profile APIs
+type URLFilteringService struct {
+	client *Client
+}
+
+// URLFilteringProfile controls web access by URL category and explicit lists
+type URLFilteringProfile struct {
+	ID            string              `json:"id"`
+	Name          string              `json:"name"`
+	Description   string              `json:"description,omitempty"`
+	DefaultAction string              `json:"default_action"`
+	Categories    []URLCategoryAction `json:"categories"`
+	AllowList     []string            `json:"allow_list"`
+	DenyList      []string            `json:"deny_list"`
+	SafeSearch    bool                `json:"safe_search"`
+	SiteIDs       []string            `json:"site_ids"`
+	UserGroups    []string            `json:"user_groups"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+// URLCategoryAction is the action applied to a URL category. Category IDs are
+// listed by CatalogService.URLCategories.
+type URLCategoryAction struct {
+	Category string `json:"category"`
+	Action   string `json:"action"`
+}
+
+// CreateURLFilteringProfileParams contains parameters for creating a URL filtering profile
+type CreateURLFilteringProfileParams struct {
+	Name          string              `json:"name"`
+	Description   string              `json:"description,omitempty"`
+	DefaultAction string              `json:"default_action,omitempty"`
+	Categories    []URLCategoryAction `json:"categories,omitempty"`
+	AllowList     []string            `json:"allow_list,omitempty"`
+	DenyList      []string            `json:"deny_list,omitempty"`
+	SafeSearch    bool                `json:"safe_search"`
+	SiteIDs       []string            `json:"site_ids,omitempty"`
+	UserGroups    []string            `json:"user_groups,omitempty"`
+}
+
+// UpdateURLFilteringProfileParams contains parameters for updating a URL
+// filtering profile. List fields replace the existing list; set them to an
+// empty slice to clear it.
+type UpdateURLFilteringProfileParams struct {
+	Name          *string              `json:"name,omitempty"`
+	Description   *string              `json:"description,omitempty"`
+	DefaultAction *string              `json:"default_action,omitempty"`
+	Categories    *[]URLCategoryAction `json:"categories,omitempty"`
+	AllowList     *[]string            `json:"allow_list,omitempty"`
+	DenyList      *[]string            `json:"deny_list,omitempty"`
+	SafeSearch    *bool                `json:"safe_search,omitempty"`
+	SiteIDs       *[]string            `json:"site_ids,omitempty"`
+	UserGroups    *[]string            `json:"user_groups,omitempty"`
+}
+
+// List retrieves all URL filtering profiles
+func (s *URLFilteringService) List(ctx context.Context) ([]URLFilteringProfile, error) {
+	data, err := s.client.get(ctx, "/security/url_filtering/profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []URLFilteringProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new URL filtering profile
+func (s *URLFilteringService) Create(ctx context.Context, params *CreateURLFilteringProfileParams) (*URLFilteringProfile, error) {
+	data, err := s.client.post(ctx, "/security/url_filtering/profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile URLFilteringProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves a URL filtering profile by ID
+func (s *URLFilteringService) Get(ctx context.Context, profileID string) (*URLFilteringProfile, error) {
+	data, err := s.client.get(ctx, "/security/url_filtering/profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile URLFilteringProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates a URL filtering profile
+func (s *URLFilteringService) Update(ctx context.Context, profileID string, params *UpdateURLFilteringProfileParams) (*URLFilteringProfile, error) {
+	data, err := s.client.patch(ctx, "/security/url_filtering/profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile URLFilteringProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes a URL filtering profile
+func (s *URLFilteringService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/security/url_filtering/profiles/"+profileID, nil)
+}
@@ -0,0 +1,247 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Zero Trust Network Access
+// =============================================================================
+
+// ZTNAApplicationsService provides access to private application publishing APIs
+type ZTNAApplicationsService struct {
+	client *Client
+}
+
+// ZTNAApplication is a private application published through ZTNA connectors
+type ZTNAApplication struct {
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description,omitempty"`
+	Hostnames       []string        `json:"hostnames"`
+	Ports           []ZTNAPortRange `json:"ports"`
+	ConnectorGroups []string        `json:"connector_group_ids"`
+	Enabled         bool            `json:"enabled"`
+	Status          string          `json:"status"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// ZTNAPortRange is a port or inclusive port range exposed by a private application
+type ZTNAPortRange struct {
+	Protocol string `json:"protocol"`
+	From     int    `json:"from"`
+	To       int    `json:"to,omitempty"`
+}
+
+// CreateZTNAApplicationParams contains parameters for publishing a private application
+type CreateZTNAApplicationParams struct {
+	Name            string          `json:"name"`
+	Description     string          `json:"description,omitempty"`
+	Hostnames       []string        `json:"hostnames"`
+	Ports           []ZTNAPortRange `json:"ports"`
+	ConnectorGroups []string        `json:"connector_group_ids"`
+	Enabled         *bool           `json:"enabled,omitempty"`
+}
+
+// UpdateZTNAApplicationParams contains parameters for updating a private application
+type UpdateZTNAApplicationParams struct {
+	Name            *string         `json:"name,omitempty"`
+	Description     *string         `json:"description,omitempty"`
+	Hostnames       []string        `json:"hostnames,omitempty"`
+	Ports           []ZTNAPortRange `json:"ports,omitempty"`
+	ConnectorGroups []string        `json:"connector_group_ids,omitempty"`
+	Enabled         *bool           `json:"enabled,omitempty"`
+}
+
+// List retrieves all private applications
+func (s *ZTNAApplicationsService) List(ctx context.Context) ([]ZTNAApplication, error) {
+	data, err := s.client.get(ctx, "/security/ztna/applications", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []ZTNAApplication
+	if err := s.client.decode(data, &apps); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// Create publishes a new private application
+func (s *ZTNAApplicationsService) Create(ctx context.Context, params *CreateZTNAApplicationParams) (*ZTNAApplication, error) {
+	data, err := s.client.post(ctx, "/security/ztna/applications", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app ZTNAApplication
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Get retrieves a private application by ID
+func (s *ZTNAApplicationsService) Get(ctx context.Context, appID string) (*ZTNAApplication, error) {
+	data, err := s.client.get(ctx, "/security/ztna/applications/"+appID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app ZTNAApplication
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Update updates a private application
+func (s *ZTNAApplicationsService) Update(ctx context.Context, appID string, params *UpdateZTNAApplicationParams) (*ZTNAApplication, error) {
+	data, err := s.client.patch(ctx, "/security/ztna/applications/"+appID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app ZTNAApplication
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Delete unpublishes a private application
+func (s *ZTNAApplicationsService) Delete(ctx context.Context, appID string) error {
+	return s.client.delete(ctx, "/security/ztna/applications/"+appID, nil)
+}
+
+// ZTNAAccessPoliciesService provides access to ZTNA access policy APIs
+type ZTNAAccessPoliciesService struct {
+	client *Client
+}
+
+// ZTNAAccessPolicy binds user groups and device requirements to private applications
+type ZTNAAccessPolicy struct {
+	ID             string              `json:"id"`
+	Name           string              `json:"name"`
+	Action         string              `json:"action"`
+	Priority       int                 `json:"priority"`
+	ApplicationIDs []string            `json:"application_ids"`
+	UserGroups     []string            `json:"user_groups"`
+	DevicePosture  *ZTNADevicePosture  `json:"device_posture,omitempty"`
+	MFA            *ZTNAMFARequirement `json:"mfa,omitempty"`
+	Enabled        bool                `json:"enabled"`
+	CreatedAt      time.Time           `json:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+
+	RuleUsage
+}
+
+// ZTNADevicePosture lists the device checks a client must pass
+type ZTNADevicePosture struct {
+	Managed        bool              `json:"managed"`
+	DiskEncryption bool              `json:"disk_encryption"`
+	Firewall       bool              `json:"firewall"`
+	MinOSVersions  map[string]string `json:"min_os_versions,omitempty"`
+}
+
+// ZTNAMFARequirement controls step-up authentication for an access policy
+type ZTNAMFARequirement struct {
+	Required      bool     `json:"required"`
+	Methods       []string `json:"methods,omitempty"`
+	ReauthMinutes int      `json:"reauth_interval_minutes,omitempty"`
+}
+
+// CreateZTNAAccessPolicyParams contains parameters for creating an access policy
+type CreateZTNAAccessPolicyParams struct {
+	Name           string              `json:"name"`
+	Action         string              `json:"action"`
+	Priority       int                 `json:"priority,omitempty"`
+	ApplicationIDs []string            `json:"application_ids"`
+	UserGroups     []string            `json:"user_groups"`
+	DevicePosture  *ZTNADevicePosture  `json:"device_posture,omitempty"`
+	MFA            *ZTNAMFARequirement `json:"mfa,omitempty"`
+	Enabled        *bool               `json:"enabled,omitempty"`
+}
+
+// UpdateZTNAAccessPolicyParams contains parameters for updating an access policy
+type UpdateZTNAAccessPolicyParams struct {
+	Name           *string             `json:"name,omitempty"`
+	Action         *string             `json:"action,omitempty"`
+	Priority       *int                `json:"priority,omitempty"`
+	ApplicationIDs []string            `json:"application_ids,omitempty"`
+	UserGroups     []string            `json:"user_groups,omitempty"`
+	DevicePosture  *ZTNADevicePosture  `json:"device_posture,omitempty"`
+	MFA            *ZTNAMFARequirement `json:"mfa,omitempty"`
+	Enabled        *bool               `json:"enabled,omitempty"`
+}
+
+// List retrieves all access policies in evaluation order
+func (s *ZTNAAccessPoliciesService) List(ctx context.Context) ([]ZTNAAccessPolicy, error) {
+	data, err := s.client.get(ctx, "/security/ztna/access_policies", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []ZTNAAccessPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Create creates a new access policy
+func (s *ZTNAAccessPoliciesService) Create(ctx context.Context, params *CreateZTNAAccessPolicyParams) (*ZTNAAccessPolicy, error) {
+	data, err := s.client.post(ctx, "/security/ztna/access_policies", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy ZTNAAccessPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Get retrieves an access policy by ID
+func (s *ZTNAAccessPoliciesService) Get(ctx context.Context, policyID string) (*ZTNAAccessPolicy, error) {
+	data, err := s.client.get(ctx, "/security/ztna/access_policies/"+policyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy ZTNAAccessPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update updates an access policy
+func (s *ZTNAAccessPoliciesService) Update(ctx context.Context, policyID string, params *UpdateZTNAAccessPolicyParams) (*ZTNAAccessPolicy, error) {
+	data, err := s.client.patch(ctx, "/security/ztna/access_policies/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy ZTNAAccessPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Delete deletes an access policy
+func (s *ZTNAAccessPoliciesService) Delete(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/security/ztna/access_policies/"+policyID, nil)
+}
@@ -0,0 +1,136 @@
+package opensase
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// DefaultKeychainService is the keychain service name tokens are stored under
+const DefaultKeychainService = "opensase"
+
+// ErrKeychainUnavailable is returned when the OS keychain cannot be used on this platform
+var ErrKeychainUnavailable = errors.New("opensase: OS keychain is not available")
+
+// KeychainTokenStore stores tokens in the OS keychain: the login keychain
+// on macOS, via the security tool, and the Secret Service on Linux, via
+// secret-tool from libsecret. Secrets are passed on stdin, never as
+// arguments. Other platforms return ErrKeychainUnavailable; fall back to
+// FileTokenStore there.
+type KeychainTokenStore struct {
+	Service string
+}
+
+// NewKeychainTokenStore returns a KeychainTokenStore, or ErrKeychainUnavailable
+// if the platform's keychain tool is not installed
+func NewKeychainTokenStore() (*KeychainTokenStore, error) {
+	tool := ""
+	switch runtime.GOOS {
+	case "darwin":
+		tool = "security"
+	case "linux":
+		tool = "secret-tool"
+	default:
+		return nil, ErrKeychainUnavailable
+	}
+	if _, err := exec.LookPath(tool); err != nil {
+		return nil, fmt.Errorf("%w: %s not found", ErrKeychainUnavailable, tool)
+	}
+	return &KeychainTokenStore{Service: DefaultKeychainService}, nil
+}
+
+// Load reads the token for key
+func (s *KeychainTokenStore) Load(key string) (*Token, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "find-generic-password", "-s", s.Service, "-a", key, "-w")
+	case "linux":
+		cmd = exec.Command("secret-tool", "lookup", "service", s.Service, "account", key)
+	default:
+		return nil, ErrKeychainUnavailable
+	}
+
+	out, err := cmd.Output()
+	secret := strings.TrimSpace(string(out))
+	if err != nil || secret == "" {
+		var exitErr *exec.ExitError
+		if secret == "" && (err == nil || errors.As(err, &exitErr)) {
+			// Both tools exit non-zero with no output for a missing item
+			return nil, ErrTokenNotFound
+		}
+		return nil, fmt.Errorf("opensase: reading keychain: %w", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("opensase: reading cached token %s: %w", key, err)
+	}
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("opensase: reading cached token %s: %w", key, err)
+	}
+	return &token, nil
+}
+
+// Save writes the token for key, replacing any existing item
+func (s *KeychainTokenStore) Save(key string, token *Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	// Base64 keeps the secret free of characters the tools would interpret
+	secret := base64.StdEncoding.EncodeToString(data)
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		// security -i reads commands from stdin, keeping the secret out of
+		// the process list
+		cmd = exec.Command("security", "-i")
+		cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %q -a %q -w %q\n", s.Service, key, secret))
+	case "linux":
+		cmd = exec.Command("secret-tool", "store", "--label="+s.Service+" "+key, "service", s.Service, "account", key)
+		cmd.Stdin = strings.NewReader(secret)
+	default:
+		return ErrKeychainUnavailable
+	}
+
+	return runKeychain(cmd)
+}
+
+// Delete removes the token for key
+func (s *KeychainTokenStore) Delete(key string) error {
+	if _, err := s.Load(key); errors.Is(err, ErrTokenNotFound) {
+		return nil
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "delete-generic-password", "-s", s.Service, "-a", key)
+	case "linux":
+		cmd = exec.Command("secret-tool", "clear", "service", s.Service, "account", key)
+	default:
+		return ErrKeychainUnavailable
+	}
+
+	return runKeychain(cmd)
+}
+
+func runKeychain(cmd *exec.Cmd) error {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("opensase: keychain: %s: %w", msg, err)
+		}
+		return fmt.Errorf("opensase: keychain: %w", err)
+	}
+	return nil
+}
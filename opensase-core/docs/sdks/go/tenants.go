@@ -0,0 +1,164 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Tenants
+// =============================================================================
+
+// Tenant plans
+const (
+	TenantPlanEssentials   = "essentials"
+	TenantPlanProfessional = "professional"
+	TenantPlanEnterprise   = "enterprise"
+)
+
+// Tenant statuses
+const (
+	TenantProvisioning = "provisioning"
+	TenantActive       = "active"
+	TenantSuspended    = "suspended"
+)
+
+// TenantsService provides access to the child tenants of a managed service
+// provider. Requests are made with the parent tenant's credentials; use
+// WithTargetTenant to manage objects inside a child tenant.
+type TenantsService struct {
+	client *Client
+}
+
+// Tenant is a child tenant. Licenses allocates part of the parent's
+// licenses to the tenant, keyed by quota resource name such as QuotaSites
+// or QuotaUsers; the allocations become the tenant's object quotas.
+type Tenant struct {
+	ID        string         `json:"id"`
+	ParentID  string         `json:"parent_id"`
+	Name      string         `json:"name"`
+	Plan      string         `json:"plan"`
+	Status    string         `json:"status"`
+	Region    string         `json:"region,omitempty"`
+	Licenses  map[string]int `json:"licenses,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// TenantAdmin is the first administrator of a new tenant. They are sent an
+// invitation to set their password.
+type TenantAdmin struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+// CreateTenantParams contains parameters for creating a child tenant. The
+// region a tenant's data is stored in cannot be changed.
+type CreateTenantParams struct {
+	Name     string         `json:"name"`
+	Plan     string         `json:"plan"`
+	Region   string         `json:"region,omitempty"`
+	Admin    TenantAdmin    `json:"admin"`
+	Licenses map[string]int `json:"licenses,omitempty"`
+}
+
+// UpdateTenantParams contains parameters for updating a child tenant.
+// Licenses replaces the existing allocations; lowering one below the
+// tenant's usage fails.
+type UpdateTenantParams struct {
+	Name     *string         `json:"name,omitempty"`
+	Plan     *string         `json:"plan,omitempty"`
+	Licenses *map[string]int `json:"licenses,omitempty"`
+}
+
+// List retrieves the child tenants of the current tenant
+func (s *TenantsService) List(ctx context.Context) ([]Tenant, error) {
+	data, err := s.client.get(ctx, "/tenants", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenants []Tenant
+	if err := s.client.decode(data, &tenants); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+// Create creates a child tenant and invites its first administrator
+func (s *TenantsService) Create(ctx context.Context, params *CreateTenantParams) (*Tenant, error) {
+	data, err := s.client.post(ctx, "/tenants", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := s.client.decode(data, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// Get retrieves a child tenant by ID
+func (s *TenantsService) Get(ctx context.Context, tenantID string) (*Tenant, error) {
+	data, err := s.client.get(ctx, "/tenants/"+tenantID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := s.client.decode(data, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// Update updates a child tenant
+func (s *TenantsService) Update(ctx context.Context, tenantID string, params *UpdateTenantParams) (*Tenant, error) {
+	data, err := s.client.patch(ctx, "/tenants/"+tenantID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := s.client.decode(data, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// Delete deletes a child tenant and all of its data, returning its licenses
+// to the parent
+func (s *TenantsService) Delete(ctx context.Context, tenantID string) error {
+	return s.client.delete(ctx, "/tenants/"+tenantID, nil)
+}
+
+// Suspend suspends a child tenant, e.g. for non-payment. Its sites keep
+// forwarding traffic but its administrators and API keys are locked out.
+func (s *TenantsService) Suspend(ctx context.Context, tenantID string) (*Tenant, error) {
+	return s.action(ctx, tenantID, "suspend")
+}
+
+// Resume resumes a suspended child tenant
+func (s *TenantsService) Resume(ctx context.Context, tenantID string) (*Tenant, error) {
+	return s.action(ctx, tenantID, "resume")
+}
+
+func (s *TenantsService) action(ctx context.Context, tenantID, action string) (*Tenant, error) {
+	data, err := s.client.post(ctx, "/tenants/"+tenantID+"/"+action, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := s.client.decode(data, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
@@ -0,0 +1,309 @@
+package tokenverify
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testAudience = "https://app.example.com"
+
+// testJWKS serves the public halves of its keys as a JWKS
+type testJWKS struct {
+	mu      sync.Mutex
+	keys    []jwk
+	fetches int
+}
+
+func (s *testJWKS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetches++
+	json.NewEncoder(w).Encode(map[string]interface{}{"keys": s.keys})
+}
+
+func (s *testJWKS) fetchCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches
+}
+
+func (s *testJWKS) add(k jwk) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, k)
+}
+
+func rsaJWK(kid, alg string, key *rsa.PrivateKey) jwk {
+	return jwk{
+		Kid: kid, Kty: "RSA", Use: "sig", Alg: alg,
+		N: base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid, alg string, key *ecdsa.PrivateKey) jwk {
+	return jwk{
+		Kid: kid, Kty: "EC", Use: "sig", Alg: alg, Crv: key.Curve.Params().Name,
+		X: base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+		Y: base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+	}
+}
+
+// sign builds a token with header alg and kid, signed with key for alg.
+// A nil key leaves the signature empty.
+func sign(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	if key == nil {
+		return signed + "."
+	}
+
+	var digest []byte
+	var hashID crypto.Hash
+	switch alg {
+	case "RS384", "ES384":
+		sum := sha512.Sum384([]byte(signed))
+		digest, hashID = sum[:], crypto.SHA384
+	default:
+		sum := sha256.Sum256([]byte(signed))
+		digest, hashID = sum[:], crypto.SHA256
+	}
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		if sig, err = rsa.SignPKCS1v15(rand.Reader, k, hashID, digest); err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherRSAKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ec384Key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+
+	jwks := &testJWKS{}
+	jwks.add(rsaJWK("rsa", "RS256", rsaKey))
+	jwks.add(rsaJWK("rsa-any", "", rsaKey))
+	jwks.add(ecJWK("ec", "ES256", ecKey))
+	jwks.add(ecJWK("ec384-any", "", ec384Key))
+	srv := httptest.NewServer(jwks)
+	defer srv.Close()
+
+	now := time.Unix(1_800_000_000, 0)
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":       DefaultIssuer,
+			"sub":       "usr_1",
+			"aud":       testAudience,
+			"tenant_id": "ten_1",
+			"scope":     "tickets:read tickets:write",
+			"iat":       now.Unix(),
+			"exp":       now.Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   func(t *testing.T) string
+		wantErr error
+	}{
+		{
+			name:  "valid RS256",
+			token: func(t *testing.T) string { return sign(t, "RS256", "rsa", rsaKey, claims(nil)) },
+		},
+		{
+			name:  "valid ES256",
+			token: func(t *testing.T) string { return sign(t, "ES256", "ec", ecKey, claims(nil)) },
+		},
+		{
+			name: "audience in array",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"aud": []string{"other", testAudience}}))
+			},
+		},
+		{
+			name:    "algorithm other than the key's",
+			token:   func(t *testing.T) string { return sign(t, "RS384", "rsa", rsaKey, claims(nil)) },
+			wantErr: ErrAlgorithm,
+		},
+		{
+			name:    "EC algorithm with an RSA key",
+			token:   func(t *testing.T) string { return sign(t, "ES256", "rsa-any", ecKey, claims(nil)) },
+			wantErr: ErrAlgorithm,
+		},
+		{
+			name:    "ES256 with a P-384 key",
+			token:   func(t *testing.T) string { return sign(t, "ES256", "ec384-any", ec384Key, claims(nil)) },
+			wantErr: ErrAlgorithm,
+		},
+		{
+			name:    "alg none",
+			token:   func(t *testing.T) string { return sign(t, "none", "rsa", nil, claims(nil)) },
+			wantErr: ErrAlgorithm,
+		},
+		{
+			name:    "signed with another key",
+			token:   func(t *testing.T) string { return sign(t, "RS256", "rsa", otherRSAKey, claims(nil)) },
+			wantErr: ErrSignature,
+		},
+		{
+			name:    "unknown kid",
+			token:   func(t *testing.T) string { return sign(t, "RS256", "missing", rsaKey, claims(nil)) },
+			wantErr: ErrUnknownKey,
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"exp": now.Add(-time.Minute).Unix()}))
+			},
+			wantErr: ErrExpired,
+		},
+		{
+			name: "expired within leeway",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"exp": now.Add(-DefaultLeeway / 2).Unix()}))
+			},
+		},
+		{
+			name: "no expiry",
+			token: func(t *testing.T) string {
+				c := claims(nil)
+				delete(c, "exp")
+				return sign(t, "RS256", "rsa", rsaKey, c)
+			},
+			wantErr: ErrExpired,
+		},
+		{
+			name: "not yet valid",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"nbf": now.Add(time.Minute).Unix()}))
+			},
+			wantErr: ErrNotYetValid,
+		},
+		{
+			name: "wrong issuer",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"iss": "https://evil.example.com"}))
+			},
+			wantErr: ErrIssuer,
+		},
+		{
+			name: "wrong audience",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"aud": "https://other.example.com"}))
+			},
+			wantErr: ErrAudience,
+		},
+		{
+			name: "wrong tenant",
+			token: func(t *testing.T) string {
+				return sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"tenant_id": "ten_2"}))
+			},
+			wantErr: ErrTenant,
+		},
+		{
+			name:    "malformed",
+			token:   func(t *testing.T) string { return "not-a-token" },
+			wantErr: ErrMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New(testAudience, WithJWKSURL(srv.URL), WithTenant("ten_1"))
+			v.now = func() time.Time { return now }
+
+			got, err := v.Verify(context.Background(), tt.token(t))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if got.Subject != "usr_1" || !got.HasScope("tickets:write") {
+				t.Errorf("Verify() = %+v, want subject usr_1 with scope tickets:write", got)
+			}
+		})
+	}
+}
+
+func TestVerifyRefetchesForUnknownKey(t *testing.T) {
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	jwks := &testJWKS{}
+	jwks.add(rsaJWK("old", "RS256", oldKey))
+	srv := httptest.NewServer(jwks)
+	defer srv.Close()
+
+	now := time.Unix(1_800_000_000, 0)
+	v := New(testAudience, WithJWKSURL(srv.URL))
+	v.now = func() time.Time { return now }
+	claims := map[string]interface{}{
+		"iss": DefaultIssuer,
+		"sub": "usr_1",
+		"aud": testAudience,
+		"exp": now.Add(time.Hour).Unix(),
+	}
+
+	if _, err := v.Verify(context.Background(), sign(t, "RS256", "old", oldKey, claims)); err != nil {
+		t.Fatalf("Verify() with the old key: %v", err)
+	}
+
+	// Rotate in a new key. Within minRefreshInterval of the last fetch the
+	// unknown kid must not trigger another fetch.
+	jwks.add(rsaJWK("new", "RS256", newKey))
+	token := sign(t, "RS256", "new", newKey, claims)
+	if _, err := v.Verify(context.Background(), token); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("Verify() right after a fetch: error = %v, want %v", err, ErrUnknownKey)
+	}
+	if n := jwks.fetchCount(); n != 1 {
+		t.Fatalf("JWKS fetched %d times, want 1", n)
+	}
+
+	now = now.Add(minRefreshInterval + time.Second)
+	if _, err := v.Verify(context.Background(), token); err != nil {
+		t.Fatalf("Verify() with the rotated key: %v", err)
+	}
+	if n := jwks.fetchCount(); n != 2 {
+		t.Errorf("JWKS fetched %d times, want 2", n)
+	}
+}
@@ -0,0 +1,352 @@
+// Package workerpool runs bulk SDK calls with bounded concurrency, retries
+// and a shared quota.
+//
+// Tasks are functions issuing SDK calls. A task failing with a transient
+// error (see opensase.IsRetryable) is retried with exponential backoff. When
+// any task is rate limited, every worker of the pool pauses for the delay
+// the API asked for, or the backoff delay if longer, rather than each one
+// discovering the limit separately.
+// Pools given the same opensase.RateLimiter, including through clients
+// configured WithSharedRateLimit, draw on one request budget.
+//
+//	limiter := opensase.NewRateLimiter(20, 20)
+//	client := opensase.NewClient(apiKey, opensase.WithSharedRateLimit(limiter))
+//	pool := workerpool.New(
+//	    workerpool.WithWorkers(16),
+//	    workerpool.WithPriority(opensase.PriorityLow),
+//	)
+//	errs := pool.Run(ctx, tasks)
+//	m := pool.Metrics()
+//	log.Printf("%d ok, %d failed, %.1f/s", m.Succeeded, m.Failed, m.Throughput())
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Defaults used when no option overrides them
+const (
+	DefaultWorkers     = 8
+	DefaultMaxAttempts = 5
+	DefaultBaseDelay   = time.Second
+	DefaultMaxDelay    = time.Minute
+)
+
+// Task is a unit of work issuing SDK calls. It must be safe to repeat, as
+// it is retried after transient failures; ctx carries the pool's priority.
+type Task func(ctx context.Context) error
+
+// Option configures a Pool
+type Option func(*Pool)
+
+// WithWorkers sets how many tasks run at a time
+func WithWorkers(n int) Option {
+	return func(p *Pool) {
+		if n > 0 {
+			p.workers = n
+		}
+	}
+}
+
+// WithMaxAttempts sets how many times a task is tried before it fails
+func WithMaxAttempts(n int) Option {
+	return func(p *Pool) {
+		if n > 0 {
+			p.maxAttempts = n
+		}
+	}
+}
+
+// WithBackoff sets the delay before the first retry of a task and the
+// maximum delay; the delay doubles with each attempt
+func WithBackoff(base, max time.Duration) Option {
+	return func(p *Pool) {
+		p.baseDelay = base
+		p.maxDelay = max
+	}
+}
+
+// WithPriority runs tasks with the given request priority, so bulk work can
+// yield to interactive use of a rate limited client
+func WithPriority(priority opensase.Priority) Option {
+	return func(p *Pool) {
+		p.priority = priority
+		p.prioritySet = true
+	}
+}
+
+// WithRateLimiter waits for budget from l before each attempt of a task,
+// for tasks whose clients are not themselves limited by l. Tasks making
+// several calls should use a client configured WithSharedRateLimit instead.
+func WithRateLimiter(l *opensase.RateLimiter) Option {
+	return func(p *Pool) {
+		p.limiter = l
+	}
+}
+
+// Metrics are counts accumulated over all runs of a pool
+type Metrics struct {
+	// Submitted tasks, and those finished successfully or failed for good
+	Submitted int64 `json:"submitted"`
+	Succeeded int64 `json:"succeeded"`
+	Failed    int64 `json:"failed"`
+	// InFlight tasks are running or waiting to be retried
+	InFlight int64 `json:"in_flight"`
+	// Retries of failed attempts, of which RateLimited were rate limited
+	Retries     int64 `json:"retries"`
+	RateLimited int64 `json:"rate_limited"`
+	// Paused is the time the pool spent paused after rate limiting
+	Paused time.Duration `json:"paused"`
+	// Busy is the time at least one task was running
+	Busy time.Duration `json:"busy"`
+}
+
+// Throughput is the number of tasks completed successfully per second of
+// busy time
+func (m Metrics) Throughput() float64 {
+	if m.Busy <= 0 {
+		return 0
+	}
+	return float64(m.Succeeded) / m.Busy.Seconds()
+}
+
+// ErrorRate is the share of finished tasks that failed
+func (m Metrics) ErrorRate() float64 {
+	done := m.Succeeded + m.Failed
+	if done == 0 {
+		return 0
+	}
+	return float64(m.Failed) / float64(done)
+}
+
+// Pool runs tasks. A pool may be used for several runs, concurrently or in
+// turn; metrics and rate limit pauses are shared by all of them.
+type Pool struct {
+	workers     int
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	priority    opensase.Priority
+	prioritySet bool
+	limiter     *opensase.RateLimiter
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+	paused      time.Duration
+	running     int
+	busySince   time.Time
+	busy        time.Duration
+
+	submitted, succeeded, failed, inFlight, retries, rateLimited atomic.Int64
+}
+
+// New returns a Pool
+func New(opts ...Option) *Pool {
+	p := &Pool{
+		workers:     DefaultWorkers,
+		maxAttempts: DefaultMaxAttempts,
+		baseDelay:   DefaultBaseDelay,
+		maxDelay:    DefaultMaxDelay,
+		priority:    opensase.PriorityNormal,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Run runs tasks and returns their errors, in the same order, with nil for
+// tasks that succeeded. When ctx is cancelled, tasks not yet started fail
+// with the context's error.
+func (p *Pool) Run(ctx context.Context, tasks []Task) []error {
+	if p.prioritySet {
+		ctx = opensase.WithPriority(ctx, p.priority)
+	}
+	p.submitted.Add(int64(len(tasks)))
+
+	errs := make([]error, len(tasks))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < p.workers && w < len(tasks); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				errs[i] = p.run(ctx, tasks[i])
+			}
+		}()
+	}
+
+dispatch:
+	for i := range tasks {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			for ; i < len(tasks); i++ {
+				errs[i] = ctx.Err()
+				p.failed.Add(1)
+			}
+			break dispatch
+		}
+	}
+	close(next)
+	wg.Wait()
+	return errs
+}
+
+// run tries a task until it succeeds, fails permanently or runs out of
+// attempts
+func (p *Pool) run(ctx context.Context, task Task) error {
+	p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = p.wait(ctx); err != nil {
+			break
+		}
+
+		p.start()
+		err = task(ctx)
+		p.stop()
+
+		if err == nil {
+			p.succeeded.Add(1)
+			return nil
+		}
+		if attempt >= p.maxAttempts || !opensase.IsRetryable(err) {
+			break
+		}
+
+		p.retries.Add(1)
+		delay := p.backoff(attempt)
+		var rlErr *opensase.RateLimitError
+		if errors.As(err, &rlErr) {
+			// Other workers would hit the same limit, so all of them wait
+			p.rateLimited.Add(1)
+			if retryAfter := opensase.RetryAfter(err); retryAfter > delay {
+				delay = retryAfter
+			}
+			p.pause(delay)
+			delay = 0
+		}
+		if !sleep(ctx, delay) {
+			err = ctx.Err()
+			break
+		}
+	}
+
+	p.failed.Add(1)
+	return err
+}
+
+// wait blocks while the pool is paused and until the rate limiter, if any,
+// grants an attempt
+func (p *Pool) wait(ctx context.Context) error {
+	for {
+		p.mu.Lock()
+		remaining := time.Until(p.pausedUntil)
+		p.mu.Unlock()
+		if remaining <= 0 {
+			break
+		}
+		if !sleep(ctx, remaining) {
+			return ctx.Err()
+		}
+	}
+
+	if p.limiter != nil {
+		return p.limiter.Wait(ctx, p.priority)
+	}
+	return ctx.Err()
+}
+
+// pause stops every worker from starting an attempt for d
+func (p *Pool) pause(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	until := now.Add(d)
+	if !until.After(p.pausedUntil) {
+		return
+	}
+	if p.pausedUntil.After(now) {
+		p.paused += until.Sub(p.pausedUntil)
+	} else {
+		p.paused += d
+	}
+	p.pausedUntil = until
+}
+
+func (p *Pool) backoff(attempt int) time.Duration {
+	delay := p.baseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= p.maxDelay {
+			return p.maxDelay
+		}
+	}
+	return delay
+}
+
+// start and stop track the time at least one task is running
+func (p *Pool) start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running == 0 {
+		p.busySince = time.Now()
+	}
+	p.running++
+}
+
+func (p *Pool) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.running--
+	if p.running == 0 {
+		p.busy += time.Since(p.busySince)
+	}
+}
+
+// Metrics returns a snapshot of the pool's metrics
+func (p *Pool) Metrics() Metrics {
+	p.mu.Lock()
+	paused, busy := p.paused, p.busy
+	if p.running > 0 {
+		busy += time.Since(p.busySince)
+	}
+	p.mu.Unlock()
+
+	return Metrics{
+		Submitted:   p.submitted.Load(),
+		Succeeded:   p.succeeded.Load(),
+		Failed:      p.failed.Load(),
+		InFlight:    p.inFlight.Load(),
+		Retries:     p.retries.Load(),
+		RateLimited: p.rateLimited.Load(),
+		Paused:      paused,
+		Busy:        busy,
+	}
+}
+
+// sleep waits for d and reports false if ctx was done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
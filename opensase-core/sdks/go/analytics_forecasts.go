@@ -0,0 +1,101 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Capacity Forecasts
+// =============================================================================
+
+// Forecast metrics
+const (
+	ForecastMetricBandwidth = "bandwidth"
+	ForecastMetricSeats     = "seats"
+)
+
+// ForecastScope selects what a capacity forecast covers. An empty scope
+// forecasts every site in the tenant.
+type ForecastScope struct {
+	SiteIDs []string `json:"site_ids,omitempty"`
+	Region  string   `json:"region,omitempty"`
+	Metrics []string `json:"metrics,omitempty"`
+	// Interval is the spacing of forecast points, e.g. "day", "week" or "month"
+	Interval string `json:"interval,omitempty"`
+	// Confidence is the confidence level of the returned intervals; defaults to 0.9
+	Confidence float64 `json:"confidence,omitempty"`
+}
+
+// Forecast contains projected growth per site
+type Forecast struct {
+	GeneratedAt  time.Time      `json:"generated_at"`
+	Horizon      string         `json:"horizon"`
+	Confidence   float64        `json:"confidence"`
+	ModelVersion string         `json:"model_version,omitempty"`
+	Sites        []SiteForecast `json:"sites"`
+}
+
+// SiteForecast is the projection of a single metric for a site
+type SiteForecast struct {
+	SiteID   string          `json:"site_id"`
+	SiteName string          `json:"site_name,omitempty"`
+	Metric   string          `json:"metric"`
+	Unit     string          `json:"unit"`
+	Current  float64         `json:"current"`
+	Capacity float64         `json:"capacity,omitempty"`
+	Points   []ForecastPoint `json:"points"`
+	// ExhaustionAt is when the upper bound first exceeds capacity, if within the horizon
+	ExhaustionAt *time.Time `json:"exhaustion_at,omitempty"`
+}
+
+// ForecastPoint is a projected value with its confidence interval
+type ForecastPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+	Lower     float64   `json:"lower"`
+	Upper     float64   `json:"upper"`
+}
+
+// Forecasts returns projected bandwidth and seat growth over horizon.
+// Forecasts are made in whole days, so horizon is rounded up to a day.
+func (s *AnalyticsService) Forecasts(ctx context.Context, scope *ForecastScope, horizon time.Duration) (*Forecast, error) {
+	if horizon <= 0 {
+		return nil, fmt.Errorf("opensase: forecast horizon must be positive")
+	}
+	v := url.Values{}
+	v.Set("horizon_days", strconv.Itoa(int(math.Ceil(horizon.Hours()/24))))
+	if scope != nil {
+		for _, id := range scope.SiteIDs {
+			v.Add("site_id", id)
+		}
+		if scope.Region != "" {
+			v.Set("region", scope.Region)
+		}
+		for _, m := range scope.Metrics {
+			v.Add("metric", m)
+		}
+		if scope.Interval != "" {
+			v.Set("interval", scope.Interval)
+		}
+		if scope.Confidence > 0 {
+			v.Set("confidence", strconv.FormatFloat(scope.Confidence, 'f', -1, 64))
+		}
+	}
+
+	data, err := s.client.get(ctx, "/analytics/forecasts", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var forecast Forecast
+	if err := s.client.decode(data, &forecast); err != nil {
+		return nil, err
+	}
+
+	return &forecast, nil
+}
@@ -0,0 +1,110 @@
+package opensase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// =============================================================================
+// Bulk Operations
+// =============================================================================
+
+// BulkResult is the outcome of a bulk request. Bulk endpoints answer 207
+// Multi-Status when only some items succeed; the SDK returns the per-item
+// results rather than an error so that only failed items need be retried.
+type BulkResult struct {
+	Results   []BulkItemResult `json:"results"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+}
+
+// BulkItemResult is the result for one item of a bulk request. Index is the
+// item's position in the request.
+type BulkItemResult struct {
+	Index  int             `json:"index"`
+	Status int             `json:"status"`
+	ID     string          `json:"id,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// OK reports whether the item succeeded
+func (r *BulkItemResult) OK() bool {
+	return r.Error == nil && r.Status < 400
+}
+
+// Decode unmarshals the created or updated object of a successful item
+func (r *BulkItemResult) Decode(v interface{}) error {
+	if len(r.Data) == 0 {
+		return fmt.Errorf("opensase: bulk item %d has no data", r.Index)
+	}
+	return json.Unmarshal(r.Data, v)
+}
+
+// FailedItems returns the results of the items that failed
+func (b *BulkResult) FailedItems() []BulkItemResult {
+	var failed []BulkItemResult
+	for _, r := range b.Results {
+		if !r.OK() {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// FailedIndexes returns the request positions of the items that failed, for
+// building a retry of just those items
+func (b *BulkResult) FailedIndexes() []int {
+	var indexes []int
+	for _, r := range b.FailedItems() {
+		indexes = append(indexes, r.Index)
+	}
+	return indexes
+}
+
+// Err returns a *BulkError if any item failed, and nil otherwise
+func (b *BulkResult) Err() error {
+	failed := b.FailedItems()
+	if len(failed) == 0 {
+		return nil
+	}
+	return &BulkError{Result: b, Failed: failed}
+}
+
+// BulkError reports the failed items of a partially successful bulk request
+type BulkError struct {
+	Result *BulkResult
+	Failed []BulkItemResult
+}
+
+func (e *BulkError) Error() string {
+	first := e.Failed[0]
+	msg := fmt.Sprintf("status %d", first.Status)
+	if first.Error != nil {
+		msg = first.Error.Error()
+	}
+	return fmt.Sprintf("opensase: %d of %d bulk items failed; item %d: %s", len(e.Failed), len(e.Result.Results), first.Index, msg)
+}
+
+// bulk posts items to a bulk endpoint and decodes its per-item results
+func (c *Client) bulk(ctx context.Context, path string, items interface{}, opts *RequestOptions) (*BulkResult, error) {
+	data, err := c.post(ctx, path, map[string]interface{}{"items": items}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BulkResult
+	if err := c.decode(data, &result); err != nil {
+		return nil, err
+	}
+
+	// Item errors carry their own status rather than the response's
+	for i := range result.Results {
+		if e := result.Results[i].Error; e != nil && e.StatusCode == 0 {
+			e.StatusCode = result.Results[i].Status
+		}
+	}
+
+	return &result, nil
+}
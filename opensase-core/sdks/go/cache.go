@@ -0,0 +1,139 @@
+package opensase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// Reference Data Cache
+// =============================================================================
+
+// DefaultReferenceTTL is how long cached reference data is served without refresh
+const DefaultReferenceTTL = 24 * time.Hour
+
+// Cache stores raw API responses for slow-changing reference data
+type Cache interface {
+	// Get returns the cached value and when it was stored
+	Get(key string) ([]byte, time.Time, bool)
+	// Set stores a value, replacing any previous one
+	Set(key string, data []byte) error
+	// Delete removes a value
+	Delete(key string) error
+}
+
+// WithReferenceCache caches reference data (application catalog, URL
+// categories, PoPs, roles) in cache. Entries younger than ttl are served
+// directly; older entries are served immediately while being refreshed in the
+// background.
+func WithReferenceCache(cache Cache, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if ttl <= 0 {
+			ttl = DefaultReferenceTTL
+		}
+		c.refCache = &referenceCache{cache: cache, ttl: ttl}
+	}
+}
+
+type referenceCache struct {
+	cache    Cache
+	ttl      time.Duration
+	inflight sync.Map
+}
+
+// cacheKey namespaces a path by API endpoint, credential and tenant so
+// tenants sharing a cache directory never see each other's data, even when
+// one credential serves several tenants through WithTenant. With a session
+// the credential is the signed-in user, whose view may differ from that of
+// other users of the tenant.
+func (c *Client) cacheKey(path string) string {
+	credential := c.apiKey
+	if c.session != nil {
+		credential = c.session.identity()
+	}
+	sum := sha256.Sum256([]byte(c.baseURL + "\x00" + credential + "\x00" + c.tenantID))
+	return hex.EncodeToString(sum[:8]) + ":" + path
+}
+
+// DiskCache is a Cache backed by files in a directory, so reference data
+// survives across process invocations
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a DiskCache in dir, or in the user cache directory if dir is empty
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(base, "opensase")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+type diskCacheEntry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (d *DiskCache) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Cache
+func (d *DiskCache) Get(key string) ([]byte, time.Time, bool) {
+	raw, err := os.ReadFile(d.file(key))
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, time.Time{}, false
+	}
+	return entry.Data, entry.StoredAt, true
+}
+
+// Set implements Cache. The file is replaced atomically so concurrent readers
+// never observe a partial write.
+func (d *DiskCache) Set(key string, data []byte) error {
+	raw, err := json.Marshal(diskCacheEntry{StoredAt: time.Now(), Data: data})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), d.file(key))
+}
+
+// Delete implements Cache
+func (d *DiskCache) Delete(key string) error {
+	err := os.Remove(d.file(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,171 @@
+package opensase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// =============================================================================
+// Reference Catalog Service
+// =============================================================================
+
+// CatalogService provides access to slow-changing reference data. Responses
+// are cached when the client is configured WithReferenceCache.
+type CatalogService struct {
+	client *Client
+}
+
+// Application is an entry in the application catalog
+type Application struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Category    string   `json:"category"`
+	Subcategory string   `json:"subcategory,omitempty"`
+	RiskLevel   int      `json:"risk_level"`
+	Description string   `json:"description,omitempty"`
+	Domains     []string `json:"domains,omitempty"`
+	Custom      bool     `json:"custom"`
+}
+
+// URLCategory is a URL filtering category
+type URLCategory struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Group       string `json:"group,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// PoP is a platform point of presence
+type PoP struct {
+	ID        string  `json:"id"`
+	Code      string  `json:"code"`
+	City      string  `json:"city"`
+	Country   string  `json:"country"`
+	Region    string  `json:"region"`
+	Status    string  `json:"status"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+// Role is an administrative role. Permissions are module:action strings,
+// e.g. network:write. Modules limits the console areas the role can open;
+// empty means all. ReadOnly roles are refused every non-read permission.
+type Role struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	Modules     []string `json:"modules,omitempty"`
+	ReadOnly    bool     `json:"read_only"`
+	BuiltIn     bool     `json:"built_in"`
+}
+
+const (
+	catalogApplicationsPath  = "/catalog/applications"
+	catalogURLCategoriesPath = "/catalog/url-categories"
+	catalogPoPsPath          = "/catalog/pops"
+	catalogRolesPath         = "/identity/roles"
+)
+
+// Applications retrieves the application catalog
+func (s *CatalogService) Applications(ctx context.Context) ([]Application, error) {
+	var apps []Application
+	if err := s.fetch(ctx, catalogApplicationsPath, &apps); err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// URLCategories retrieves the URL filtering categories
+func (s *CatalogService) URLCategories(ctx context.Context) ([]URLCategory, error) {
+	var categories []URLCategory
+	if err := s.fetch(ctx, catalogURLCategoriesPath, &categories); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// PoPs retrieves the platform points of presence
+func (s *CatalogService) PoPs(ctx context.Context) ([]PoP, error) {
+	var pops []PoP
+	if err := s.fetch(ctx, catalogPoPsPath, &pops); err != nil {
+		return nil, err
+	}
+	return pops, nil
+}
+
+// Roles retrieves the administrative roles available to the tenant
+func (s *CatalogService) Roles(ctx context.Context) ([]Role, error) {
+	var roles []Role
+	if err := s.fetch(ctx, catalogRolesPath, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// Refresh re-fetches all reference data and replaces the cached copies
+func (s *CatalogService) Refresh(ctx context.Context) error {
+	for _, path := range []string{catalogApplicationsPath, catalogURLCategoriesPath, catalogPoPsPath, catalogRolesPath} {
+		if _, err := s.load(ctx, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *CatalogService) fetch(ctx context.Context, path string, v interface{}) error {
+	data, err := s.cached(ctx, path)
+	if err != nil {
+		return err
+	}
+	return s.client.decode(data, v)
+}
+
+func (s *CatalogService) cached(ctx context.Context, path string) (json.RawMessage, error) {
+	rc := s.client.refCache
+	if _, targeted := TargetTenant(ctx); rc == nil || targeted {
+		// Cache keys don't carry the target tenant
+		return s.client.get(ctx, path, nil, nil)
+	}
+
+	if data, storedAt, ok := rc.cache.Get(s.client.cacheKey(path)); ok {
+		if time.Since(storedAt) >= rc.ttl {
+			s.refreshInBackground(path)
+		}
+		return data, nil
+	}
+
+	return s.load(ctx, path)
+}
+
+// load fetches path from the API and stores it in the cache
+func (s *CatalogService) load(ctx context.Context, path string) (json.RawMessage, error) {
+	data, err := s.client.get(ctx, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, targeted := TargetTenant(ctx); targeted {
+		return data, nil
+	}
+	if rc := s.client.refCache; rc != nil {
+		// A failed cache write only costs a refetch next time.
+		_ = rc.cache.Set(s.client.cacheKey(path), data)
+	}
+	return data, nil
+}
+
+func (s *CatalogService) refreshInBackground(path string) {
+	rc := s.client.refCache
+	if _, busy := rc.inflight.LoadOrStore(path, struct{}{}); busy {
+		return
+	}
+
+	go func() {
+		defer rc.inflight.Delete(path)
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+		defer cancel()
+		_, _ = s.load(WithPriority(ctx, PriorityLow), path)
+	}()
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// endpoint is an API call made by the SDK
+type endpoint struct {
+	Method string
+	Path   string
+	Pos    string
+}
+
+// helper methods on Client and the index of their path argument
+var requestHelpers = map[string]struct {
+	method  string
+	pathArg int
+}{
+	"get":     {"GET", 1},
+	"post":    {"POST", 1},
+	"patch":   {"PATCH", 1},
+	"delete":  {"DELETE", 1},
+	"bulk":    {"POST", 1},
+	"request": {"", 2},
+}
+
+// checkEndpoints reports SDK calls to paths missing from a collection the
+// spec documents. Collections the spec does not describe at all are skipped.
+func checkEndpoints(s *spec, dir string) ([]finding, error) {
+	calls, err := sdkEndpoints(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := map[string]bool{}
+	for path := range s.Paths {
+		collections[collection(path)] = true
+	}
+
+	var findings []finding
+	seen := map[string]bool{}
+	for _, c := range calls {
+		if !collections[collection(c.Path)] {
+			continue
+		}
+		if s.Paths[c.Path][c.Method] {
+			continue
+		}
+		key := "endpoint:" + c.Method + " " + c.Path
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		findings = append(findings, finding{
+			Key:     key,
+			Message: fmt.Sprintf("%s %s: called at %s but not documented", c.Method, c.Path, c.Pos),
+		})
+	}
+	return findings, nil
+}
+
+// collection is the first two path segments, e.g. /crm/contacts
+func collection(path string) string {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(parts) < 2 {
+		return "/" + parts[0]
+	}
+	return "/" + parts[0] + "/" + parts[1]
+}
+
+// sdkEndpoints finds client helper calls whose path can be resolved statically
+func sdkEndpoints(dir string) ([]endpoint, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []endpoint
+	for _, pkg := range pkgs {
+		consts := stringConsts(pkg)
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || !isClientExpr(sel.X) {
+					return true
+				}
+				helper, ok := requestHelpers[sel.Sel.Name]
+				if !ok || len(call.Args) <= helper.pathArg {
+					return true
+				}
+
+				method := helper.method
+				if method == "" {
+					m, ok := resolvePath(call.Args[1], consts)
+					if !ok {
+						return true
+					}
+					method = strings.ToUpper(m)
+				}
+				path, ok := resolvePath(call.Args[helper.pathArg], consts)
+				if !ok || !strings.HasPrefix(path, "/") {
+					return true
+				}
+				endpoints = append(endpoints, endpoint{
+					Method: method,
+					Path:   normalizePath(path),
+					Pos:    fset.Position(call.Pos()).String(),
+				})
+				return true
+			})
+		}
+	}
+	return endpoints, nil
+}
+
+// isClientExpr matches s.client and c
+func isClientExpr(x ast.Expr) bool {
+	switch e := x.(type) {
+	case *ast.SelectorExpr:
+		return e.Sel.Name == "client"
+	case *ast.Ident:
+		return e.Name == "c"
+	}
+	return false
+}
+
+// resolvePath evaluates string concatenations, turning non-constant
+// operands into path parameters
+func resolvePath(expr ast.Expr, consts map[string]string) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		v, err := strconv.Unquote(e.Value)
+		return v, err == nil
+	case *ast.Ident:
+		if v, ok := consts[e.Name]; ok {
+			return v, true
+		}
+		return "{}", true
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "http" && strings.HasPrefix(e.Sel.Name, "Method") {
+			return strings.TrimPrefix(e.Sel.Name, "Method"), true
+		}
+		return "{}", true
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		l, ok := resolvePath(e.X, consts)
+		if !ok {
+			return "", false
+		}
+		r, ok := resolvePath(e.Y, consts)
+		if !ok {
+			return "", false
+		}
+		return l + r, true
+	case *ast.CallExpr, *ast.IndexExpr:
+		return "{}", true
+	}
+	return "", false
+}
+
+func stringConsts(pkg *ast.Package) map[string]string {
+	consts := map[string]string{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if i >= len(vs.Values) {
+						continue
+					}
+					if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						if v, err := strconv.Unquote(lit.Value); err == nil {
+							consts[name.Name] = v
+						}
+					}
+				}
+			}
+		}
+	}
+	return consts
+}
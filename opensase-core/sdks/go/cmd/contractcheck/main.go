@@ -0,0 +1,136 @@
+// Command contractcheck verifies the SDK against the published OpenAPI
+// description of the API. It fails when:
+//
+//   - a documented property is missing from the SDK model mapped to it
+//   - an SDK model field is not documented (removed or renamed upstream)
+//   - a field's Go type no longer matches the documented type
+//   - the SDK calls an endpoint in a documented collection that the API no
+//     longer describes
+//
+// Findings listed in the baseline file are reported but do not fail the run,
+// so known gaps can be burned down without blocking CI.
+//
+//	go run ./cmd/contractcheck \
+//	    -spec ../../openapi/opensase-api.yaml \
+//	    -spec ../../openapi/opensase-api-paths-crm-payments.yaml \
+//	    -baseline cmd/contractcheck/baseline.txt
+//
+// -spec also accepts http(s) URLs, to check against the live API description.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+type specList []string
+
+func (s *specList) String() string     { return strings.Join(*s, ",") }
+func (s *specList) Set(v string) error { *s = append(*s, v); return nil }
+
+// finding is a single contract violation, keyed for baselining
+type finding struct {
+	Key     string
+	Message string
+}
+
+func main() {
+	var specs specList
+	flag.Var(&specs, "spec", "OpenAPI document or path fragment, file or URL (repeatable)")
+	sdkDir := flag.String("sdk", ".", "directory of the SDK package")
+	baselinePath := flag.String("baseline", "", "file of accepted finding keys, one per line")
+	updateBaseline := flag.Bool("update-baseline", false, "write current findings to -baseline and exit")
+	flag.Parse()
+
+	if len(specs) == 0 {
+		fmt.Fprintln(os.Stderr, "contractcheck: at least one -spec is required")
+		os.Exit(2)
+	}
+
+	spec, err := loadSpec(specs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "contractcheck: %v\n", err)
+		os.Exit(2)
+	}
+
+	findings := checkModels(spec)
+	endpoints, err := checkEndpoints(spec, *sdkDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "contractcheck: %v\n", err)
+		os.Exit(2)
+	}
+	findings = append(findings, endpoints...)
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Key < findings[j].Key })
+
+	if *updateBaseline {
+		if *baselinePath == "" {
+			fmt.Fprintln(os.Stderr, "contractcheck: -update-baseline requires -baseline")
+			os.Exit(2)
+		}
+		if err := writeBaseline(*baselinePath, findings); err != nil {
+			fmt.Fprintf(os.Stderr, "contractcheck: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("wrote %d findings to %s\n", len(findings), *baselinePath)
+		return
+	}
+
+	accepted := map[string]bool{}
+	if *baselinePath != "" {
+		if accepted, err = readBaseline(*baselinePath); err != nil {
+			fmt.Fprintf(os.Stderr, "contractcheck: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	failed := 0
+	for _, f := range findings {
+		if accepted[f.Key] {
+			fmt.Printf("known  %s\n", f.Message)
+			delete(accepted, f.Key)
+			continue
+		}
+		fmt.Printf("FAIL   %s\n", f.Message)
+		failed++
+	}
+	for key := range accepted {
+		fmt.Printf("fixed  %s (remove from baseline)\n", key)
+	}
+
+	fmt.Printf("\n%d models, %d findings, %d new\n", len(models), len(findings), failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func readBaseline(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	keys := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keys[line] = true
+	}
+	return keys, scanner.Err()
+}
+
+func writeBaseline(path string, findings []finding) error {
+	var b strings.Builder
+	b.WriteString("# Accepted contract findings; see cmd/contractcheck. Regenerate with -update-baseline.\n")
+	for _, f := range findings {
+		b.WriteString(f.Key + "\n")
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
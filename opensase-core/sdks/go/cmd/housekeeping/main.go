@@ -0,0 +1,120 @@
+// Command housekeeping garbage-collects stale address objects, service
+// objects and service groups. It is meant to run as a scheduled job; runs
+// are dry runs unless -apply is set.
+//
+//	OPENSASE_API_KEY=... housekeeping -unused-days 90
+//	OPENSASE_API_KEY=... housekeeping -unused-days 90 -tag -delete -grace-days 14 -apply
+//
+// With -tag, stale objects are tagged in their description on the first run
+// and deleted by the first run after the grace period. The exit status is 1
+// if any action failed.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/housekeeping"
+)
+
+func main() {
+	var (
+		unusedDays = flag.Int("unused-days", 90, "days without use after which an object is stale")
+		graceDays  = flag.Int("grace-days", 14, "days a stale object is kept before deletion")
+		tag        = flag.Bool("tag", false, "tag stale objects; the grace period starts at tagging")
+		del        = flag.Bool("delete", false, "delete stale objects after the grace period")
+		apply      = flag.Bool("apply", false, "make changes; without it only report")
+		baseURL    = flag.String("base-url", "", "API base URL (default: SDK default)")
+		tenant     = flag.String("tenant", os.Getenv("OPENSASE_TENANT_ID"), "tenant to run against")
+		jsonOut    = flag.Bool("json", false, "print the report as JSON")
+	)
+	flag.Parse()
+
+	apiKey := os.Getenv("OPENSASE_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "housekeeping: OPENSASE_API_KEY is not set")
+		os.Exit(2)
+	}
+
+	var opts []opensase.ClientOption
+	if *baseURL != "" {
+		opts = append(opts, opensase.WithBaseURL(*baseURL))
+	}
+	if *tenant != "" {
+		opts = append(opts, opensase.WithTenant(*tenant))
+	}
+	// Housekeeping must not compete with interactive API use
+	opts = append(opts, opensase.WithRateLimit(5, 5))
+
+	sweepOpts := []housekeeping.Option{
+		housekeeping.WithUnusedFor(time.Duration(*unusedDays) * 24 * time.Hour),
+		housekeeping.WithGracePeriod(time.Duration(*graceDays) * 24 * time.Hour),
+	}
+	if *tag {
+		sweepOpts = append(sweepOpts, housekeeping.WithTagging())
+	}
+	if *del {
+		sweepOpts = append(sweepOpts, housekeeping.WithDeletion())
+	}
+	sw := housekeeping.New(opensase.NewClient(apiKey, opts...), sweepOpts...)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx = opensase.WithPriority(ctx, opensase.PriorityLow)
+
+	run := sw.Plan
+	if *apply {
+		run = sw.Run
+	}
+	report, err := run(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "housekeeping: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(report)
+	} else {
+		writeTable(os.Stdout, report)
+	}
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func writeTable(w io.Writer, r *housekeeping.Report) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ACTION\tKIND\tNAME\tID\tDELETE AFTER\tNOTE")
+	for _, f := range r.Findings {
+		deleteAfter := "-"
+		if f.DeleteAfter != nil {
+			deleteAfter = f.DeleteAfter.Format("2006-01-02")
+		}
+		note := ""
+		switch {
+		case f.Error != "":
+			note = "error: " + f.Error
+		case f.Action == housekeeping.ActionReview:
+			note = fmt.Sprintf("%d idle references", len(f.References))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", f.Action, f.Kind, f.Name, f.ID, deleteAfter, note)
+	}
+	tw.Flush()
+
+	mode := "applied"
+	if r.DryRun {
+		mode = "dry run"
+	}
+	fmt.Fprintf(w, "\n%s: %d objects scanned, %d tagged, %d untagged, %d deleted, %d failed\n",
+		mode, r.Scanned, r.Tagged, r.Untagged, r.Deleted, r.Failed)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+type stats struct {
+	mu  sync.Mutex
+	ops map[string]*opStats
+}
+
+type opStats struct {
+	latencies []time.Duration
+	errors    map[string]int
+}
+
+func newStats() *stats {
+	return &stats{ops: make(map[string]*opStats)}
+}
+
+func (s *stats) record(op string, latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.ops[op]
+	if !ok {
+		o = &opStats{errors: make(map[string]int)}
+		s.ops[op] = o
+	}
+	if err != nil {
+		o.errors[errorClass(err)]++
+		return
+	}
+	o.latencies = append(o.latencies, latency)
+}
+
+// errorClass groups errors by API status so that, for example, rate limiting
+// can be told apart from server failures
+func errorClass(err error) string {
+	var rl *opensase.RateLimitError
+	if errors.As(err, &rl) {
+		return "429"
+	}
+	var apiErr *opensase.Error
+	if errors.As(err, &apiErr) {
+		return strconv.Itoa(apiErr.StatusCode)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	return "transport"
+}
+
+// Report is the summary printed at the end of a run
+type Report struct {
+	Elapsed    time.Duration `json:"elapsed_ns"`
+	Operations []OpReport    `json:"operations"`
+	Errors     int           `json:"errors"`
+}
+
+// OpReport summarizes a single operation
+type OpReport struct {
+	Name      string         `json:"name"`
+	Count     int            `json:"count"`
+	PerSecond float64        `json:"per_second"`
+	P50       time.Duration  `json:"p50_ns"`
+	P90       time.Duration  `json:"p90_ns"`
+	P99       time.Duration  `json:"p99_ns"`
+	Max       time.Duration  `json:"max_ns"`
+	Errors    map[string]int `json:"errors,omitempty"`
+}
+
+func (s *stats) report(elapsed time.Duration) *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := &Report{Elapsed: elapsed}
+	for name, o := range s.ops {
+		sort.Slice(o.latencies, func(i, j int) bool { return o.latencies[i] < o.latencies[j] })
+		op := OpReport{
+			Name:      name,
+			Count:     len(o.latencies),
+			PerSecond: float64(len(o.latencies)) / elapsed.Seconds(),
+			P50:       percentile(o.latencies, 0.50),
+			P90:       percentile(o.latencies, 0.90),
+			P99:       percentile(o.latencies, 0.99),
+			Max:       percentile(o.latencies, 1),
+			Errors:    o.errors,
+		}
+		for _, n := range o.errors {
+			r.Errors += n
+		}
+		r.Operations = append(r.Operations, op)
+	}
+	sort.Slice(r.Operations, func(i, j int) bool { return r.Operations[i].Name < r.Operations[j].Name })
+	return r
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p*float64(len(sorted))+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+func (r *Report) writeTable(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "OPERATION\tOK\tOPS/S\tP50\tP90\tP99\tMAX\tERRORS")
+	for _, op := range r.Operations {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f\t%s\t%s\t%s\t%s\t%s\n",
+			op.Name, op.Count, op.PerSecond,
+			round(op.P50), round(op.P90), round(op.P99), round(op.Max),
+			formatErrors(op.Errors))
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\n%d errors in %s\n", r.Errors, round(r.Elapsed))
+}
+
+func (r *Report) writeJSON(w io.Writer) {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(r)
+}
+
+func formatErrors(errs map[string]int) string {
+	if len(errs) == 0 {
+		return "-"
+	}
+	classes := make([]string, 0, len(errs))
+	for c := range errs {
+		classes = append(classes, c)
+	}
+	sort.Strings(classes)
+
+	out := ""
+	for i, c := range classes {
+		if i > 0 {
+			out += " "
+		}
+		out += fmt.Sprintf("%s:%d", c, errs[c])
+	}
+	return out
+}
+
+func round(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond)
+	default:
+		return d.Round(time.Microsecond)
+	}
+}
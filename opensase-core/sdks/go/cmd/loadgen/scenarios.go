@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/enum"
+)
+
+// cleanupTimeout bounds deletes issued after the run context has expired
+const cleanupTimeout = 30 * time.Second
+
+// policyChurn repeatedly creates, updates, reorders and deletes security policies
+func policyChurn(ctx context.Context, r *runner, worker int) error {
+	for i := 0; ctx.Err() == nil && r.next(); i++ {
+		var policy *opensase.Policy
+		err := r.time("policies.create", func() (err error) {
+			policy, err = r.client.Security.Policies.Create(ctx, &opensase.CreatePolicyParams{
+				Name:    fmt.Sprintf("%s-w%d-%d", r.prefix, worker, i),
+				Action:  enum.PolicyActionAllow,
+				Enabled: opensase.Bool(false),
+			})
+			return err
+		})
+		if err != nil {
+			continue
+		}
+
+		r.time("policies.update", func() error {
+			_, err := r.client.Security.Policies.Update(ctx, policy.ID, &opensase.UpdatePolicyParams{
+				Action: opensase.Ptr(enum.PolicyActionDeny),
+			})
+			return err
+		})
+		r.time("policies.list", func() error {
+			_, err := r.client.Security.Policies.List(ctx, &opensase.ListPoliciesParams{PerPage: 100})
+			return err
+		})
+
+		if !r.keep {
+			r.cleanup("policies.delete", func(ctx context.Context) error {
+				return r.client.Security.Policies.Delete(ctx, policy.ID)
+			})
+		}
+	}
+	return nil
+}
+
+// siteOnboarding creates sites with WAN links the way a bulk migration does
+func siteOnboarding(ctx context.Context, r *runner, worker int) error {
+	for i := 0; ctx.Err() == nil && r.next(); i++ {
+		var site *opensase.Site
+		err := r.time("sites.create", func() (err error) {
+			site, err = r.client.Network.Sites.Create(ctx, &opensase.CreateSiteParams{
+				Name:     fmt.Sprintf("%s-w%d-%d", r.prefix, worker, i),
+				Location: "loadgen",
+			})
+			return err
+		})
+		if err != nil {
+			continue
+		}
+
+		for _, link := range []opensase.CreateWANLinkParams{
+			{Name: "wan0", Type: enum.WANLinkTypeBroadband, BandwidthMbps: 500, FailoverPriority: 1},
+			{Name: "lte0", Type: enum.WANLinkTypeLTE, BandwidthMbps: 50, FailoverPriority: 2},
+		} {
+			link := link
+			r.time("wan_links.create", func() error {
+				_, err := r.client.Network.WANLinks.Create(ctx, site.ID, &link)
+				return err
+			})
+		}
+		r.time("sites.get", func() error {
+			_, err := r.client.Network.Sites.Get(ctx, site.ID)
+			return err
+		})
+
+		if !r.keep {
+			r.cleanup("sites.delete", func(ctx context.Context) error {
+				return r.client.Network.Sites.Delete(ctx, site.ID)
+			})
+		}
+	}
+	return nil
+}
+
+// logStream holds a log stream consumer open, reconnecting from the last
+// cursor, and measures time to first entry and per-entry delivery lag
+func logStream(ctx context.Context, r *runner, worker int) error {
+	var cursor string
+	for ctx.Err() == nil && r.next() {
+		var stream *opensase.LogStream
+		connected := time.Now()
+		err := r.time("logs.connect", func() (err error) {
+			stream, err = r.client.Monitoring.Logs.Stream(ctx, &opensase.LogStreamParams{Cursor: cursor})
+			return err
+		})
+		if err != nil {
+			sleep(ctx, time.Second)
+			continue
+		}
+
+		first := true
+		for {
+			entry, err := stream.Next()
+			if err != nil {
+				if ctx.Err() == nil && !errors.Is(err, io.EOF) {
+					r.stats.record("logs.read", 0, err)
+				}
+				break
+			}
+			if first {
+				r.stats.record("logs.first_entry", time.Since(connected), nil)
+				first = false
+			}
+			r.stats.record("logs.lag", time.Since(entry.Timestamp), nil)
+			cursor = entry.ID
+		}
+		stream.Close()
+	}
+	return nil
+}
+
+// cleanup runs a delete even if the run context has already expired
+func (r *runner) cleanup(op string, fn func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+	r.time(op, func() error { return fn(ctx) })
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
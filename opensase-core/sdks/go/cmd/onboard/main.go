@@ -0,0 +1,147 @@
+// Command onboard provisions branch sites from a JSON file holding a list of
+// provisioning.SiteSpec:
+//
+//	[{"site": {"name": "branch-042", "location": "Leeds", "template_id": "tpl_..."},
+//	  "vlans": [{"name": "users", "vlan_id": 10, "cidr": "10.42.10.0/24"}],
+//	  "traffic_policy_ids": ["tp_..."],
+//	  "device": {"serial_number": "OS1K-...", "model": "os-1000", "claim_code": "...", "name": "branch-042-edge"}}]
+//
+//	OPENSASE_API_KEY=... onboard -f weekend.json -concurrency 8
+//
+// Progress is written to stderr and a summary to stdout. Failed sites are
+// rolled back, so the same file can be run again once the cause is fixed;
+// sites that already exist then fail on creation and are left alone. The
+// exit status is 1 if any site failed.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/provisioning"
+)
+
+func main() {
+	var (
+		file        = flag.String("f", "", "JSON file of site specs (required)")
+		concurrency = flag.Int("concurrency", provisioning.DefaultConcurrency, "sites onboarded at a time")
+		noRollback  = flag.Bool("no-rollback", false, "leave failed sites in place for inspection")
+		baseURL     = flag.String("base-url", "", "API base URL (default: SDK default)")
+		tenant      = flag.String("tenant", os.Getenv("OPENSASE_TENANT_ID"), "tenant to run against")
+		jsonOut     = flag.Bool("json", false, "print the results as JSON")
+	)
+	flag.Parse()
+
+	apiKey := os.Getenv("OPENSASE_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "onboard: OPENSASE_API_KEY is not set")
+		os.Exit(2)
+	}
+	if *file == "" {
+		fmt.Fprintln(os.Stderr, "onboard: -f is required")
+		os.Exit(2)
+	}
+
+	specs, err := readSpecs(*file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "onboard: %v\n", err)
+		os.Exit(2)
+	}
+
+	var opts []opensase.ClientOption
+	if *baseURL != "" {
+		opts = append(opts, opensase.WithBaseURL(*baseURL))
+	}
+	if *tenant != "" {
+		opts = append(opts, opensase.WithTenant(*tenant))
+	}
+
+	obOpts := []provisioning.Option{
+		provisioning.WithConcurrency(*concurrency),
+		provisioning.WithProgress(func(e provisioning.Event) {
+			line := fmt.Sprintf("%s: %s %s", e.Site, e.Step, e.Status)
+			if e.Err != nil {
+				line += ": " + e.Err.Error()
+			}
+			fmt.Fprintln(os.Stderr, line)
+		}),
+	}
+	if *noRollback {
+		obOpts = append(obOpts, provisioning.WithoutRollback())
+	}
+	ob := provisioning.New(opensase.NewClient(apiKey, opts...), obOpts...)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	results, err := ob.Run(ctx, specs)
+	if results == nil {
+		fmt.Fprintf(os.Stderr, "onboard: %v\n", err)
+		os.Exit(2)
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(results)
+	} else {
+		writeTable(os.Stdout, results)
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func readSpecs(path string) ([]provisioning.SiteSpec, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	var specs []provisioning.SiteSpec
+	if err := dec.Decode(&specs); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return specs, nil
+}
+
+func writeTable(w io.Writer, results []provisioning.Result) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SITE\tSITE ID\tDEVICE ID\tRESULT")
+	failed := 0
+	for _, r := range results {
+		result := "ok"
+		if r.Err != nil {
+			failed++
+			result = r.Err.Error()
+			switch {
+			case r.RolledBack:
+				result += " (rolled back)"
+			case r.RollbackErr != nil:
+				result += " (rollback incomplete: " + strings.ReplaceAll(r.RollbackErr.Error(), "\n", "; ") + ")"
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Name, dash(r.SiteID), dash(r.DeviceID), result)
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\n%d sites, %d onboarded, %d failed\n", len(results), len(results)-failed, failed)
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,203 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Compliance Service
+// =============================================================================
+
+// Compliance frameworks supported by evidence reports
+const (
+	FrameworkPCIDSS   = "pci_dss"
+	FrameworkISO27001 = "iso_27001"
+	FrameworkSOC2     = "soc2"
+)
+
+// Compliance report statuses
+const (
+	ComplianceReportPending    = "pending"
+	ComplianceReportGenerating = "generating"
+	ComplianceReportReady      = "ready"
+	ComplianceReportFailed     = "failed"
+)
+
+// ComplianceService provides access to compliance and audit evidence APIs
+type ComplianceService struct {
+	client     *Client
+	Reports    *ComplianceReportsService
+	Retention  *RetentionService
+	LegalHolds *LegalHoldsService
+}
+
+// ComplianceReportsService provides access to compliance evidence bundle APIs
+type ComplianceReportsService struct {
+	client *Client
+}
+
+// ComplianceReport represents an evidence bundle generated for a framework.
+// JobID identifies the background job generating it; see JobsService.
+type ComplianceReport struct {
+	ID          string                     `json:"id"`
+	Framework   string                     `json:"framework"`
+	Status      string                     `json:"status"`
+	PeriodStart time.Time                  `json:"period_start"`
+	PeriodEnd   time.Time                  `json:"period_end"`
+	Sections    []string                   `json:"sections"`
+	Artifacts   []ComplianceReportArtifact `json:"artifacts,omitempty"`
+	Format      string                     `json:"format"`
+	SizeBytes   int64                      `json:"size_bytes,omitempty"`
+	SHA256      string                     `json:"sha256,omitempty"`
+	DownloadURL string                     `json:"download_url,omitempty"`
+	ExpiresAt   *time.Time                 `json:"expires_at,omitempty"`
+	FailureCode string                     `json:"failure_code,omitempty"`
+	RequestedBy string                     `json:"requested_by,omitempty"`
+	JobID       string                     `json:"job_id,omitempty"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	CompletedAt *time.Time                 `json:"completed_at,omitempty"`
+}
+
+// ComplianceReportArtifact is a single file within an evidence bundle
+type ComplianceReportArtifact struct {
+	Section   string `json:"section"`
+	Name      string `json:"name"`
+	Control   string `json:"control,omitempty"`
+	SizeBytes int64  `json:"size_bytes"`
+	SHA256    string `json:"sha256"`
+}
+
+// CreateComplianceReportParams contains parameters for generating an evidence bundle.
+// Sections defaults to config_snapshots, admin_activity and policy_attestations.
+type CreateComplianceReportParams struct {
+	Framework   string    `json:"framework"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Sections    []string  `json:"sections,omitempty"`
+	Format      string    `json:"format,omitempty"`
+}
+
+// ListComplianceReportsParams contains parameters for listing compliance reports
+type ListComplianceReportsParams struct {
+	Page      int     `json:"page,omitempty"`
+	PerPage   int     `json:"per_page,omitempty"`
+	Framework *string `json:"framework,omitempty"`
+	Status    *string `json:"status,omitempty"`
+}
+
+// ComplianceReportListResponse contains a list of compliance reports with pagination
+type ComplianceReportListResponse struct {
+	Data       []ComplianceReport `json:"data"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+// Create starts asynchronous generation of an evidence bundle
+func (s *ComplianceReportsService) Create(ctx context.Context, params *CreateComplianceReportParams, opts *RequestOptions) (*ComplianceReport, error) {
+	if !params.PeriodEnd.After(params.PeriodStart) {
+		return nil, fmt.Errorf("opensase: compliance report period_end must be after period_start")
+	}
+
+	data, err := s.client.post(ctx, "/compliance/reports", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var report ComplianceReport
+	if err := s.client.decode(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// Get retrieves a compliance report by ID
+func (s *ComplianceReportsService) Get(ctx context.Context, reportID string) (*ComplianceReport, error) {
+	data, err := s.client.get(ctx, "/compliance/reports/"+reportID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var report ComplianceReport
+	if err := s.client.decode(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// List retrieves compliance reports with pagination
+func (s *ComplianceReportsService) List(ctx context.Context, params *ListComplianceReportsParams) (*ComplianceReportListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Framework != nil {
+			v.Set("framework", *params.Framework)
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/compliance/reports", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ComplianceReportListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RefreshDownloadURL issues a new signed download URL for a ready report
+func (s *ComplianceReportsService) RefreshDownloadURL(ctx context.Context, reportID string) (*ComplianceReport, error) {
+	data, err := s.client.post(ctx, "/compliance/reports/"+reportID+"/download_url", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var report ComplianceReport
+	if err := s.client.decode(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// Wait polls a compliance report until it is ready or has failed
+func (s *ComplianceReportsService) Wait(ctx context.Context, reportID string, interval time.Duration) (*ComplianceReport, error) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		report, err := s.Get(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch report.Status {
+		case ComplianceReportReady:
+			return report, nil
+		case ComplianceReportFailed:
+			return report, fmt.Errorf("opensase: compliance report %s failed: %s", report.ID, report.FailureCode)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
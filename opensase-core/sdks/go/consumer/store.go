@@ -0,0 +1,198 @@
+package consumer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Record statuses
+const (
+	StatusPending    = "pending"
+	StatusProcessed  = "processed"
+	StatusDeadLetter = "dead_letter"
+)
+
+// Record is a persisted webhook event awaiting or having completed processing
+type Record struct {
+	ID            string
+	Type          string
+	Payload       []byte
+	Status        string
+	Attempts      int
+	LastError     string
+	ReceivedAt    time.Time
+	NextAttemptAt time.Time
+	ProcessedAt   *time.Time
+}
+
+// StoreStats summarizes the state of a Store
+type StoreStats struct {
+	Pending       int
+	DeadLettered  int
+	OldestPending *time.Time
+}
+
+// Store persists webhook events between receipt and processing. Implementations
+// must be safe for concurrent use and must deduplicate on Record.ID, since the
+// platform delivers events at least once.
+type Store interface {
+	// Save persists a new record. It returns false without error if a record
+	// with the same ID already exists.
+	Save(ctx context.Context, rec *Record) (bool, error)
+
+	// Due returns up to limit pending records whose NextAttemptAt is not after now,
+	// oldest first.
+	Due(ctx context.Context, now time.Time, limit int) ([]*Record, error)
+
+	// MarkProcessed marks a record as successfully handled.
+	MarkProcessed(ctx context.Context, id string, at time.Time) error
+
+	// MarkRetry records a failed attempt and schedules the next one.
+	MarkRetry(ctx context.Context, id string, attempts int, next time.Time, lastErr string) error
+
+	// MarkDeadLetter moves a record out of the retry loop.
+	MarkDeadLetter(ctx context.Context, id string, attempts int, lastErr string) error
+
+	// DeadLetters returns records that exhausted their retries.
+	DeadLetters(ctx context.Context, limit int) ([]*Record, error)
+
+	// Requeue moves a dead-lettered record back to pending with its attempts reset.
+	Requeue(ctx context.Context, id string, at time.Time) error
+
+	// Stats returns queue depth information used for lag metrics.
+	Stats(ctx context.Context) (*StoreStats, error)
+}
+
+// MemoryStore is an in-process Store. It loses events on restart and is
+// intended for tests and single-instance consumers that can tolerate that.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]*Record)}
+}
+
+// Save implements Store
+func (s *MemoryStore) Save(ctx context.Context, rec *Record) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.records[rec.ID]; ok {
+		return false, nil
+	}
+	cp := *rec
+	s.records[rec.ID] = &cp
+	return true, nil
+}
+
+// Due implements Store
+func (s *MemoryStore) Due(ctx context.Context, now time.Time, limit int) ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*Record
+	for _, rec := range s.records {
+		if rec.Status == StatusPending && !rec.NextAttemptAt.After(now) {
+			cp := *rec
+			due = append(due, &cp)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ReceivedAt.Before(due[j].ReceivedAt) })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// MarkProcessed implements Store
+func (s *MemoryStore) MarkProcessed(ctx context.Context, id string, at time.Time) error {
+	return s.update(id, func(rec *Record) {
+		rec.Status = StatusProcessed
+		rec.ProcessedAt = &at
+		rec.Payload = nil
+	})
+}
+
+// MarkRetry implements Store
+func (s *MemoryStore) MarkRetry(ctx context.Context, id string, attempts int, next time.Time, lastErr string) error {
+	return s.update(id, func(rec *Record) {
+		rec.Attempts = attempts
+		rec.NextAttemptAt = next
+		rec.LastError = lastErr
+	})
+}
+
+// MarkDeadLetter implements Store
+func (s *MemoryStore) MarkDeadLetter(ctx context.Context, id string, attempts int, lastErr string) error {
+	return s.update(id, func(rec *Record) {
+		rec.Status = StatusDeadLetter
+		rec.Attempts = attempts
+		rec.LastError = lastErr
+	})
+}
+
+// DeadLetters implements Store
+func (s *MemoryStore) DeadLetters(ctx context.Context, limit int) ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var dead []*Record
+	for _, rec := range s.records {
+		if rec.Status == StatusDeadLetter {
+			cp := *rec
+			dead = append(dead, &cp)
+		}
+	}
+	sort.Slice(dead, func(i, j int) bool { return dead[i].ReceivedAt.Before(dead[j].ReceivedAt) })
+	if limit > 0 && len(dead) > limit {
+		dead = dead[:limit]
+	}
+	return dead, nil
+}
+
+// Requeue implements Store
+func (s *MemoryStore) Requeue(ctx context.Context, id string, at time.Time) error {
+	return s.update(id, func(rec *Record) {
+		rec.Status = StatusPending
+		rec.Attempts = 0
+		rec.NextAttemptAt = at
+	})
+}
+
+// Stats implements Store
+func (s *MemoryStore) Stats(ctx context.Context) (*StoreStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := &StoreStats{}
+	for _, rec := range s.records {
+		switch rec.Status {
+		case StatusPending:
+			stats.Pending++
+			if stats.OldestPending == nil || rec.ReceivedAt.Before(*stats.OldestPending) {
+				t := rec.ReceivedAt
+				stats.OldestPending = &t
+			}
+		case StatusDeadLetter:
+			stats.DeadLettered++
+		}
+	}
+	return stats, nil
+}
+
+func (s *MemoryStore) update(id string, fn func(*Record)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.records[id]
+	if !ok {
+		return ErrNotFound
+	}
+	fn(rec)
+	return nil
+}
@@ -0,0 +1,333 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// CRM Products & Quotes
+// =============================================================================
+
+// ProductsService provides access to product catalog APIs
+type ProductsService struct {
+	client *Client
+}
+
+// Product represents a sellable product
+type Product struct {
+	ID           string                 `json:"id"`
+	SKU          string                 `json:"sku,omitempty"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description,omitempty"`
+	UnitPrice    float64                `json:"unit_price"`
+	Currency     string                 `json:"currency"`
+	BillingCycle string                 `json:"billing_cycle,omitempty"`
+	Active       bool                   `json:"active"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// CreateProductParams contains parameters for creating a product
+type CreateProductParams struct {
+	Name         string                 `json:"name"`
+	SKU          string                 `json:"sku,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	UnitPrice    float64                `json:"unit_price"`
+	Currency     string                 `json:"currency,omitempty"`
+	BillingCycle string                 `json:"billing_cycle,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+}
+
+// UpdateProductParams contains parameters for updating a product
+type UpdateProductParams struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	UnitPrice   *float64 `json:"unit_price,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// ListProductsParams contains parameters for listing products
+type ListProductsParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	Search  *string `json:"search,omitempty"`
+	Active  *bool   `json:"active,omitempty"`
+}
+
+// ProductListResponse contains a list of products with pagination
+type ProductListResponse struct {
+	Data       []Product  `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// List retrieves all products with pagination
+func (s *ProductsService) List(ctx context.Context, params *ListProductsParams) (*ProductListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Search != nil {
+			v.Set("search", *params.Search)
+		}
+		if params.Active != nil {
+			v.Set("active", strconv.FormatBool(*params.Active))
+		}
+	}
+
+	data, err := s.client.get(ctx, "/crm/products", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ProductListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Create creates a new product
+func (s *ProductsService) Create(ctx context.Context, params *CreateProductParams) (*Product, error) {
+	data, err := s.client.post(ctx, "/crm/products", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var product Product
+	if err := s.client.decode(data, &product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// Get retrieves a product by ID
+func (s *ProductsService) Get(ctx context.Context, productID string) (*Product, error) {
+	data, err := s.client.get(ctx, "/crm/products/"+productID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var product Product
+	if err := s.client.decode(data, &product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// Update updates a product
+func (s *ProductsService) Update(ctx context.Context, productID string, params *UpdateProductParams) (*Product, error) {
+	data, err := s.client.patch(ctx, "/crm/products/"+productID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var product Product
+	if err := s.client.decode(data, &product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// Delete deletes a product
+func (s *ProductsService) Delete(ctx context.Context, productID string) error {
+	return s.client.delete(ctx, "/crm/products/"+productID, nil)
+}
+
+// QuotesService provides access to quote APIs
+type QuotesService struct {
+	client *Client
+}
+
+// Quote represents a priced quote attached to a deal
+type Quote struct {
+	ID            string          `json:"id"`
+	Number        string          `json:"number"`
+	DealID        string          `json:"deal_id"`
+	ContactID     string          `json:"contact_id,omitempty"`
+	Status        string          `json:"status"`
+	Currency      string          `json:"currency"`
+	LineItems     []QuoteLineItem `json:"line_items"`
+	Subtotal      float64         `json:"subtotal"`
+	DiscountTotal float64         `json:"discount_total"`
+	TaxTotal      float64         `json:"tax_total"`
+	Total         float64         `json:"total"`
+	ExpiresAt     *time.Time      `json:"expires_at,omitempty"`
+	Signature     *QuoteSignature `json:"signature,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// QuoteLineItem represents a product line on a quote
+type QuoteLineItem struct {
+	ID              string  `json:"id,omitempty"`
+	ProductID       string  `json:"product_id"`
+	Name            string  `json:"name,omitempty"`
+	Quantity        int     `json:"quantity"`
+	UnitPrice       float64 `json:"unit_price,omitempty"`
+	DiscountPercent float64 `json:"discount_percent,omitempty"`
+	DiscountAmount  float64 `json:"discount_amount,omitempty"`
+	Total           float64 `json:"total,omitempty"`
+}
+
+// QuoteSignature contains the e-signature status of a quote
+type QuoteSignature struct {
+	Status      string     `json:"status"`
+	SignerName  string     `json:"signer_name,omitempty"`
+	SignerEmail string     `json:"signer_email,omitempty"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+	ViewedAt    *time.Time `json:"viewed_at,omitempty"`
+	SignedAt    *time.Time `json:"signed_at,omitempty"`
+	DeclinedAt  *time.Time `json:"declined_at,omitempty"`
+}
+
+// QuoteDocument contains a generated quote PDF
+type QuoteDocument struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateQuoteParams contains parameters for creating a quote
+type CreateQuoteParams struct {
+	DealID    string          `json:"deal_id"`
+	ContactID string          `json:"contact_id,omitempty"`
+	Currency  string          `json:"currency,omitempty"`
+	LineItems []QuoteLineItem `json:"line_items"`
+	ExpiresAt *string         `json:"expires_at,omitempty"`
+	Notes     string          `json:"notes,omitempty"`
+}
+
+// UpdateQuoteParams contains parameters for updating a draft quote
+type UpdateQuoteParams struct {
+	LineItems []QuoteLineItem `json:"line_items,omitempty"`
+	ExpiresAt *string         `json:"expires_at,omitempty"`
+	Notes     *string         `json:"notes,omitempty"`
+}
+
+// SendForSignatureParams contains parameters for requesting an e-signature
+type SendForSignatureParams struct {
+	SignerName  string `json:"signer_name"`
+	SignerEmail string `json:"signer_email"`
+	Message     string `json:"message,omitempty"`
+}
+
+// Create creates a new quote for a deal
+func (s *QuotesService) Create(ctx context.Context, params *CreateQuoteParams) (*Quote, error) {
+	data, err := s.client.post(ctx, "/crm/quotes", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote Quote
+	if err := s.client.decode(data, &quote); err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// Get retrieves a quote by ID
+func (s *QuotesService) Get(ctx context.Context, quoteID string) (*Quote, error) {
+	data, err := s.client.get(ctx, "/crm/quotes/"+quoteID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote Quote
+	if err := s.client.decode(data, &quote); err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// Update updates a draft quote
+func (s *QuotesService) Update(ctx context.Context, quoteID string, params *UpdateQuoteParams) (*Quote, error) {
+	data, err := s.client.patch(ctx, "/crm/quotes/"+quoteID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote Quote
+	if err := s.client.decode(data, &quote); err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// ListForDeal retrieves all quotes attached to a deal
+func (s *QuotesService) ListForDeal(ctx context.Context, dealID string) ([]Quote, error) {
+	data, err := s.client.get(ctx, "/crm/deals/"+dealID+"/quotes", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quotes []Quote
+	if err := s.client.decode(data, &quotes); err != nil {
+		return nil, err
+	}
+
+	return quotes, nil
+}
+
+// GeneratePDF renders the quote as a PDF and returns a download link
+func (s *QuotesService) GeneratePDF(ctx context.Context, quoteID string) (*QuoteDocument, error) {
+	data, err := s.client.post(ctx, "/crm/quotes/"+quoteID+"/pdf", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc QuoteDocument
+	if err := s.client.decode(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// SendForSignature sends the quote to a signer for e-signature
+func (s *QuotesService) SendForSignature(ctx context.Context, quoteID string, params *SendForSignatureParams) (*Quote, error) {
+	data, err := s.client.post(ctx, "/crm/quotes/"+quoteID+"/signature", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote Quote
+	if err := s.client.decode(data, &quote); err != nil {
+		return nil, err
+	}
+
+	return &quote, nil
+}
+
+// SignatureStatus retrieves the current e-signature status of a quote
+func (s *QuotesService) SignatureStatus(ctx context.Context, quoteID string) (*QuoteSignature, error) {
+	data, err := s.client.get(ctx, "/crm/quotes/"+quoteID+"/signature", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature QuoteSignature
+	if err := s.client.decode(data, &signature); err != nil {
+		return nil, err
+	}
+
+	return &signature, nil
+}
+
+// Delete deletes a draft quote
+func (s *QuotesService) Delete(ctx context.Context, quoteID string) error {
+	return s.client.delete(ctx, "/crm/quotes/"+quoteID, nil)
+}
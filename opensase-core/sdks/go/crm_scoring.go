@@ -0,0 +1,175 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// CRM Lead Scoring
+// =============================================================================
+
+// ScoringService provides access to lead scoring APIs
+type ScoringService struct {
+	client *Client
+}
+
+// ScoringRule represents a lead scoring rule
+type ScoringRule struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Enabled   bool              `json:"enabled"`
+	Weight    int               `json:"weight"`
+	Attribute *AttributeScoring `json:"attribute,omitempty"`
+	Activity  *ActivityScoring  `json:"activity,omitempty"`
+	Decay     *ScoreDecay       `json:"decay,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// AttributeScoring matches a contact field against a value
+type AttributeScoring struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// ActivityScoring matches contact activities such as email opens or page views
+type ActivityScoring struct {
+	ActivityType string `json:"activity_type"`
+	MinCount     int    `json:"min_count,omitempty"`
+	WithinDays   int    `json:"within_days,omitempty"`
+	MaxPoints    int    `json:"max_points,omitempty"`
+}
+
+// ScoreDecay reduces the points a rule contributes as the triggering activity ages
+type ScoreDecay struct {
+	HalfLifeDays int `json:"half_life_days"`
+	FloorPoints  int `json:"floor_points,omitempty"`
+}
+
+// CreateScoringRuleParams contains parameters for creating a scoring rule
+type CreateScoringRuleParams struct {
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Weight    int               `json:"weight"`
+	Enabled   *bool             `json:"enabled,omitempty"`
+	Attribute *AttributeScoring `json:"attribute,omitempty"`
+	Activity  *ActivityScoring  `json:"activity,omitempty"`
+	Decay     *ScoreDecay       `json:"decay,omitempty"`
+}
+
+// UpdateScoringRuleParams contains parameters for updating a scoring rule
+type UpdateScoringRuleParams struct {
+	Name      *string           `json:"name,omitempty"`
+	Weight    *int              `json:"weight,omitempty"`
+	Enabled   *bool             `json:"enabled,omitempty"`
+	Attribute *AttributeScoring `json:"attribute,omitempty"`
+	Activity  *ActivityScoring  `json:"activity,omitempty"`
+	Decay     *ScoreDecay       `json:"decay,omitempty"`
+}
+
+// ScoreBreakdown explains how a contact's lead score was computed
+type ScoreBreakdown struct {
+	ContactID    string               `json:"contact_id"`
+	Score        int                  `json:"score"`
+	Contributors []ScoreContributor   `json:"contributors"`
+	CalculatedAt time.Time            `json:"calculated_at"`
+	History      []ScoreHistoryRecord `json:"history,omitempty"`
+}
+
+// ScoreContributor is the contribution of a single rule to a score
+type ScoreContributor struct {
+	RuleID       string     `json:"rule_id"`
+	RuleName     string     `json:"rule_name"`
+	Points       int        `json:"points"`
+	DecayedFrom  int        `json:"decayed_from,omitempty"`
+	LastActivity *time.Time `json:"last_activity,omitempty"`
+}
+
+// ScoreHistoryRecord is a previous score value for a contact
+type ScoreHistoryRecord struct {
+	Score        int       `json:"score"`
+	CalculatedAt time.Time `json:"calculated_at"`
+}
+
+// ListRules retrieves all scoring rules
+func (s *ScoringService) ListRules(ctx context.Context) ([]ScoringRule, error) {
+	data, err := s.client.get(ctx, "/crm/scoring/rules", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []ScoringRule
+	if err := s.client.decode(data, &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// CreateRule creates a new scoring rule
+func (s *ScoringService) CreateRule(ctx context.Context, params *CreateScoringRuleParams) (*ScoringRule, error) {
+	data, err := s.client.post(ctx, "/crm/scoring/rules", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule ScoringRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// UpdateRule updates a scoring rule
+func (s *ScoringService) UpdateRule(ctx context.Context, ruleID string, params *UpdateScoringRuleParams) (*ScoringRule, error) {
+	data, err := s.client.patch(ctx, "/crm/scoring/rules/"+ruleID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule ScoringRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// DeleteRule deletes a scoring rule
+func (s *ScoringService) DeleteRule(ctx context.Context, ruleID string) error {
+	return s.client.delete(ctx, "/crm/scoring/rules/"+ruleID, nil)
+}
+
+// GetBreakdown retrieves the score breakdown for a contact
+func (s *ScoringService) GetBreakdown(ctx context.Context, contactID string) (*ScoreBreakdown, error) {
+	data, err := s.client.get(ctx, "/crm/contacts/"+contactID+"/score", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var breakdown ScoreBreakdown
+	if err := s.client.decode(data, &breakdown); err != nil {
+		return nil, err
+	}
+
+	return &breakdown, nil
+}
+
+// Recalculate recomputes a contact's score against the current rules
+func (s *ScoringService) Recalculate(ctx context.Context, contactID string) (*ScoreBreakdown, error) {
+	data, err := s.client.post(ctx, "/crm/contacts/"+contactID+"/score/recalculate", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var breakdown ScoreBreakdown
+	if err := s.client.decode(data, &breakdown); err != nil {
+		return nil, err
+	}
+
+	return &breakdown, nil
+}
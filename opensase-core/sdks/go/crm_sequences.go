@@ -0,0 +1,260 @@
+package opensase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// CRM Sequences
+// =============================================================================
+
+// Sequence webhook event types
+const (
+	EventSequenceEnrollmentCreated   = "crm.sequence.enrollment.created"
+	EventSequenceEnrollmentCompleted = "crm.sequence.enrollment.completed"
+	EventSequenceEnrollmentExited    = "crm.sequence.enrollment.exited"
+	EventSequenceStepCompleted       = "crm.sequence.step.completed"
+)
+
+// SequencesService provides access to email sequence APIs
+type SequencesService struct {
+	client *Client
+}
+
+// Sequence represents an automated email sequence
+type Sequence struct {
+	ID                string         `json:"id"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description,omitempty"`
+	Status            string         `json:"status"`
+	Steps             []SequenceStep `json:"steps"`
+	SenderID          string         `json:"sender_id,omitempty"`
+	ActiveEnrollments int            `json:"active_enrollments"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+// SequenceStep represents a single step of a sequence
+type SequenceStep struct {
+	ID         string `json:"id"`
+	Position   int    `json:"position"`
+	Type       string `json:"type"`
+	Subject    string `json:"subject,omitempty"`
+	TemplateID string `json:"template_id,omitempty"`
+	DelayHours int    `json:"delay_hours"`
+}
+
+// SequenceEnrollment represents a contact enrolled in a sequence
+type SequenceEnrollment struct {
+	ID          string               `json:"id"`
+	SequenceID  string               `json:"sequence_id"`
+	ContactID   string               `json:"contact_id"`
+	Status      string               `json:"status"`
+	CurrentStep int                  `json:"current_step"`
+	Steps       []EnrollmentStepInfo `json:"steps,omitempty"`
+	ExitReason  string               `json:"exit_reason,omitempty"`
+	EnrolledAt  time.Time            `json:"enrolled_at"`
+	CompletedAt *time.Time           `json:"completed_at,omitempty"`
+}
+
+// EnrollmentStepInfo contains the delivery status of a sequence step for one enrollment
+type EnrollmentStepInfo struct {
+	StepID      string     `json:"step_id"`
+	Position    int        `json:"position"`
+	Status      string     `json:"status"`
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	OpenedAt    *time.Time `json:"opened_at,omitempty"`
+	RepliedAt   *time.Time `json:"replied_at,omitempty"`
+}
+
+// ListSequencesParams contains parameters for listing sequences
+type ListSequencesParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Search  *string `json:"search,omitempty"`
+}
+
+// SequenceListResponse contains a list of sequences with pagination
+type SequenceListResponse struct {
+	Data       []Sequence `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// EnrollContactsParams contains parameters for enrolling contacts in a sequence
+type EnrollContactsParams struct {
+	ContactIDs []string `json:"contact_ids"`
+	SenderID   string   `json:"sender_id,omitempty"`
+	StartAt    *string  `json:"start_at,omitempty"`
+	StartStep  int      `json:"start_step,omitempty"`
+}
+
+// ListEnrollmentsParams contains parameters for listing sequence enrollments
+type ListEnrollmentsParams struct {
+	Page      int     `json:"page,omitempty"`
+	PerPage   int     `json:"per_page,omitempty"`
+	Status    *string `json:"status,omitempty"`
+	ContactID *string `json:"contact_id,omitempty"`
+}
+
+// EnrollmentListResponse contains a list of enrollments with pagination
+type EnrollmentListResponse struct {
+	Data       []SequenceEnrollment `json:"data"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+// List retrieves all sequences with pagination
+func (s *SequencesService) List(ctx context.Context, params *ListSequencesParams) (*SequenceListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+		if params.Search != nil {
+			v.Set("search", *params.Search)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/crm/sequences", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SequenceListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves a sequence by ID
+func (s *SequencesService) Get(ctx context.Context, sequenceID string) (*Sequence, error) {
+	data, err := s.client.get(ctx, "/crm/sequences/"+sequenceID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sequence Sequence
+	if err := s.client.decode(data, &sequence); err != nil {
+		return nil, err
+	}
+
+	return &sequence, nil
+}
+
+// Enroll enrolls one or more contacts in a sequence
+func (s *SequencesService) Enroll(ctx context.Context, sequenceID string, params *EnrollContactsParams, opts *RequestOptions) ([]SequenceEnrollment, error) {
+	data, err := s.client.post(ctx, "/crm/sequences/"+sequenceID+"/enrollments", params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollments []SequenceEnrollment
+	if err := s.client.decode(data, &enrollments); err != nil {
+		return nil, err
+	}
+
+	return enrollments, nil
+}
+
+// Unenroll removes a contact from a sequence before it completes
+func (s *SequencesService) Unenroll(ctx context.Context, sequenceID, contactID string, reason *string) error {
+	params := map[string]interface{}{
+		"contact_id": contactID,
+	}
+	if reason != nil {
+		params["reason"] = *reason
+	}
+
+	_, err := s.client.post(ctx, "/crm/sequences/"+sequenceID+"/unenroll", params, nil)
+	return err
+}
+
+// ListEnrollments retrieves the enrollments of a sequence
+func (s *SequencesService) ListEnrollments(ctx context.Context, sequenceID string, params *ListEnrollmentsParams) (*EnrollmentListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+		if params.ContactID != nil {
+			v.Set("contact_id", *params.ContactID)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/crm/sequences/"+sequenceID+"/enrollments", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response EnrollmentListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetEnrollment retrieves an enrollment including per-step status
+func (s *SequencesService) GetEnrollment(ctx context.Context, sequenceID, enrollmentID string) (*SequenceEnrollment, error) {
+	data, err := s.client.get(ctx, "/crm/sequences/"+sequenceID+"/enrollments/"+enrollmentID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollment SequenceEnrollment
+	if err := s.client.decode(data, &enrollment); err != nil {
+		return nil, err
+	}
+
+	return &enrollment, nil
+}
+
+// SequenceEnrollmentFromEvent extracts the enrollment carried by a sequence webhook event
+func SequenceEnrollmentFromEvent(event *WebhookEvent) (*SequenceEnrollment, error) {
+	switch event.Type {
+	case EventSequenceEnrollmentCreated, EventSequenceEnrollmentCompleted, EventSequenceEnrollmentExited, EventSequenceStepCompleted:
+	default:
+		return nil, fmt.Errorf("unexpected event type %q", event.Type)
+	}
+
+	var enrollment SequenceEnrollment
+	if err := decodeEventObject(event, &enrollment); err != nil {
+		return nil, err
+	}
+
+	return &enrollment, nil
+}
+
+// decodeEventObject decodes the object carried in a webhook event's data
+func decodeEventObject(event *WebhookEvent, v interface{}) error {
+	var obj interface{} = event.Data
+	if inner, ok := event.Data["object"]; ok {
+		obj = inner
+	}
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,200 @@
+// Package enum defines typed values for the SDK fields that take one of a
+// fixed set of strings, so that a misspelt value is caught by the compiler
+// or by IsValid instead of being sent to the API.
+//
+// Each type has constants for its values, a function listing them all, and
+// IsValid and String methods. Values decoded from API responses are not
+// checked: a value added to the API after this SDK was released decodes
+// normally and reports IsValid false.
+//
+//	site, err := client.Network.Sites.Get(ctx, id)
+//	if err != nil {
+//	    return err
+//	}
+//	if site.Status == enum.SiteStatusOffline {
+//	    ...
+//	}
+//
+//	action := enum.PolicyAction(flagAction)
+//	if !action.IsValid() {
+//	    return fmt.Errorf("action must be one of %v", enum.PolicyActions())
+//	}
+package enum
+
+// Strings converts values of any enum type to plain strings, e.g. for
+// validation.StringInSlice in the Terraform provider
+func Strings[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func contains[T comparable](values []T, v T) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// =============================================================================
+// Network
+// =============================================================================
+
+// SiteStatus is the lifecycle and health state of a site
+type SiteStatus string
+
+// Site statuses
+const (
+	SiteStatusPending        SiteStatus = "pending"
+	SiteStatusProvisioning   SiteStatus = "provisioning"
+	SiteStatusOnline         SiteStatus = "online"
+	SiteStatusDegraded       SiteStatus = "degraded"
+	SiteStatusOffline        SiteStatus = "offline"
+	SiteStatusError          SiteStatus = "error"
+	SiteStatusDecommissioned SiteStatus = "decommissioned" // taken out of service by Sites.Decommission; can be deleted
+)
+
+// SiteStatuses returns all site statuses
+func SiteStatuses() []SiteStatus {
+	return []SiteStatus{
+		SiteStatusPending, SiteStatusProvisioning, SiteStatusOnline, SiteStatusDegraded,
+		SiteStatusOffline, SiteStatusError, SiteStatusDecommissioned,
+	}
+}
+
+// IsValid reports whether s is a known site status
+func (s SiteStatus) IsValid() bool { return contains(SiteStatuses(), s) }
+
+func (s SiteStatus) String() string { return string(s) }
+
+// WANLinkType is the kind of transport behind a WAN link
+type WANLinkType string
+
+// WAN link types
+const (
+	WANLinkTypeBroadband WANLinkType = "broadband"
+	WANLinkTypeMPLS      WANLinkType = "mpls"
+	WANLinkTypeLTE       WANLinkType = "lte"
+	WANLinkTypeSatellite WANLinkType = "satellite"
+)
+
+// WANLinkTypes returns all WAN link types
+func WANLinkTypes() []WANLinkType {
+	return []WANLinkType{WANLinkTypeBroadband, WANLinkTypeMPLS, WANLinkTypeLTE, WANLinkTypeSatellite}
+}
+
+// IsValid reports whether t is a known WAN link type
+func (t WANLinkType) IsValid() bool { return contains(WANLinkTypes(), t) }
+
+func (t WANLinkType) String() string { return string(t) }
+
+// WANLinkStatus is the operational state of a WAN link
+type WANLinkStatus string
+
+// WAN link statuses
+const (
+	WANLinkStatusUp       WANLinkStatus = "up"
+	WANLinkStatusDown     WANLinkStatus = "down"
+	WANLinkStatusDegraded WANLinkStatus = "degraded"
+	WANLinkStatusUnknown  WANLinkStatus = "unknown"
+)
+
+// WANLinkStatuses returns all WAN link statuses
+func WANLinkStatuses() []WANLinkStatus {
+	return []WANLinkStatus{WANLinkStatusUp, WANLinkStatusDown, WANLinkStatusDegraded, WANLinkStatusUnknown}
+}
+
+// IsValid reports whether s is a known WAN link status
+func (s WANLinkStatus) IsValid() bool { return contains(WANLinkStatuses(), s) }
+
+func (s WANLinkStatus) String() string { return string(s) }
+
+// =============================================================================
+// Security
+// =============================================================================
+
+// PolicyAction is what a security policy or firewall rule does with
+// matching traffic
+type PolicyAction string
+
+// Policy actions
+const (
+	PolicyActionAllow   PolicyAction = "allow"
+	PolicyActionDeny    PolicyAction = "deny"
+	PolicyActionInspect PolicyAction = "inspect"
+)
+
+// PolicyActions returns all policy actions
+func PolicyActions() []PolicyAction {
+	return []PolicyAction{PolicyActionAllow, PolicyActionDeny, PolicyActionInspect}
+}
+
+// IsValid reports whether a is a known policy action
+func (a PolicyAction) IsValid() bool { return contains(PolicyActions(), a) }
+
+func (a PolicyAction) String() string { return string(a) }
+
+// =============================================================================
+// Payments
+// =============================================================================
+
+// SubscriptionStatus is the billing state of a subscription
+type SubscriptionStatus string
+
+// Subscription statuses
+const (
+	SubscriptionStatusIncomplete        SubscriptionStatus = "incomplete"
+	SubscriptionStatusIncompleteExpired SubscriptionStatus = "incomplete_expired"
+	SubscriptionStatusTrialing          SubscriptionStatus = "trialing"
+	SubscriptionStatusActive            SubscriptionStatus = "active"
+	SubscriptionStatusPastDue           SubscriptionStatus = "past_due"
+	SubscriptionStatusUnpaid            SubscriptionStatus = "unpaid"
+	SubscriptionStatusPaused            SubscriptionStatus = "paused"
+	SubscriptionStatusCanceled          SubscriptionStatus = "canceled"
+)
+
+// SubscriptionStatuses returns all subscription statuses
+func SubscriptionStatuses() []SubscriptionStatus {
+	return []SubscriptionStatus{
+		SubscriptionStatusIncomplete, SubscriptionStatusIncompleteExpired, SubscriptionStatusTrialing,
+		SubscriptionStatusActive, SubscriptionStatusPastDue, SubscriptionStatusUnpaid,
+		SubscriptionStatusPaused, SubscriptionStatusCanceled,
+	}
+}
+
+// IsValid reports whether s is a known subscription status
+func (s SubscriptionStatus) IsValid() bool { return contains(SubscriptionStatuses(), s) }
+
+func (s SubscriptionStatus) String() string { return string(s) }
+
+// PaymentIntentStatus is the state of a payment intent
+type PaymentIntentStatus string
+
+// Payment intent statuses
+const (
+	PaymentIntentStatusRequiresPaymentMethod PaymentIntentStatus = "requires_payment_method"
+	PaymentIntentStatusRequiresConfirmation  PaymentIntentStatus = "requires_confirmation"
+	PaymentIntentStatusRequiresAction        PaymentIntentStatus = "requires_action"
+	PaymentIntentStatusProcessing            PaymentIntentStatus = "processing"
+	PaymentIntentStatusRequiresCapture       PaymentIntentStatus = "requires_capture"
+	PaymentIntentStatusSucceeded             PaymentIntentStatus = "succeeded"
+	PaymentIntentStatusCanceled              PaymentIntentStatus = "canceled"
+)
+
+// PaymentIntentStatuses returns all payment intent statuses
+func PaymentIntentStatuses() []PaymentIntentStatus {
+	return []PaymentIntentStatus{
+		PaymentIntentStatusRequiresPaymentMethod, PaymentIntentStatusRequiresConfirmation,
+		PaymentIntentStatusRequiresAction, PaymentIntentStatusProcessing,
+		PaymentIntentStatusRequiresCapture, PaymentIntentStatusSucceeded, PaymentIntentStatusCanceled,
+	}
+}
+
+// IsValid reports whether s is a known payment intent status
+func (s PaymentIntentStatus) IsValid() bool { return contains(PaymentIntentStatuses(), s) }
+
+func (s PaymentIntentStatus) String() string { return string(s) }
@@ -0,0 +1,215 @@
+package opensase
+
+import (
+	"fmt"
+	"sync"
+)
+
+// =============================================================================
+// Error Code Catalog
+// =============================================================================
+
+// ErrorCode is a machine-readable API error code
+type ErrorCode string
+
+// API error codes
+const (
+	ErrCodeValidation          ErrorCode = "validation_error"
+	ErrCodeInvalidFormat       ErrorCode = "invalid_format"
+	ErrCodeRequired            ErrorCode = "required"
+	ErrCodeUnauthorized        ErrorCode = "unauthorized"
+	ErrCodeTokenExpired        ErrorCode = "token_expired"
+	ErrCodeMFARequired         ErrorCode = "mfa_required"
+	ErrCodeForbidden           ErrorCode = "forbidden"
+	ErrCodeNotFound            ErrorCode = "not_found"
+	ErrCodeConflict            ErrorCode = "conflict"
+	ErrCodeIdempotencyConflict ErrorCode = "idempotency_conflict"
+	ErrCodeQuotaExceeded       ErrorCode = "quota_exceeded"
+	ErrCodeRateLimitExceeded   ErrorCode = "rate_limit_exceeded"
+	ErrCodeCardDeclined        ErrorCode = "card_declined"
+	ErrCodePaymentFailed       ErrorCode = "payment_failed"
+	ErrCodeInternal            ErrorCode = "internal_error"
+	ErrCodeServiceUnavailable  ErrorCode = "service_unavailable"
+	ErrCodeUnknown             ErrorCode = "unknown_error"
+)
+
+// DefaultLocale is the locale used when no translation is registered
+const DefaultLocale = "en"
+
+// ErrorMessage is the human-readable description and remediation for an error code
+type ErrorMessage struct {
+	Message string
+	Hint    string
+}
+
+var (
+	errorCatalogMu sync.RWMutex
+	errorCatalog   = map[string]map[ErrorCode]ErrorMessage{
+		DefaultLocale: {
+			ErrCodeValidation: {
+				Message: "The request contains invalid fields.",
+				Hint:    "Check Error.Details for the offending fields and correct the request before retrying.",
+			},
+			ErrCodeInvalidFormat: {
+				Message: "A field has an invalid format.",
+				Hint:    "Check the field against the format documented in the API reference.",
+			},
+			ErrCodeRequired: {
+				Message: "A required field is missing.",
+				Hint:    "Supply every required field listed in Error.Details.",
+			},
+			ErrCodeUnauthorized: {
+				Message: "The request is not authenticated.",
+				Hint:    "Verify the API key or access token is set and has not been revoked.",
+			},
+			ErrCodeTokenExpired: {
+				Message: "The access token has expired.",
+				Hint:    "Refresh the token with Identity.Auth.Refresh and retry the request.",
+			},
+			ErrCodeMFARequired: {
+				Message: "Multi-factor authentication is required.",
+				Hint:    "Complete the MFA challenge with Identity.Auth.VerifyMFA.",
+			},
+			ErrCodeForbidden: {
+				Message: "You do not have permission to perform this action.",
+				Hint:    "Ask a tenant administrator to grant the required role or API key scope.",
+			},
+			ErrCodeNotFound: {
+				Message: "The resource does not exist.",
+				Hint:    "Check the ID; the resource may have been deleted or belong to another tenant.",
+			},
+			ErrCodeConflict: {
+				Message: "A resource with this identifier already exists.",
+				Hint:    "Fetch the existing resource and update it instead of creating a new one.",
+			},
+			ErrCodeIdempotencyConflict: {
+				Message: "The idempotency key was reused with different parameters.",
+				Hint:    "Generate a new idempotency key for each distinct request.",
+			},
+			ErrCodeQuotaExceeded: {
+				Message: "A tenant object limit has been reached.",
+				Hint:    "Delete unused objects or contact support to raise the tenant quota.",
+			},
+			ErrCodeRateLimitExceeded: {
+				Message: "Too many requests.",
+				Hint:    "Back off and retry after the Retry-After interval; spread bulk work over time or lower concurrency.",
+			},
+			ErrCodeCardDeclined: {
+				Message: "The card was declined.",
+				Hint:    "Ask the customer to use a different payment method.",
+			},
+			ErrCodePaymentFailed: {
+				Message: "The payment could not be completed.",
+				Hint:    "Inspect the payment intent's last error and retry with a new payment method if needed.",
+			},
+			ErrCodeInternal: {
+				Message: "An internal error occurred.",
+				Hint:    "Retry with exponential backoff; if it persists, contact support with the request ID.",
+			},
+			ErrCodeServiceUnavailable: {
+				Message: "The service is temporarily unavailable.",
+				Hint:    "Retry with exponential backoff and check the platform status page.",
+			},
+			ErrCodeUnknown: {
+				Message: "The API returned an unexpected response.",
+				Hint:    "Contact support with the request ID.",
+			},
+		},
+	}
+)
+
+// RegisterErrorMessages adds or replaces translations of error messages for a locale
+func RegisterErrorMessages(locale string, messages map[ErrorCode]ErrorMessage) {
+	errorCatalogMu.Lock()
+	defer errorCatalogMu.Unlock()
+
+	if errorCatalog[locale] == nil {
+		errorCatalog[locale] = make(map[ErrorCode]ErrorMessage, len(messages))
+	}
+	for code, msg := range messages {
+		errorCatalog[locale][code] = msg
+	}
+}
+
+// LookupErrorMessage returns the catalog entry for a code, falling back to DefaultLocale
+func LookupErrorMessage(code ErrorCode, locale string) (ErrorMessage, bool) {
+	errorCatalogMu.RLock()
+	defer errorCatalogMu.RUnlock()
+
+	if msg, ok := errorCatalog[locale][code]; ok {
+		return msg, true
+	}
+	msg, ok := errorCatalog[DefaultLocale][code]
+	return msg, ok
+}
+
+// ErrorCodes returns every code in the default catalog
+func ErrorCodes() []ErrorCode {
+	errorCatalogMu.RLock()
+	defer errorCatalogMu.RUnlock()
+
+	codes := make([]ErrorCode, 0, len(errorCatalog[DefaultLocale]))
+	for code := range errorCatalog[DefaultLocale] {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// ErrorCode returns the typed error code
+func (e *Error) ErrorCode() ErrorCode {
+	return ErrorCode(e.Code)
+}
+
+// LocalizedMessage returns a human-readable message for the error in the given locale
+func (e *Error) LocalizedMessage(locale string) string {
+	if msg, ok := LookupErrorMessage(e.ErrorCode(), locale); ok {
+		return msg.Message
+	}
+	return e.Message
+}
+
+// Hint suggests how to remediate the error
+func (e *Error) Hint() string {
+	return e.LocalizedHint(DefaultLocale)
+}
+
+// LocalizedHint suggests how to remediate the error in the given locale
+func (e *Error) LocalizedHint(locale string) string {
+	if msg, ok := LookupErrorMessage(e.ErrorCode(), locale); ok {
+		return msg.Hint
+	}
+	if msg, ok := LookupErrorMessage(statusErrorCode(e.StatusCode), locale); ok {
+		return msg.Hint
+	}
+	return ""
+}
+
+// Hint suggests how to remediate the error, including the server's retry interval
+func (e *RateLimitError) Hint() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("Back off and retry after %d seconds; spread bulk work over time or lower concurrency.", e.RetryAfter)
+	}
+	return e.Err.Hint()
+}
+
+func statusErrorCode(statusCode int) ErrorCode {
+	switch {
+	case statusCode == 400:
+		return ErrCodeValidation
+	case statusCode == 401:
+		return ErrCodeUnauthorized
+	case statusCode == 403:
+		return ErrCodeForbidden
+	case statusCode == 404:
+		return ErrCodeNotFound
+	case statusCode == 409:
+		return ErrCodeConflict
+	case statusCode == 429:
+		return ErrCodeRateLimitExceeded
+	case statusCode == 503:
+		return ErrCodeServiceUnavailable
+	case statusCode >= 500:
+		return ErrCodeInternal
+	}
+	return ErrCodeUnknown
+}
@@ -0,0 +1,91 @@
+// Package events provides typed OpenSASE webhook events.
+//
+// Every event type published by the platform is declared in catalog.json and
+// generated into a constant and a payload struct. Parse returns one of those
+// structs, so callers can switch on the concrete type:
+//
+//	event, err := events.Parse(payload)
+//	switch e := event.(type) {
+//	case *events.PaymentIntentSucceeded:
+//	    fulfil(e.Object.ID)
+//	case *events.Unknown:
+//	    log.Printf("unhandled event %s", e.Type)
+//	}
+//
+// Implementing Handler and calling Dispatch makes handling exhaustive: a new
+// event in the catalog will not compile until the handler supports it.
+package events
+
+//go:generate go run gen.go
+
+import (
+	"encoding/json"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Event is implemented by every typed webhook event
+type Event interface {
+	EventID() string
+	EventType() string
+	isEvent()
+}
+
+// Envelope contains the fields shared by all webhook events
+type Envelope struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	APIVersion string `json:"api_version"`
+	Created    int64  `json:"created"`
+	Livemode   bool   `json:"livemode"`
+}
+
+// EventID returns the unique event ID
+func (e Envelope) EventID() string { return e.ID }
+
+// EventType returns the event type
+func (e Envelope) EventType() string { return e.Type }
+
+// Unknown is returned for event types not in the catalog, typically because
+// the platform is newer than the SDK
+type Unknown struct {
+	Envelope
+	Object json.RawMessage
+}
+
+func (*Unknown) isEvent() {}
+
+// Parse decodes a raw webhook payload into a typed event. The payload should
+// already have been verified, e.g. with opensase.VerifyWebhookSignature.
+func Parse(raw []byte) (Event, error) {
+	var wire struct {
+		Envelope
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &wire); err != nil {
+		return nil, err
+	}
+	if wire.Type == "" {
+		return nil, fmt.Errorf("events: payload has no event type")
+	}
+
+	object := wire.Data
+	var data struct {
+		Object json.RawMessage `json:"object"`
+	}
+	if err := json.Unmarshal(wire.Data, &data); err == nil && data.Object != nil {
+		object = data.Object
+	}
+
+	return decode(wire.Envelope, object)
+}
+
+// FromWebhookEvent converts an event returned by opensase.ConstructWebhookEvent
+func FromWebhookEvent(event *opensase.WebhookEvent) (Event, error) {
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(raw)
+}
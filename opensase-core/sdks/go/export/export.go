@@ -0,0 +1,244 @@
+// Package export keeps data warehouse exports of OpenSASE data in sync.
+//
+// Jobs are declared in code and applied idempotently by name. The platform
+// writes Parquet files to the destination bucket under
+//
+//	<prefix>/<dataset>/v<schema version>/<partition>=<value>/part-*.parquet
+//
+// so each schema version is a separate external table in BigQuery or
+// Snowflake. Exporter.Run exports incrementally from the job's watermark and
+// falls back to a full export when there is no watermark yet or the
+// dataset's schema has changed.
+//
+//	ex := export.New(client)
+//	if err := ex.Apply(ctx, []export.Job{{
+//	    Name:        "contacts-to-lake",
+//	    Dataset:     opensase.ExportDatasetContacts,
+//	    Destination: opensase.ExportDestination{Type: opensase.ExportDestinationS3, Bucket: "acme-lake", RoleARN: role},
+//	    Schedule:    "0 * * * *",
+//	}}); err != nil {
+//	    return err
+//	}
+//	run, err := ex.Run(ctx, "contacts-to-lake", export.ModeAuto)
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Mode selects how Run exports a dataset
+type Mode int
+
+const (
+	// ModeAuto runs incrementally when possible and fully otherwise
+	ModeAuto Mode = iota
+	// ModeFull re-exports the whole dataset
+	ModeFull
+	// ModeIncremental exports changes since the watermark, failing if a
+	// full export is required first
+	ModeIncremental
+)
+
+// ErrFullExportRequired is returned by Run in ModeIncremental when the job
+// has no watermark or its schema is out of date
+var ErrFullExportRequired = errors.New("export: full export required")
+
+// Job is the desired configuration of an export job
+type Job struct {
+	Name        string
+	Dataset     string
+	Destination opensase.ExportDestination
+	// Partitioning defaults to daily partitions
+	Partitioning opensase.ExportPartitioning
+	// Schedule is a cron expression for server-run incremental exports
+	Schedule string
+	Disabled bool
+}
+
+// Option configures an Exporter
+type Option func(*Exporter)
+
+// WithPollInterval sets how often Run polls a run's status
+func WithPollInterval(d time.Duration) Option {
+	return func(e *Exporter) {
+		e.pollInterval = d
+	}
+}
+
+// Exporter applies export jobs and runs them
+type Exporter struct {
+	exports      *opensase.ExportsService
+	pollInterval time.Duration
+}
+
+// New returns an Exporter using client
+func New(client *opensase.Client, opts ...Option) *Exporter {
+	e := &Exporter{
+		exports:      client.Exports,
+		pollInterval: 10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Apply creates missing jobs and updates changed ones, matching by name.
+// Jobs not in the list are left alone. A job's dataset cannot be changed;
+// delete it and apply it under a new name instead.
+func (e *Exporter) Apply(ctx context.Context, jobs []Job) error {
+	existing, err := e.jobsByName(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		partitioning := job.Partitioning
+		if partitioning.Granularity == "" {
+			partitioning.Granularity = "day"
+		}
+
+		current, ok := existing[job.Name]
+		if !ok {
+			_, err := e.exports.CreateJob(ctx, &opensase.CreateExportJobParams{
+				Name:         job.Name,
+				Dataset:      job.Dataset,
+				Format:       "parquet",
+				Destination:  job.Destination,
+				Partitioning: partitioning,
+				Schedule:     job.Schedule,
+				Enabled:      opensase.Bool(!job.Disabled),
+			})
+			if err != nil {
+				return fmt.Errorf("export: creating job %q: %w", job.Name, err)
+			}
+			continue
+		}
+
+		if current.Dataset != job.Dataset {
+			return fmt.Errorf("export: job %q exports %s, not %s", job.Name, current.Dataset, job.Dataset)
+		}
+
+		params := &opensase.UpdateExportJobParams{}
+		changed := false
+		if !reflect.DeepEqual(current.Destination, job.Destination) {
+			params.Destination = &job.Destination
+			changed = true
+		}
+		if current.Partitioning != partitioning {
+			params.Partitioning = &partitioning
+			changed = true
+		}
+		if current.Schedule != job.Schedule {
+			params.Schedule = opensase.String(job.Schedule)
+			changed = true
+		}
+		if current.Enabled == job.Disabled {
+			params.Enabled = opensase.Bool(!job.Disabled)
+			changed = true
+		}
+		if changed {
+			if _, err := e.exports.UpdateJob(ctx, current.ID, params); err != nil {
+				return fmt.Errorf("export: updating job %q: %w", job.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Run exports the named job and waits for the run to finish. A run that
+// fails is returned along with an error describing the failure.
+func (e *Exporter) Run(ctx context.Context, name string, mode Mode) (*opensase.ExportRun, error) {
+	jobs, err := e.jobsByName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	job, ok := jobs[name]
+	if !ok {
+		return nil, fmt.Errorf("export: no job named %q", name)
+	}
+
+	schema, err := e.exports.Schema(ctx, job.Dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new schema version starts a new table, which must be backfilled
+	full := mode == ModeFull || job.Watermark == "" || schema.Version != job.SchemaVersion
+	if full && mode == ModeIncremental {
+		return nil, ErrFullExportRequired
+	}
+
+	params := &opensase.StartExportRunParams{
+		Mode:          opensase.ExportModeIncremental,
+		SchemaVersion: schema.Version,
+	}
+	if full {
+		params.Mode = opensase.ExportModeFull
+	}
+	run, err := e.exports.StartRun(ctx, job.ID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err = e.Wait(ctx, run)
+	if err != nil {
+		return run, err
+	}
+
+	if schema.Version != job.SchemaVersion {
+		// Scheduled runs write the new version from now on
+		if _, err := e.exports.UpdateJob(ctx, job.ID, &opensase.UpdateExportJobParams{
+			SchemaVersion: opensase.Int(schema.Version),
+		}); err != nil {
+			return run, fmt.Errorf("export: recording schema version %d for %q: %w", schema.Version, name, err)
+		}
+	}
+	return run, nil
+}
+
+// Wait polls a run until it finishes
+func (e *Exporter) Wait(ctx context.Context, run *opensase.ExportRun) (*opensase.ExportRun, error) {
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+
+	for !run.Done() {
+		select {
+		case <-ctx.Done():
+			return run, ctx.Err()
+		case <-ticker.C:
+		}
+
+		next, err := e.exports.GetRun(ctx, run.JobID, run.ID)
+		if err != nil {
+			return run, err
+		}
+		run = next
+	}
+
+	if run.Status == opensase.ExportRunFailed {
+		if run.Error != nil {
+			return run, fmt.Errorf("export: run %s failed: %w", run.ID, run.Error)
+		}
+		return run, fmt.Errorf("export: run %s failed", run.ID)
+	}
+	return run, nil
+}
+
+func (e *Exporter) jobsByName(ctx context.Context) (map[string]*opensase.ExportJob, error) {
+	jobs, err := e.exports.ListJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*opensase.ExportJob, len(jobs))
+	for i := range jobs {
+		byName[jobs[i].Name] = &jobs[i]
+	}
+	return byName, nil
+}
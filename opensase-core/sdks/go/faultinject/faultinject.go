@@ -0,0 +1,258 @@
+// Package faultinject provides an HTTP transport that injects latency, API
+// errors, connection resets and malformed responses, for testing how
+// applications built on the SDK degrade when the API misbehaves.
+//
+//	client := opensase.NewClient(key, opensase.WithMiddleware(faultinject.New(faultinject.Config{
+//	    Seed:      42,
+//	    ErrorRate: 0.2,
+//	    ResetRate: 0.05,
+//	})))
+//
+// Faults are drawn from a seeded random source, so a given Seed and request
+// order always produce the same faults. For exact control, Script lists the
+// faults for the first requests in order.
+package faultinject
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Kind identifies a fault
+type Kind int
+
+// Fault kinds
+const (
+	None Kind = iota
+	Latency
+	Error
+	Reset
+	Malformed
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Latency:
+		return "latency"
+	case Error:
+		return "error"
+	case Reset:
+		return "reset"
+	case Malformed:
+		return "malformed"
+	default:
+		return "none"
+	}
+}
+
+// Fault is a single injected fault
+type Fault struct {
+	Kind Kind
+	// Delay is added before the request for Latency faults
+	Delay time.Duration
+	// Status is the response status for Error faults; defaults to 503
+	Status int
+}
+
+// Config controls which faults are injected. Rates are probabilities between
+// 0 and 1 and are checked in the order reset, error, malformed; latency is
+// applied independently of the other faults.
+type Config struct {
+	Seed int64
+
+	// Latency is added to LatencyRate of requests, plus up to LatencyJitter
+	Latency       time.Duration
+	LatencyJitter time.Duration
+	LatencyRate   float64
+
+	ErrorRate     float64
+	ErrorStatuses []int
+
+	ResetRate     float64
+	MalformedRate float64
+
+	// Script, if set, is consumed one fault per request before random faults apply
+	Script []Fault
+
+	// Match limits injection to matching requests; all requests when nil
+	Match func(*http.Request) bool
+}
+
+// Transport is an http.RoundTripper that injects faults
+type Transport struct {
+	next http.RoundTripper
+	cfg  Config
+
+	mu     sync.Mutex
+	rng    *rand.Rand
+	script []Fault
+	counts map[Kind]int
+}
+
+// New returns a middleware for opensase.WithMiddleware
+func New(cfg Config) opensase.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return NewTransport(next, cfg)
+	}
+}
+
+// NewTransport wraps next with fault injection
+func NewTransport(next http.RoundTripper, cfg Config) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Transport{
+		next:   next,
+		cfg:    cfg,
+		rng:    rand.New(rand.NewSource(cfg.Seed)),
+		script: append([]Fault(nil), cfg.Script...),
+		counts: make(map[Kind]int),
+	}
+}
+
+// Counts returns how many faults of each kind have been injected
+func (t *Transport) Counts() map[Kind]int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make(map[Kind]int, len(t.counts))
+	for k, v := range t.counts {
+		out[k] = v
+	}
+	return out
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cfg.Match != nil && !t.cfg.Match(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	faults := t.pick()
+	for _, f := range faults {
+		switch f.Kind {
+		case Latency:
+			if err := sleep(req.Context(), f.Delay); err != nil {
+				return nil, err
+			}
+		case Reset:
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+		case Error:
+			return errorResponse(req, f.Status), nil
+		case Malformed:
+			return t.malformed(req)
+		}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// pick decides the faults for one request
+func (t *Transport) pick() []Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var faults []Fault
+	if len(t.script) > 0 {
+		f := t.script[0]
+		t.script = t.script[1:]
+		if f.Kind != None {
+			faults = append(faults, f)
+		}
+	} else {
+		if t.cfg.LatencyRate > 0 && t.rng.Float64() < t.cfg.LatencyRate {
+			delay := t.cfg.Latency
+			if t.cfg.LatencyJitter > 0 {
+				delay += time.Duration(t.rng.Int63n(int64(t.cfg.LatencyJitter)))
+			}
+			faults = append(faults, Fault{Kind: Latency, Delay: delay})
+		}
+		switch r := t.rng.Float64(); {
+		case r < t.cfg.ResetRate:
+			faults = append(faults, Fault{Kind: Reset})
+		case r < t.cfg.ResetRate+t.cfg.ErrorRate:
+			faults = append(faults, Fault{Kind: Error, Status: t.errorStatus()})
+		case r < t.cfg.ResetRate+t.cfg.ErrorRate+t.cfg.MalformedRate:
+			faults = append(faults, Fault{Kind: Malformed})
+		}
+	}
+
+	for _, f := range faults {
+		t.counts[f.Kind]++
+	}
+	return faults
+}
+
+func (t *Transport) errorStatus() int {
+	if len(t.cfg.ErrorStatuses) == 0 {
+		return http.StatusServiceUnavailable
+	}
+	return t.cfg.ErrorStatuses[t.rng.Intn(len(t.cfg.ErrorStatuses))]
+}
+
+// malformed performs the request and truncates the response body mid-JSON
+func (t *Transport) malformed(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	body = append(body[:len(body)/2], []byte(`{"data":`)...)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+func errorResponse(req *http.Request, status int) *http.Response {
+	if status == 0 {
+		status = http.StatusServiceUnavailable
+	}
+	body := fmt.Sprintf(`{"error":{"code":"fault_injected","message":"injected %d response"}}`, status)
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Request-ID", "faultinject")
+	if status == http.StatusTooManyRequests {
+		header.Set("Retry-After", "1")
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(body))),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,410 @@
+// Package housekeeping garbage-collects stale security objects.
+//
+// An address object, service object or service group is stale when nothing
+// references it and it has not been changed for the unused period. Objects
+// still referenced, but only by rules without a hit in that period, are
+// reported for review; they cannot be deleted until the rules are.
+//
+// Stale objects are optionally tagged by prefixing their description with
+// the date they were first found stale, and deleted once the grace period
+// has passed since that date. The tag is the only state kept between runs,
+// so runs can happen anywhere and an operator removes an object from
+// collection by editing its description. Tagged objects that are referenced
+// again are untagged.
+//
+//	sw := housekeeping.New(client,
+//	    housekeeping.WithUnusedFor(90*24*time.Hour),
+//	    housekeeping.WithTagging(),
+//	    housekeeping.WithDeletion(),
+//	)
+//	report, err := sw.Plan(ctx) // dry run
+//	report, err = sw.Run(ctx)
+package housekeeping
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// Defaults used when no option overrides them
+const (
+	DefaultUnusedFor   = 90 * 24 * time.Hour
+	DefaultGracePeriod = 14 * 24 * time.Hour
+)
+
+// Object kinds
+const (
+	KindAddress      = "address"
+	KindService      = "service"
+	KindServiceGroup = "service_group"
+)
+
+// Action is what a run does, or would do, with a stale object
+type Action string
+
+const (
+	// ActionReview reports an object referenced only by idle rules
+	ActionReview Action = "review"
+	// ActionTag marks a newly stale object
+	ActionTag Action = "tag"
+	// ActionWait leaves a stale object alone until its grace period ends
+	ActionWait Action = "wait"
+	// ActionDelete deletes a stale object whose grace period has ended
+	ActionDelete Action = "delete"
+	// ActionUntag removes the tag from an object that is in use again
+	ActionUntag Action = "untag"
+)
+
+// tagPrefix starts the description of a tagged object, followed by the date
+// it was found stale and "] "
+const tagPrefix = "[stale since "
+
+const tagDateLayout = "2006-01-02"
+
+// Option configures a Sweeper
+type Option func(*Sweeper)
+
+// WithUnusedFor sets how long an object must be unused to be stale
+func WithUnusedFor(d time.Duration) Option {
+	return func(s *Sweeper) {
+		s.unusedFor = d
+	}
+}
+
+// WithGracePeriod sets how long a stale object is kept before deletion
+func WithGracePeriod(d time.Duration) Option {
+	return func(s *Sweeper) {
+		s.grace = d
+	}
+}
+
+// WithTagging tags stale objects so the grace period counts from when they
+// were first found. Without tagging it counts from the end of the unused
+// period.
+func WithTagging() Option {
+	return func(s *Sweeper) {
+		s.tag = true
+	}
+}
+
+// WithDeletion deletes stale objects once their grace period has ended.
+// Without it runs only tag and report.
+func WithDeletion() Option {
+	return func(s *Sweeper) {
+		s.delete = true
+	}
+}
+
+// Finding is a stale, idle or previously tagged object and the action taken
+// on it
+type Finding struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Action Action `json:"action"`
+	// References are the idle rules referencing an object under review
+	References []opensase.ObjectReference `json:"references,omitempty"`
+	// LastMatchedAt is the most recent hit of any referencing rule
+	LastMatchedAt *time.Time `json:"last_matched_at,omitempty"`
+	StaleSince    *time.Time `json:"stale_since,omitempty"`
+	DeleteAfter   *time.Time `json:"delete_after,omitempty"`
+	// Error is set when the action failed
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the outcome of a sweep
+type Report struct {
+	DryRun   bool      `json:"dry_run"`
+	Scanned  int       `json:"scanned"`
+	Findings []Finding `json:"findings"`
+	// Counts of actions taken, or planned in a dry run
+	Tagged   int `json:"tagged"`
+	Untagged int `json:"untagged"`
+	Deleted  int `json:"deleted"`
+	Failed   int `json:"failed"`
+}
+
+// Sweeper finds and collects stale objects
+type Sweeper struct {
+	objects   *opensase.ObjectsService
+	security  *opensase.SecurityService
+	unusedFor time.Duration
+	grace     time.Duration
+	tag       bool
+	delete    bool
+	now       func() time.Time
+}
+
+// New returns a Sweeper using client
+func New(client *opensase.Client, opts ...Option) *Sweeper {
+	s := &Sweeper{
+		objects:   client.Security.Objects,
+		security:  client.Security,
+		unusedFor: DefaultUnusedFor,
+		grace:     DefaultGracePeriod,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// object is the kind-independent view of an object the sweep works on
+type object struct {
+	kind        string
+	id          string
+	name        string
+	description string
+	updatedAt   time.Time
+}
+
+// Plan reports what Run would do without changing anything
+func (s *Sweeper) Plan(ctx context.Context) (*Report, error) {
+	return s.sweep(ctx, true)
+}
+
+// Run tags, untags and deletes objects as configured. A failed action is
+// recorded on its finding and does not stop the run.
+func (s *Sweeper) Run(ctx context.Context) (*Report, error) {
+	return s.sweep(ctx, false)
+}
+
+func (s *Sweeper) sweep(ctx context.Context, dryRun bool) (*Report, error) {
+	objects, err := s.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	cutoff := now.Add(-s.unusedFor)
+	usage := map[string]*time.Time{}
+	report := &Report{DryRun: dryRun, Scanned: len(objects), Findings: []Finding{}}
+
+	for _, obj := range objects {
+		refs, err := s.objects.WhereUsed(ctx, obj.id)
+		if err != nil {
+			return nil, fmt.Errorf("housekeeping: listing references of %s %q: %w", obj.kind, obj.name, err)
+		}
+		since, tagged := parseTag(obj.description)
+
+		finding := Finding{Kind: obj.kind, ID: obj.id, Name: obj.name}
+		switch {
+		case len(refs) > 0 && tagged:
+			finding.Action = ActionUntag
+
+		case len(refs) > 0:
+			idle, last, err := s.idle(ctx, refs, cutoff, usage)
+			if err != nil {
+				return nil, fmt.Errorf("housekeeping: reading rule usage for %s %q: %w", obj.kind, obj.name, err)
+			}
+			if !idle {
+				continue
+			}
+			finding.Action = ActionReview
+			finding.References = refs
+			finding.LastMatchedAt = last
+
+		case tagged:
+			deleteAfter := since.Add(s.grace)
+			finding.StaleSince = &since
+			finding.DeleteAfter = &deleteAfter
+			finding.Action = ActionWait
+			if s.delete && !now.Before(deleteAfter) {
+				finding.Action = ActionDelete
+			}
+
+		case obj.updatedAt.Before(cutoff):
+			staleSince := obj.updatedAt.Add(s.unusedFor)
+			if s.tag {
+				// The grace period starts at tagging, whatever the object's age
+				staleSince = now
+			}
+			deleteAfter := staleSince.Add(s.grace)
+			finding.StaleSince = &staleSince
+			finding.DeleteAfter = &deleteAfter
+			switch {
+			case s.tag:
+				finding.Action = ActionTag
+			case s.delete && !now.Before(deleteAfter):
+				finding.Action = ActionDelete
+			default:
+				finding.Action = ActionWait
+			}
+
+		default:
+			continue
+		}
+
+		if !dryRun {
+			if err := s.apply(ctx, obj, finding.Action, now); err != nil {
+				finding.Error = err.Error()
+			}
+		}
+		report.add(finding)
+	}
+
+	return report, nil
+}
+
+func (r *Report) add(f Finding) {
+	r.Findings = append(r.Findings, f)
+	if f.Error != "" {
+		r.Failed++
+		return
+	}
+	switch f.Action {
+	case ActionTag:
+		r.Tagged++
+	case ActionUntag:
+		r.Untagged++
+	case ActionDelete:
+		r.Deleted++
+	}
+}
+
+// idle reports whether every reference is a rule that has not matched since
+// cutoff, and the latest match among them. Groups and rules without hit
+// counts are always in use. usage caches rule lookups across objects.
+func (s *Sweeper) idle(ctx context.Context, refs []opensase.ObjectReference, cutoff time.Time, usage map[string]*time.Time) (bool, *time.Time, error) {
+	var latest *time.Time
+	for _, ref := range refs {
+		key := ref.Type + "/" + ref.ID
+		last, ok := usage[key]
+		if !ok {
+			var err error
+			if last, ok, err = s.lastMatched(ctx, ref); err != nil {
+				return false, nil, err
+			}
+			if !ok {
+				return false, nil, nil
+			}
+			usage[key] = last
+		}
+		if last != nil && !last.Before(cutoff) {
+			return false, nil, nil
+		}
+		if last != nil && (latest == nil || last.After(*latest)) {
+			latest = last
+		}
+	}
+	return true, latest, nil
+}
+
+// lastMatched returns when a rule last matched; ok is false for references
+// without hit counts
+func (s *Sweeper) lastMatched(ctx context.Context, ref opensase.ObjectReference) (last *time.Time, ok bool, err error) {
+	switch ref.Type {
+	case opensase.ReferenceFirewallRule:
+		rule, err := s.security.Firewall.Get(ctx, ref.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		return rule.LastMatchedAt, true, nil
+	case opensase.ReferencePolicy:
+		policy, err := s.security.Policies.Get(ctx, ref.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		return policy.LastMatchedAt, true, nil
+	case opensase.ReferenceZTNAAccessPolicy:
+		policy, err := s.security.ZTNAAccessPolicies.Get(ctx, ref.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		return policy.LastMatchedAt, true, nil
+	}
+	return nil, false, nil
+}
+
+func (s *Sweeper) apply(ctx context.Context, obj object, action Action, now time.Time) error {
+	switch action {
+	case ActionTag:
+		return s.setDescription(ctx, obj, tagPrefix+now.UTC().Format(tagDateLayout)+"] "+obj.description)
+	case ActionUntag:
+		return s.setDescription(ctx, obj, untag(obj.description))
+	case ActionDelete:
+		switch obj.kind {
+		case KindAddress:
+			return s.objects.Addresses.Delete(ctx, obj.id)
+		case KindService:
+			return s.objects.Services.Delete(ctx, obj.id)
+		case KindServiceGroup:
+			return s.objects.ServiceGroups.Delete(ctx, obj.id)
+		}
+	}
+	return nil
+}
+
+func (s *Sweeper) setDescription(ctx context.Context, obj object, description string) error {
+	var err error
+	switch obj.kind {
+	case KindAddress:
+		_, err = s.objects.Addresses.Update(ctx, obj.id, &opensase.UpdateAddressObjectParams{Description: &description})
+	case KindService:
+		_, err = s.objects.Services.Update(ctx, obj.id, &opensase.UpdateServiceObjectParams{Description: &description})
+	case KindServiceGroup:
+		_, err = s.objects.ServiceGroups.Update(ctx, obj.id, &opensase.UpdateServiceGroupParams{Description: &description})
+	}
+	return err
+}
+
+// list returns every object. Service groups come last, so their members are
+// still referenced when checked and are only collected on a later run,
+// after the group is gone.
+func (s *Sweeper) list(ctx context.Context) ([]object, error) {
+	var objects []object
+
+	addresses, err := s.objects.Addresses.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("housekeeping: listing address objects: %w", err)
+	}
+	for _, a := range addresses {
+		objects = append(objects, object{KindAddress, a.ID, a.Name, a.Description, a.UpdatedAt})
+	}
+
+	services, err := s.objects.Services.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("housekeeping: listing service objects: %w", err)
+	}
+	for _, svc := range services {
+		objects = append(objects, object{KindService, svc.ID, svc.Name, svc.Description, svc.UpdatedAt})
+	}
+
+	groups, err := s.objects.ServiceGroups.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("housekeeping: listing service groups: %w", err)
+	}
+	for _, g := range groups {
+		objects = append(objects, object{KindServiceGroup, g.ID, g.Name, g.Description, g.UpdatedAt})
+	}
+
+	return objects, nil
+}
+
+// parseTag returns the date in a tagged description
+func parseTag(description string) (time.Time, bool) {
+	if !strings.HasPrefix(description, tagPrefix) {
+		return time.Time{}, false
+	}
+	rest := description[len(tagPrefix):]
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return time.Time{}, false
+	}
+	since, err := time.Parse(tagDateLayout, rest[:end])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return since, true
+}
+
+// untag removes the tag from a tagged description
+func untag(description string) string {
+	end := strings.IndexByte(description, ']')
+	return strings.TrimPrefix(description[end+1:], " ")
+}
@@ -0,0 +1,279 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// External AAA Servers
+// =============================================================================
+
+// Uses of an external AAA server
+const (
+	AAAUseDot1X = "dot1x" // 802.1X authentication of LAN and Wi-Fi clients
+	AAAUseAdmin = "admin" // sign-in of administrators to the portal and edge devices
+)
+
+// LDAP connection security modes
+const (
+	LDAPSecurityNone     = "none"
+	LDAPSecurityStartTLS = "starttls"
+	LDAPSecurityLDAPS    = "ldaps"
+)
+
+// RADIUSServersService provides access to the external RADIUS servers used
+// for 802.1X and administrator authentication
+type RADIUSServersService struct {
+	client *Client
+}
+
+// RADIUSServer is an external RADIUS server. Servers with the same use are
+// tried in ascending Priority: a request that gets no answer within
+// TimeoutSeconds is repeated Retries times, then sent to the next server.
+// An AccountingPort of 0 disables accounting. The shared secret is
+// write-only and never returned.
+type RADIUSServer struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Host           string    `json:"host"`
+	AuthPort       int       `json:"auth_port"`
+	AccountingPort int       `json:"accounting_port"`
+	TimeoutSeconds int       `json:"timeout_seconds"`
+	Retries        int       `json:"retries"`
+	Priority       int       `json:"priority"`
+	Uses           []string  `json:"uses"`
+	Enabled        bool      `json:"enabled"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CreateRADIUSServerParams contains parameters for adding a RADIUS server.
+// Zero ports and timeouts take the server defaults of 1812, 1813 and 5
+// seconds.
+type CreateRADIUSServerParams struct {
+	Name           string   `json:"name"`
+	Host           string   `json:"host"`
+	SharedSecret   string   `json:"shared_secret"`
+	AuthPort       int      `json:"auth_port,omitempty"`
+	AccountingPort *int     `json:"accounting_port,omitempty"`
+	TimeoutSeconds int      `json:"timeout_seconds,omitempty"`
+	Retries        *int     `json:"retries,omitempty"`
+	Priority       int      `json:"priority,omitempty"`
+	Uses           []string `json:"uses"`
+	Enabled        *bool    `json:"enabled,omitempty"`
+}
+
+// UpdateRADIUSServerParams contains parameters for updating a RADIUS
+// server. Uses replaces the existing list.
+type UpdateRADIUSServerParams struct {
+	Name           *string   `json:"name,omitempty"`
+	Host           *string   `json:"host,omitempty"`
+	SharedSecret   *string   `json:"shared_secret,omitempty"`
+	AuthPort       *int      `json:"auth_port,omitempty"`
+	AccountingPort *int      `json:"accounting_port,omitempty"`
+	TimeoutSeconds *int      `json:"timeout_seconds,omitempty"`
+	Retries        *int      `json:"retries,omitempty"`
+	Priority       *int      `json:"priority,omitempty"`
+	Uses           *[]string `json:"uses,omitempty"`
+	Enabled        *bool     `json:"enabled,omitempty"`
+}
+
+// List retrieves all RADIUS servers in failover order
+func (s *RADIUSServersService) List(ctx context.Context) ([]RADIUSServer, error) {
+	data, err := s.client.get(ctx, "/identity/radius_servers", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []RADIUSServer
+	if err := s.client.decode(data, &servers); err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// Create adds a RADIUS server
+func (s *RADIUSServersService) Create(ctx context.Context, params *CreateRADIUSServerParams) (*RADIUSServer, error) {
+	data, err := s.client.post(ctx, "/identity/radius_servers", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server RADIUSServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Get retrieves a RADIUS server by ID
+func (s *RADIUSServersService) Get(ctx context.Context, serverID string) (*RADIUSServer, error) {
+	data, err := s.client.get(ctx, "/identity/radius_servers/"+serverID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server RADIUSServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Update updates a RADIUS server
+func (s *RADIUSServersService) Update(ctx context.Context, serverID string, params *UpdateRADIUSServerParams) (*RADIUSServer, error) {
+	data, err := s.client.patch(ctx, "/identity/radius_servers/"+serverID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server RADIUSServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Delete removes a RADIUS server
+func (s *RADIUSServersService) Delete(ctx context.Context, serverID string) error {
+	return s.client.delete(ctx, "/identity/radius_servers/"+serverID, nil)
+}
+
+// LDAPServersService provides access to the external LDAP directories used
+// for 802.1X and administrator authentication
+type LDAPServersService struct {
+	client *Client
+}
+
+// LDAPServer is an external LDAP directory. Users are found below BaseDN
+// with UserFilter, in which {username} is replaced by the name signing in,
+// and authenticated by binding as them. The service account in BindDN is
+// used for the search; its password is write-only and never returned.
+// Failover across servers with the same use works as for RADIUS servers.
+type LDAPServer struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Host           string    `json:"host"`
+	Port           int       `json:"port"`
+	Security       string    `json:"security"`
+	CACertificate  string    `json:"ca_certificate,omitempty"`
+	BindDN         string    `json:"bind_dn"`
+	BaseDN         string    `json:"base_dn"`
+	UserFilter     string    `json:"user_filter"`
+	GroupAttribute string    `json:"group_attribute,omitempty"`
+	TimeoutSeconds int       `json:"timeout_seconds"`
+	Priority       int       `json:"priority"`
+	Uses           []string  `json:"uses"`
+	Enabled        bool      `json:"enabled"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CreateLDAPServerParams contains parameters for adding an LDAP server. A
+// zero Port is 389, or 636 with LDAPS.
+type CreateLDAPServerParams struct {
+	Name           string   `json:"name"`
+	Host           string   `json:"host"`
+	Port           int      `json:"port,omitempty"`
+	Security       string   `json:"security"`
+	CACertificate  string   `json:"ca_certificate,omitempty"`
+	BindDN         string   `json:"bind_dn"`
+	BindPassword   string   `json:"bind_password"`
+	BaseDN         string   `json:"base_dn"`
+	UserFilter     string   `json:"user_filter,omitempty"`
+	GroupAttribute string   `json:"group_attribute,omitempty"`
+	TimeoutSeconds int      `json:"timeout_seconds,omitempty"`
+	Priority       int      `json:"priority,omitempty"`
+	Uses           []string `json:"uses"`
+	Enabled        *bool    `json:"enabled,omitempty"`
+}
+
+// UpdateLDAPServerParams contains parameters for updating an LDAP server.
+// Uses replaces the existing list.
+type UpdateLDAPServerParams struct {
+	Name           *string   `json:"name,omitempty"`
+	Host           *string   `json:"host,omitempty"`
+	Port           *int      `json:"port,omitempty"`
+	Security       *string   `json:"security,omitempty"`
+	CACertificate  *string   `json:"ca_certificate,omitempty"`
+	BindDN         *string   `json:"bind_dn,omitempty"`
+	BindPassword   *string   `json:"bind_password,omitempty"`
+	BaseDN         *string   `json:"base_dn,omitempty"`
+	UserFilter     *string   `json:"user_filter,omitempty"`
+	GroupAttribute *string   `json:"group_attribute,omitempty"`
+	TimeoutSeconds *int      `json:"timeout_seconds,omitempty"`
+	Priority       *int      `json:"priority,omitempty"`
+	Uses           *[]string `json:"uses,omitempty"`
+	Enabled        *bool     `json:"enabled,omitempty"`
+}
+
+// List retrieves all LDAP servers in failover order
+func (s *LDAPServersService) List(ctx context.Context) ([]LDAPServer, error) {
+	data, err := s.client.get(ctx, "/identity/ldap_servers", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []LDAPServer
+	if err := s.client.decode(data, &servers); err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// Create adds an LDAP server. The platform binds with the service account
+// first and rejects servers it cannot reach or authenticate to.
+func (s *LDAPServersService) Create(ctx context.Context, params *CreateLDAPServerParams) (*LDAPServer, error) {
+	data, err := s.client.post(ctx, "/identity/ldap_servers", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server LDAPServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Get retrieves an LDAP server by ID
+func (s *LDAPServersService) Get(ctx context.Context, serverID string) (*LDAPServer, error) {
+	data, err := s.client.get(ctx, "/identity/ldap_servers/"+serverID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server LDAPServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Update updates an LDAP server
+func (s *LDAPServersService) Update(ctx context.Context, serverID string, params *UpdateLDAPServerParams) (*LDAPServer, error) {
+	data, err := s.client.patch(ctx, "/identity/ldap_servers/"+serverID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server LDAPServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Delete removes an LDAP server
+func (s *LDAPServersService) Delete(ctx context.Context, serverID string) error {
+	return s.client.delete(ctx, "/identity/ldap_servers/"+serverID, nil)
+}
@@ -0,0 +1,255 @@
+package opensase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Interactive Login Flows
+// =============================================================================
+
+// Token endpoint error codes returned while a device authorization is polled
+const (
+	errCodeAuthorizationPending = "authorization_pending"
+	errCodeSlowDown             = "slow_down"
+)
+
+// DeviceAuthorization is a pending device code login. Show UserCode and
+// VerificationURI to the user, who approves the login from another device.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// DeviceCodeParams contains parameters for a device code login
+type DeviceCodeParams struct {
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope,omitempty"`
+
+	// Prompt is called once with the pending authorization so the caller
+	// can show the user code. Returning an error aborts the flow.
+	Prompt func(*DeviceAuthorization) error `json:"-"`
+}
+
+// PKCEFlowParams contains parameters for a browser login with a local callback
+type PKCEFlowParams struct {
+	ClientID string
+	Scope    string
+
+	// ListenAddr is the loopback address the callback server binds to.
+	// Defaults to 127.0.0.1:0, which picks a free port.
+	ListenAddr string
+
+	// OpenBrowser is called with the authorization URL. The SDK does not
+	// launch a browser itself; CLIs typically exec the platform opener and
+	// print the URL as a fallback.
+	OpenBrowser func(authURL string) error
+}
+
+// DeviceCodeFlow logs a user in with the device authorization grant. It
+// requests a device code, hands it to params.Prompt and polls until the
+// user approves, denies or the code expires. Keep the result with
+// TokenCache.Save, or pass its AccessToken to NewClient.
+func (s *AuthService) DeviceCodeFlow(ctx context.Context, params *DeviceCodeParams) (*LoginResponse, error) {
+	if params == nil || params.Prompt == nil {
+		return nil, fmt.Errorf("opensase: device code flow requires a Prompt")
+	}
+
+	data, err := s.client.post(withoutSession(ctx), "/identity/auth/device/code", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var auth DeviceAuthorization
+	if err := s.client.decode(data, &auth); err != nil {
+		return nil, err
+	}
+
+	if err := params.Prompt(&auth); err != nil {
+		return nil, err
+	}
+
+	interval := time.Duration(auth.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(auth.ExpiresIn) * time.Second)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		resp, err := s.token(ctx, map[string]string{
+			"grant_type":  "urn:ietf:params:oauth:grant-type:device_code",
+			"device_code": auth.DeviceCode,
+			"client_id":   params.ClientID,
+		})
+		if err == nil {
+			return resp, nil
+		}
+
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			return nil, err
+		}
+		switch apiErr.Code {
+		case errCodeAuthorizationPending:
+		case errCodeSlowDown:
+			interval += 5 * time.Second
+		default:
+			return nil, err
+		}
+
+		if auth.ExpiresIn > 0 && time.Now().After(deadline) {
+			return nil, fmt.Errorf("opensase: device code %s expired before it was approved", auth.UserCode)
+		}
+	}
+}
+
+// PKCEFlow logs a user in through the browser with the authorization code
+// grant and PKCE. It serves the redirect on a loopback address, so no
+// client secret is needed. Keep the result with TokenCache.Save, or pass
+// its AccessToken to NewClient.
+func (s *AuthService) PKCEFlow(ctx context.Context, params *PKCEFlowParams) (*LoginResponse, error) {
+	if params == nil || params.OpenBrowser == nil {
+		return nil, fmt.Errorf("opensase: PKCE flow requires OpenBrowser")
+	}
+
+	verifier, err := randomURLToken(32)
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomURLToken(16)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	addr := params.ListenAddr
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("opensase: starting login callback listener: %w", err)
+	}
+	redirectURI := "http://" + listener.Addr().String() + "/callback"
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("state") != state {
+			// Not the redirect of this login: any page the browser loads
+			// can request the loopback address, so refuse it and keep
+			// waiting rather than letting it end the flow
+			http.Error(w, "Unexpected login callback.", http.StatusBadRequest)
+			return
+		}
+
+		var res result
+		switch {
+		case q.Get("error") != "":
+			res.err = &Error{Code: q.Get("error"), Message: q.Get("error_description")}
+		case q.Get("code") == "":
+			res.err = fmt.Errorf("opensase: login callback is missing the authorization code")
+		default:
+			res.code = q.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(w, "Login failed. You can close this window.", http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Login complete. You can close this window.")
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+	defer server.Close()
+
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", params.ClientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("code_challenge", challenge)
+	v.Set("code_challenge_method", "S256")
+	v.Set("state", state)
+	if params.Scope != "" {
+		v.Set("scope", params.Scope)
+	}
+	if s.client.tenantID != "" {
+		v.Set("tenant_id", s.client.tenantID)
+	}
+
+	if err := params.OpenBrowser(s.client.baseURL + "/identity/auth/authorize?" + v.Encode()); err != nil {
+		return nil, err
+	}
+
+	var res result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-results:
+	}
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	return s.token(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"code":          res.code,
+		"redirect_uri":  redirectURI,
+		"code_verifier": verifier,
+		"client_id":     params.ClientID,
+	})
+}
+
+func (s *AuthService) token(ctx context.Context, params map[string]string) (*LoginResponse, error) {
+	data, err := s.client.post(withoutSession(ctx), "/identity/auth/token", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response LoginResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func randomURLToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
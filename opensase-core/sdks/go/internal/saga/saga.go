@@ -0,0 +1,78 @@
+// Package saga holds the compensation logic shared by the multi-step
+// helpers, provisioning and offboarding: the log of how to undo completed
+// API calls and how results carrying errors are encoded.
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+)
+
+// UndoFunc reverses a completed API call
+type UndoFunc func(ctx context.Context) error
+
+// Log records how to undo the API calls a run has completed
+type Log struct {
+	undo []UndoFunc
+}
+
+// Add records how to undo the call just completed
+func (l *Log) Add(fn UndoFunc) {
+	l.undo = append(l.undo, fn)
+}
+
+// Len returns the number of calls that can be undone
+func (l *Log) Len() int {
+	return len(l.undo)
+}
+
+// Forget drops what has been recorded, for when a run passes a step that
+// cannot be undone and earlier steps must then stay in place
+func (l *Log) Forget() {
+	l.undo = nil
+}
+
+// Rollback undoes the recorded calls in reverse order, within timeout. It
+// runs even if ctx is already cancelled, and carries on past failures so as
+// much as possible is undone. Objects already gone count as undone.
+func (l *Log) Rollback(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(l.undo) - 1; i >= 0; i-- {
+		if err := l.undo[i](ctx); err != nil && !IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	l.undo = nil
+	return errors.Join(errs...)
+}
+
+// IsNotFound reports whether err is an API not found error
+func IsNotFound(err error) bool {
+	var apiErr *opensase.Error
+	return errors.As(err, &apiErr) && apiErr.IsNotFoundError()
+}
+
+// Errors holds the errors of a result as strings. Embedded in the struct a
+// result's MarshalJSON encodes, it adds them as error and rollback_error.
+type Errors struct {
+	Error         string `json:"error,omitempty"`
+	RollbackError string `json:"rollback_error,omitempty"`
+}
+
+// ErrorStrings returns err and rollbackErr as Errors
+func ErrorStrings(err, rollbackErr error) Errors {
+	var e Errors
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if rollbackErr != nil {
+		e.RollbackError = rollbackErr.Error()
+	}
+	return e
+}
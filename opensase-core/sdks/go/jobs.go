@@ -0,0 +1,230 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Jobs
+// =============================================================================
+
+// Background job types
+const (
+	JobTypeImport          = "import"
+	JobTypeExport          = "export"
+	JobTypeFirmwareRollout = "firmware_rollout"
+	JobTypeReport          = "report"
+	JobTypeDecommission    = "decommission"
+)
+
+// Background job statuses
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// JobsService provides a single view of the platform's background jobs,
+// whichever feature started them
+type JobsService struct {
+	client *Client
+}
+
+// Job represents a platform background job. ResourceType and ResourceID
+// identify the feature-specific object the job works on, such as an export
+// run or a compliance report. Retrying a job creates a new job whose
+// RetryOf is the original job's ID.
+type Job struct {
+	ID           string                 `json:"id"`
+	Type         string                 `json:"type"`
+	Status       string                 `json:"status"`
+	Description  string                 `json:"description,omitempty"`
+	Progress     float64                `json:"progress"`
+	ResourceType string                 `json:"resource_type,omitempty"`
+	ResourceID   string                 `json:"resource_id,omitempty"`
+	Attempt      int                    `json:"attempt"`
+	RetryOf      string                 `json:"retry_of,omitempty"`
+	Cancellable  bool                   `json:"cancellable"`
+	Error        *Error                 `json:"error,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedBy    string                 `json:"created_by,omitempty"`
+	StartedAt    *time.Time             `json:"started_at,omitempty"`
+	FinishedAt   *time.Time             `json:"finished_at,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+// Done reports whether the job has reached a terminal status
+func (j *Job) Done() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed || j.Status == JobCancelled
+}
+
+// ListJobsParams contains parameters for listing background jobs
+type ListJobsParams struct {
+	Limit      int     `json:"limit,omitempty"`
+	Cursor     string  `json:"cursor,omitempty"`
+	Type       *string `json:"type,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	ResourceID *string `json:"resource_id,omitempty"`
+}
+
+// JobListResponse contains a list of jobs with cursor pagination
+type JobListResponse struct {
+	Data       []Job            `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// JobLogEntry is a single line of a job's log
+type JobLogEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Level     string    `json:"level"`
+	Message   string    `json:"message"`
+}
+
+// JobLogListResponse contains a page of job log entries, oldest first
+type JobLogListResponse struct {
+	Data       []JobLogEntry    `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// List retrieves background jobs, most recent first
+func (s *JobsService) List(ctx context.Context, params *ListJobsParams) (*JobListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+		if params.Type != nil {
+			v.Set("type", *params.Type)
+		}
+		if params.Status != nil {
+			v.Set("status", *params.Status)
+		}
+		if params.ResourceID != nil {
+			v.Set("resource_id", *params.ResourceID)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/jobs", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response JobListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves a background job by ID
+func (s *JobsService) Get(ctx context.Context, jobID string) (*Job, error) {
+	data, err := s.client.get(ctx, "/jobs/"+jobID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Logs retrieves a page of a job's log
+func (s *JobsService) Logs(ctx context.Context, jobID string, params *ListParams) (*JobLogListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/jobs/"+jobID+"/logs", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response JobLogListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Cancel requests cancellation of a queued or running job. Cancellation is
+// asynchronous; the returned job may still be running.
+func (s *JobsService) Cancel(ctx context.Context, jobID string) (*Job, error) {
+	data, err := s.client.post(ctx, "/jobs/"+jobID+"/cancel", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Retry starts a new attempt of a failed or cancelled job and returns the new job
+func (s *JobsService) Retry(ctx context.Context, jobID string, opts *RequestOptions) (*Job, error) {
+	data, err := s.client.post(ctx, "/jobs/"+jobID+"/retry", nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if err := s.client.decode(data, &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Wait polls a job until it succeeds, fails or is cancelled
+func (s *JobsService) Wait(ctx context.Context, jobID string, interval time.Duration) (*Job, error) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		job, err := s.Get(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case JobSucceeded:
+			return job, nil
+		case JobFailed:
+			if job.Error != nil {
+				return job, fmt.Errorf("opensase: job %s failed: %s", job.ID, job.Error.Message)
+			}
+			return job, fmt.Errorf("opensase: job %s failed", job.ID)
+		case JobCancelled:
+			return job, fmt.Errorf("opensase: job %s was cancelled", job.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
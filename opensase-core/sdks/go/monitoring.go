@@ -0,0 +1,230 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Monitoring Service
+// =============================================================================
+
+// Synthetic probe types
+const (
+	ProbeHTTP = "http"
+	ProbeICMP = "icmp"
+	ProbeDNS  = "dns"
+)
+
+// MonitoringService provides access to monitoring APIs
+type MonitoringService struct {
+	client     *Client
+	Synthetics *SyntheticsService
+	Experience *ExperienceService
+	Logs       *LogsService
+	Alerts     *AlertsService
+	LogExports *LogExportsService
+
+	MaintenanceWindows *MaintenanceWindowsService
+}
+
+// SyntheticsService provides access to synthetic probe APIs
+type SyntheticsService struct {
+	client *Client
+}
+
+// SyntheticProbe is a probe run periodically from sites or PoPs toward a target
+type SyntheticProbe struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	Type            string            `json:"type"`
+	Target          string            `json:"target"`
+	IntervalSeconds int               `json:"interval_seconds"`
+	TimeoutSeconds  int               `json:"timeout_seconds"`
+	SiteIDs         []string          `json:"site_ids,omitempty"`
+	PoPIDs          []string          `json:"pop_ids,omitempty"`
+	HTTP            *HTTPProbeOptions `json:"http,omitempty"`
+	DNS             *DNSProbeOptions  `json:"dns,omitempty"`
+	Enabled         bool              `json:"enabled"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+}
+
+// HTTPProbeOptions configures an HTTP probe
+type HTTPProbeOptions struct {
+	Method         string            `json:"method,omitempty"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	ExpectedStatus []int             `json:"expected_status,omitempty"`
+	ExpectedBody   string            `json:"expected_body,omitempty"`
+	FollowRedirect bool              `json:"follow_redirects"`
+}
+
+// DNSProbeOptions configures a DNS probe
+type DNSProbeOptions struct {
+	RecordType string `json:"record_type,omitempty"`
+	Resolver   string `json:"resolver,omitempty"`
+}
+
+// CreateProbeParams contains parameters for creating a synthetic probe.
+// At least one of SiteIDs or PoPIDs must be set.
+type CreateProbeParams struct {
+	Name            string            `json:"name"`
+	Type            string            `json:"type"`
+	Target          string            `json:"target"`
+	IntervalSeconds int               `json:"interval_seconds,omitempty"`
+	TimeoutSeconds  int               `json:"timeout_seconds,omitempty"`
+	SiteIDs         []string          `json:"site_ids,omitempty"`
+	PoPIDs          []string          `json:"pop_ids,omitempty"`
+	HTTP            *HTTPProbeOptions `json:"http,omitempty"`
+	DNS             *DNSProbeOptions  `json:"dns,omitempty"`
+	Enabled         *bool             `json:"enabled,omitempty"`
+}
+
+// UpdateProbeParams contains parameters for updating a synthetic probe
+type UpdateProbeParams struct {
+	Name            *string           `json:"name,omitempty"`
+	Target          *string           `json:"target,omitempty"`
+	IntervalSeconds *int              `json:"interval_seconds,omitempty"`
+	TimeoutSeconds  *int              `json:"timeout_seconds,omitempty"`
+	SiteIDs         []string          `json:"site_ids,omitempty"`
+	PoPIDs          []string          `json:"pop_ids,omitempty"`
+	HTTP            *HTTPProbeOptions `json:"http,omitempty"`
+	DNS             *DNSProbeOptions  `json:"dns,omitempty"`
+	Enabled         *bool             `json:"enabled,omitempty"`
+}
+
+// ProbeResultsParams contains parameters for querying probe results
+type ProbeResultsParams struct {
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Interval string    `json:"interval,omitempty"`
+	SiteID   *string   `json:"site_id,omitempty"`
+	PoPID    *string   `json:"pop_id,omitempty"`
+}
+
+// ProbeResults contains one time series per probe source
+type ProbeResults struct {
+	ProbeID string        `json:"probe_id"`
+	Series  []ProbeSeries `json:"series"`
+}
+
+// ProbeSeries is the result time series of a probe from one site or PoP
+type ProbeSeries struct {
+	SourceType string             `json:"source_type"`
+	SourceID   string             `json:"source_id"`
+	Points     []ProbeResultPoint `json:"points"`
+}
+
+// ProbeResultPoint aggregates probe runs within one interval. Timings are in milliseconds.
+type ProbeResultPoint struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Availability float64   `json:"availability"`
+	LatencyAvg   float64   `json:"latency_avg_ms"`
+	LatencyP95   float64   `json:"latency_p95_ms"`
+	PacketLoss   float64   `json:"packet_loss,omitempty"`
+	DNSTime      float64   `json:"dns_ms,omitempty"`
+	ConnectTime  float64   `json:"connect_ms,omitempty"`
+	TLSTime      float64   `json:"tls_ms,omitempty"`
+	TTFB         float64   `json:"ttfb_ms,omitempty"`
+	Runs         int       `json:"runs"`
+	Failures     int       `json:"failures"`
+}
+
+// List retrieves all synthetic probes
+func (s *SyntheticsService) List(ctx context.Context) ([]SyntheticProbe, error) {
+	data, err := s.client.get(ctx, "/monitoring/synthetics", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var probes []SyntheticProbe
+	if err := s.client.decode(data, &probes); err != nil {
+		return nil, err
+	}
+
+	return probes, nil
+}
+
+// Create creates a new synthetic probe
+func (s *SyntheticsService) Create(ctx context.Context, params *CreateProbeParams) (*SyntheticProbe, error) {
+	if len(params.SiteIDs) == 0 && len(params.PoPIDs) == 0 {
+		return nil, fmt.Errorf("opensase: probe requires at least one site or PoP")
+	}
+
+	data, err := s.client.post(ctx, "/monitoring/synthetics", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe SyntheticProbe
+	if err := s.client.decode(data, &probe); err != nil {
+		return nil, err
+	}
+
+	return &probe, nil
+}
+
+// Get retrieves a synthetic probe by ID
+func (s *SyntheticsService) Get(ctx context.Context, probeID string) (*SyntheticProbe, error) {
+	data, err := s.client.get(ctx, "/monitoring/synthetics/"+probeID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe SyntheticProbe
+	if err := s.client.decode(data, &probe); err != nil {
+		return nil, err
+	}
+
+	return &probe, nil
+}
+
+// Update updates a synthetic probe
+func (s *SyntheticsService) Update(ctx context.Context, probeID string, params *UpdateProbeParams) (*SyntheticProbe, error) {
+	data, err := s.client.patch(ctx, "/monitoring/synthetics/"+probeID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe SyntheticProbe
+	if err := s.client.decode(data, &probe); err != nil {
+		return nil, err
+	}
+
+	return &probe, nil
+}
+
+// Delete deletes a synthetic probe
+func (s *SyntheticsService) Delete(ctx context.Context, probeID string) error {
+	return s.client.delete(ctx, "/monitoring/synthetics/"+probeID, nil)
+}
+
+// Results retrieves probe results as time series, one per source site or PoP
+func (s *SyntheticsService) Results(ctx context.Context, probeID string, params *ProbeResultsParams) (*ProbeResults, error) {
+	v := url.Values{}
+	v.Set("from", params.From.UTC().Format(time.RFC3339))
+	v.Set("to", params.To.UTC().Format(time.RFC3339))
+	if params.Interval != "" {
+		v.Set("interval", params.Interval)
+	}
+	if params.SiteID != nil {
+		v.Set("site_id", *params.SiteID)
+	}
+	if params.PoPID != nil {
+		v.Set("pop_id", *params.PoPID)
+	}
+
+	data, err := s.client.get(ctx, "/monitoring/synthetics/"+probeID+"/results", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results ProbeResults
+	if err := s.client.decode(data, &results); err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
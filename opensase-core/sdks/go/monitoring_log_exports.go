@@ -0,0 +1,198 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Log Exports
+// =============================================================================
+
+// Log types that can be exported
+const (
+	LogTypeTraffic  = "traffic"
+	LogTypeSecurity = "security"
+	LogTypeDNS      = "dns"
+	LogTypeZTNA     = "ztna"
+	LogTypeAudit    = "audit"
+)
+
+// Log export destination types
+const (
+	LogDestinationSyslog    = "syslog"
+	LogDestinationS3        = "s3"
+	LogDestinationSplunkHEC = "splunk_hec"
+	LogDestinationHTTPS     = "https"
+)
+
+// Log export formats
+const (
+	LogFormatJSON = "json"
+	LogFormatCEF  = "cef"
+	LogFormatLEEF = "leef"
+)
+
+// LogExportsService provides access to continuous log forwarding to SIEMs
+// and archives. Unlike Logs.Stream, exports are delivered by the platform
+// without a connected consumer.
+type LogExportsService struct {
+	client *Client
+}
+
+// LogExport forwards logs of the selected types to one destination. Exactly
+// one of the destination configs is set, matching DestinationType. Tokens
+// and auth headers are write-only and never returned.
+type LogExport struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	DestinationType string                `json:"destination_type"`
+	Syslog          *SyslogDestination    `json:"syslog,omitempty"`
+	S3              *S3LogDestination     `json:"s3,omitempty"`
+	SplunkHEC       *SplunkHECDestination `json:"splunk_hec,omitempty"`
+	HTTPS           *HTTPSLogDestination  `json:"https,omitempty"`
+	Format          string                `json:"format"`
+	LogTypes        []string              `json:"log_types"`
+	SiteIDs         []string              `json:"site_ids,omitempty"`
+	Enabled         bool                  `json:"enabled"`
+	Status          string                `json:"status,omitempty"`
+	LastError       string                `json:"last_error,omitempty"`
+	LastDeliveredAt *time.Time            `json:"last_delivered_at,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+	UpdatedAt       time.Time             `json:"updated_at"`
+}
+
+// SyslogDestination sends RFC 5424 syslog over TLS, or plain TCP or UDP.
+// CACertificate pins the collector's CA for TLS.
+type SyslogDestination struct {
+	Host          string `json:"host"`
+	Port          int    `json:"port"`
+	Protocol      string `json:"protocol"`
+	Facility      string `json:"facility,omitempty"`
+	CACertificate string `json:"ca_certificate,omitempty"`
+}
+
+// S3LogDestination writes batched, gzipped log files to a bucket by
+// assuming RoleARN
+type S3LogDestination struct {
+	Bucket  string `json:"bucket"`
+	Prefix  string `json:"prefix,omitempty"`
+	Region  string `json:"region"`
+	RoleARN string `json:"role_arn"`
+}
+
+// SplunkHECDestination sends events to a Splunk HTTP Event Collector
+type SplunkHECDestination struct {
+	URL        string `json:"url"`
+	Token      string `json:"token,omitempty"`
+	Index      string `json:"index,omitempty"`
+	SourceType string `json:"source_type,omitempty"`
+}
+
+// HTTPSLogDestination POSTs batches of newline-delimited log entries
+type HTTPSLogDestination struct {
+	URL        string            `json:"url"`
+	AuthHeader string            `json:"auth_header,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+// CreateLogExportParams contains parameters for creating a log export. An
+// empty SiteIDs exports logs from every site.
+type CreateLogExportParams struct {
+	Name            string                `json:"name"`
+	DestinationType string                `json:"destination_type"`
+	Syslog          *SyslogDestination    `json:"syslog,omitempty"`
+	S3              *S3LogDestination     `json:"s3,omitempty"`
+	SplunkHEC       *SplunkHECDestination `json:"splunk_hec,omitempty"`
+	HTTPS           *HTTPSLogDestination  `json:"https,omitempty"`
+	Format          string                `json:"format"`
+	LogTypes        []string              `json:"log_types"`
+	SiteIDs         []string              `json:"site_ids,omitempty"`
+	Enabled         *bool                 `json:"enabled,omitempty"`
+}
+
+// UpdateLogExportParams contains parameters for updating a log export. The
+// destination type cannot be changed; a destination config replaces the
+// existing one and lists replace the existing values.
+type UpdateLogExportParams struct {
+	Name      *string               `json:"name,omitempty"`
+	Syslog    *SyslogDestination    `json:"syslog,omitempty"`
+	S3        *S3LogDestination     `json:"s3,omitempty"`
+	SplunkHEC *SplunkHECDestination `json:"splunk_hec,omitempty"`
+	HTTPS     *HTTPSLogDestination  `json:"https,omitempty"`
+	Format    *string               `json:"format,omitempty"`
+	LogTypes  *[]string             `json:"log_types,omitempty"`
+	SiteIDs   *[]string             `json:"site_ids,omitempty"`
+	Enabled   *bool                 `json:"enabled,omitempty"`
+}
+
+// List retrieves all log exports
+func (s *LogExportsService) List(ctx context.Context) ([]LogExport, error) {
+	data, err := s.client.get(ctx, "/monitoring/log_exports", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var exports []LogExport
+	if err := s.client.decode(data, &exports); err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// Create creates a new log export
+func (s *LogExportsService) Create(ctx context.Context, params *CreateLogExportParams) (*LogExport, error) {
+	data, err := s.client.post(ctx, "/monitoring/log_exports", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var export LogExport
+	if err := s.client.decode(data, &export); err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// Get retrieves a log export by ID, including its delivery status
+func (s *LogExportsService) Get(ctx context.Context, exportID string) (*LogExport, error) {
+	data, err := s.client.get(ctx, "/monitoring/log_exports/"+exportID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var export LogExport
+	if err := s.client.decode(data, &export); err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// Update updates a log export
+func (s *LogExportsService) Update(ctx context.Context, exportID string, params *UpdateLogExportParams) (*LogExport, error) {
+	data, err := s.client.patch(ctx, "/monitoring/log_exports/"+exportID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var export LogExport
+	if err := s.client.decode(data, &export); err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// Delete deletes a log export
+func (s *LogExportsService) Delete(ctx context.Context, exportID string) error {
+	return s.client.delete(ctx, "/monitoring/log_exports/"+exportID, nil)
+}
+
+// Test sends a sample entry of each selected log type to the destination
+func (s *LogExportsService) Test(ctx context.Context, exportID string) error {
+	_, err := s.client.post(ctx, "/monitoring/log_exports/"+exportID+"/test", nil, nil)
+	return err
+}
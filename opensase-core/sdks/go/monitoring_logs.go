@@ -0,0 +1,129 @@
+package opensase
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Log Streaming
+// =============================================================================
+
+// LogsService provides access to the live traffic and security log stream
+type LogsService struct {
+	client *Client
+}
+
+// LogEntry is a single traffic, security or audit log record
+type LogEntry struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	SiteID    string                 `json:"site_id,omitempty"`
+	UserID    string                 `json:"user_id,omitempty"`
+	Action    string                 `json:"action,omitempty"`
+	Message   string                 `json:"message,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+}
+
+// LogStreamParams contains parameters for opening a log stream
+type LogStreamParams struct {
+	Types  []string `json:"types,omitempty"`
+	SiteID *string  `json:"site_id,omitempty"`
+	// Cursor resumes the stream after the last entry a consumer processed
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// LogStream reads newline-delimited log entries from an open stream
+type LogStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+}
+
+// Stream opens a long-lived log stream. The caller must Close it.
+func (s *LogsService) Stream(ctx context.Context, params *LogStreamParams) (*LogStream, error) {
+	v := url.Values{}
+	if params != nil {
+		for _, t := range params.Types {
+			v.Add("type", t)
+		}
+		if params.SiteID != nil {
+			v.Set("site_id", *params.SiteID)
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+	}
+
+	u := s.client.baseURL + "/logs/stream"
+	if len(v) > 0 {
+		u += "?" + v.Encode()
+	}
+	authorization, err := s.client.authorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	req.Header.Set("Accept", "application/x-ndjson")
+	req.Header.Set("User-Agent", "opensase-go/"+Version)
+	if s.client.tenantID != "" {
+		req.Header.Set("X-Tenant-ID", s.client.tenantID)
+	}
+	callOptionsFrom(ctx).apply(req)
+
+	// Streams are unbounded, so the client-wide timeout must not apply
+	httpClient := *s.client.httpClient
+	httpClient.Timeout = 0
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, parseError(body, resp.StatusCode, resp.Header.Get("X-Request-ID"), resp.Header)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &LogStream{body: resp.Body, scanner: scanner}, nil
+}
+
+// Next blocks until the next entry arrives. It returns io.EOF when the
+// server closes the stream.
+func (ls *LogStream) Next() (*LogEntry, error) {
+	for ls.scanner.Scan() {
+		line := ls.scanner.Bytes()
+		if len(line) == 0 {
+			// Keep-alive
+			continue
+		}
+		var entry LogEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("opensase: invalid log entry: %w", err)
+		}
+		return &entry, nil
+	}
+	if err := ls.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Close closes the stream
+func (ls *LogStream) Close() error {
+	return ls.body.Close()
+}
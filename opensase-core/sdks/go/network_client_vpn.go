@@ -0,0 +1,172 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Client VPN Profiles
+// =============================================================================
+
+// Client VPN protocols
+const (
+	VPNProtocolWireGuard = "wireguard"
+	VPNProtocolIPsec     = "ipsec"
+	VPNProtocolSSL       = "ssl"
+)
+
+// Client VPN tunnel modes. Full sends all traffic through the tunnel;
+// split include sends only SplitTunnelRoutes and split exclude sends
+// everything except them.
+const (
+	VPNTunnelFull         = "full"
+	VPNTunnelSplitInclude = "split_include"
+	VPNTunnelSplitExclude = "split_exclude"
+)
+
+// ClientVPNProfilesService provides access to remote-access VPN client profiles
+type ClientVPNProfilesService struct {
+	client *Client
+}
+
+// ClientVPNProfile is the connection profile remote-access clients
+// download. ConfigVersion increases whenever a change requires clients to
+// fetch a new config.
+type ClientVPNProfile struct {
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description,omitempty"`
+	Protocol          string             `json:"protocol"`
+	TunnelMode        string             `json:"tunnel_mode"`
+	SplitTunnelRoutes []string           `json:"split_tunnel_routes,omitempty"`
+	DNS               *ClientVPNDNS      `json:"dns,omitempty"`
+	DevicePosture     *ZTNADevicePosture `json:"device_posture,omitempty"`
+	UserGroups        []string           `json:"user_groups,omitempty"`
+	ConfigVersion     int                `json:"config_version"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+// ClientVPNDNS is the resolver configuration pushed to connected clients.
+// With no servers, clients keep their local resolvers.
+type ClientVPNDNS struct {
+	Servers       []string `json:"servers,omitempty"`
+	SearchDomains []string `json:"search_domains,omitempty"`
+}
+
+// ClientVPNConfig is a downloadable client configuration for a profile
+type ClientVPNConfig struct {
+	Filename      string    `json:"filename"`
+	ContentType   string    `json:"content_type"`
+	Content       []byte    `json:"content"`
+	ConfigVersion int       `json:"config_version"`
+	GeneratedAt   time.Time `json:"generated_at"`
+}
+
+// CreateClientVPNProfileParams contains parameters for creating a client VPN profile
+type CreateClientVPNProfileParams struct {
+	Name              string             `json:"name"`
+	Description       string             `json:"description,omitempty"`
+	Protocol          string             `json:"protocol"`
+	TunnelMode        string             `json:"tunnel_mode,omitempty"`
+	SplitTunnelRoutes []string           `json:"split_tunnel_routes,omitempty"`
+	DNS               *ClientVPNDNS      `json:"dns,omitempty"`
+	DevicePosture     *ZTNADevicePosture `json:"device_posture,omitempty"`
+	UserGroups        []string           `json:"user_groups,omitempty"`
+}
+
+// UpdateClientVPNProfileParams contains parameters for updating a client
+// VPN profile. Lists replace the existing values.
+type UpdateClientVPNProfileParams struct {
+	Name              *string            `json:"name,omitempty"`
+	Description       *string            `json:"description,omitempty"`
+	Protocol          *string            `json:"protocol,omitempty"`
+	TunnelMode        *string            `json:"tunnel_mode,omitempty"`
+	SplitTunnelRoutes *[]string          `json:"split_tunnel_routes,omitempty"`
+	DNS               *ClientVPNDNS      `json:"dns,omitempty"`
+	DevicePosture     *ZTNADevicePosture `json:"device_posture,omitempty"`
+	UserGroups        *[]string          `json:"user_groups,omitempty"`
+}
+
+// List retrieves all client VPN profiles
+func (s *ClientVPNProfilesService) List(ctx context.Context) ([]ClientVPNProfile, error) {
+	data, err := s.client.get(ctx, "/client_vpn_profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []ClientVPNProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new client VPN profile
+func (s *ClientVPNProfilesService) Create(ctx context.Context, params *CreateClientVPNProfileParams) (*ClientVPNProfile, error) {
+	data, err := s.client.post(ctx, "/client_vpn_profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile ClientVPNProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves a client VPN profile by ID
+func (s *ClientVPNProfilesService) Get(ctx context.Context, profileID string) (*ClientVPNProfile, error) {
+	data, err := s.client.get(ctx, "/client_vpn_profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile ClientVPNProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates a client VPN profile
+func (s *ClientVPNProfilesService) Update(ctx context.Context, profileID string, params *UpdateClientVPNProfileParams) (*ClientVPNProfile, error) {
+	data, err := s.client.patch(ctx, "/client_vpn_profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile ClientVPNProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes a client VPN profile. Connected clients are disconnected.
+func (s *ClientVPNProfilesService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/client_vpn_profiles/"+profileID, nil)
+}
+
+// DownloadConfig retrieves the client configuration for a profile. The
+// content is a WireGuard or strongSwan config, or an SSL VPN connection
+// file, depending on the protocol.
+func (s *ClientVPNProfilesService) DownloadConfig(ctx context.Context, profileID string) (*ClientVPNConfig, error) {
+	data, err := s.client.get(ctx, "/client_vpn_profiles/"+profileID+"/config", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var config ClientVPNConfig
+	if err := s.client.decode(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
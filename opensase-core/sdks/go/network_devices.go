@@ -0,0 +1,145 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Edge Devices
+// =============================================================================
+
+// Firmware release channels
+const (
+	FirmwareChannelStable = "stable"
+	FirmwareChannelLTS    = "lts"
+	FirmwareChannelBeta   = "beta"
+)
+
+// Edge device high-availability roles
+const (
+	HARoleStandalone = "standalone"
+	HARolePrimary    = "primary"
+	HARoleSecondary  = "secondary"
+)
+
+// Edge device connection statuses
+const (
+	DeviceStatusPending = "pending"
+	DeviceStatusOnline  = "online"
+	DeviceStatusOffline = "offline"
+)
+
+// EdgeDevicesService provides access to the edge appliances claimed by the tenant
+type EdgeDevicesService struct {
+	client *Client
+}
+
+// EdgeDevice represents an edge appliance claimed by the tenant. A device is
+// pending until it first connects after being claimed.
+type EdgeDevice struct {
+	ID              string     `json:"id"`
+	SerialNumber    string     `json:"serial_number"`
+	Model           string     `json:"model"`
+	Name            string     `json:"name"`
+	SiteID          string     `json:"site_id,omitempty"`
+	FirmwareChannel string     `json:"firmware_channel"`
+	FirmwareVersion string     `json:"firmware_version,omitempty"`
+	HARole          string     `json:"ha_role"`
+	Status          string     `json:"status"`
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
+	ClaimedAt       time.Time  `json:"claimed_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// ClaimDeviceParams contains parameters for claiming an edge appliance.
+// ClaimCode is printed on the appliance and proves physical possession.
+type ClaimDeviceParams struct {
+	SerialNumber    string `json:"serial_number"`
+	Model           string `json:"model"`
+	ClaimCode       string `json:"claim_code,omitempty"`
+	Name            string `json:"name"`
+	SiteID          string `json:"site_id,omitempty"`
+	FirmwareChannel string `json:"firmware_channel,omitempty"`
+	HARole          string `json:"ha_role,omitempty"`
+}
+
+// UpdateDeviceParams contains parameters for updating an edge device. Set
+// SiteID to an empty string to unbind the device from its site.
+type UpdateDeviceParams struct {
+	Name            *string `json:"name,omitempty"`
+	SiteID          *string `json:"site_id,omitempty"`
+	FirmwareChannel *string `json:"firmware_channel,omitempty"`
+	HARole          *string `json:"ha_role,omitempty"`
+}
+
+// List retrieves the tenant's edge devices, optionally only those bound to a site
+func (s *EdgeDevicesService) List(ctx context.Context, siteID string) ([]EdgeDevice, error) {
+	v := url.Values{}
+	if siteID != "" {
+		v.Set("site_id", siteID)
+	}
+
+	data, err := s.client.get(ctx, "/edge_devices", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []EdgeDevice
+	if err := s.client.decode(data, &devices); err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// Claim registers an edge appliance to the tenant
+func (s *EdgeDevicesService) Claim(ctx context.Context, params *ClaimDeviceParams) (*EdgeDevice, error) {
+	data, err := s.client.post(ctx, "/edge_devices", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var device EdgeDevice
+	if err := s.client.decode(data, &device); err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// Get retrieves an edge device by ID
+func (s *EdgeDevicesService) Get(ctx context.Context, deviceID string) (*EdgeDevice, error) {
+	data, err := s.client.get(ctx, "/edge_devices/"+deviceID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var device EdgeDevice
+	if err := s.client.decode(data, &device); err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// Update updates an edge device
+func (s *EdgeDevicesService) Update(ctx context.Context, deviceID string, params *UpdateDeviceParams) (*EdgeDevice, error) {
+	data, err := s.client.patch(ctx, "/edge_devices/"+deviceID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var device EdgeDevice
+	if err := s.client.decode(data, &device); err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// Release unclaims an edge device so it can be claimed again, by this or another tenant
+func (s *EdgeDevicesService) Release(ctx context.Context, deviceID string) error {
+	return s.client.delete(ctx, "/edge_devices/"+deviceID, nil)
+}
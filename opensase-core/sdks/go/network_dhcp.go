@@ -0,0 +1,146 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// DHCP Servers
+// =============================================================================
+
+// DHCPServersService provides access to the DHCP servers run by the edge
+// devices of individual sites
+type DHCPServersService struct {
+	client *Client
+}
+
+// DHCPServer hands out addresses from Ranges to hosts on one VLAN of a site.
+// The VLAN's gateway address is offered as the default router. Reserved
+// addresses may lie outside the ranges but must be in the VLAN's subnet.
+type DHCPServer struct {
+	ID           string            `json:"id"`
+	SiteID       string            `json:"site_id"`
+	VLAN         int               `json:"vlan"`
+	Enabled      bool              `json:"enabled"`
+	Ranges       []DHCPRange       `json:"ranges"`
+	LeaseSeconds int               `json:"lease_seconds"`
+	DNSServers   []string          `json:"dns_servers,omitempty"`
+	DomainName   string            `json:"domain_name,omitempty"`
+	Options      []DHCPOption      `json:"options,omitempty"`
+	Reservations []DHCPReservation `json:"reservations,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// DHCPRange is an inclusive range of addresses leased to hosts
+type DHCPRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// DHCPOption is a custom DHCP option sent to hosts, such as 66 (TFTP
+// server) for IP phones. Value is the option's text form; options with
+// dedicated fields, like DNS servers, cannot be set here.
+type DHCPOption struct {
+	Code  int    `json:"code"`
+	Value string `json:"value"`
+}
+
+// DHCPReservation always leases IPAddress to the host with MACAddress
+type DHCPReservation struct {
+	MACAddress string `json:"mac_address"`
+	IPAddress  string `json:"ip_address"`
+	Hostname   string `json:"hostname,omitempty"`
+}
+
+// CreateDHCPServerParams contains parameters for creating a DHCP server.
+// A site has at most one DHCP server per VLAN, and the VLAN must exist.
+type CreateDHCPServerParams struct {
+	VLAN         int               `json:"vlan"`
+	Enabled      *bool             `json:"enabled,omitempty"`
+	Ranges       []DHCPRange       `json:"ranges"`
+	LeaseSeconds int               `json:"lease_seconds,omitempty"`
+	DNSServers   []string          `json:"dns_servers,omitempty"`
+	DomainName   string            `json:"domain_name,omitempty"`
+	Options      []DHCPOption      `json:"options,omitempty"`
+	Reservations []DHCPReservation `json:"reservations,omitempty"`
+}
+
+// UpdateDHCPServerParams contains parameters for updating a DHCP server.
+// The VLAN cannot be changed; lists replace the existing values.
+type UpdateDHCPServerParams struct {
+	Enabled      *bool              `json:"enabled,omitempty"`
+	Ranges       *[]DHCPRange       `json:"ranges,omitempty"`
+	LeaseSeconds *int               `json:"lease_seconds,omitempty"`
+	DNSServers   *[]string          `json:"dns_servers,omitempty"`
+	DomainName   *string            `json:"domain_name,omitempty"`
+	Options      *[]DHCPOption      `json:"options,omitempty"`
+	Reservations *[]DHCPReservation `json:"reservations,omitempty"`
+}
+
+// List retrieves the DHCP servers of a site
+func (s *DHCPServersService) List(ctx context.Context, siteID string) ([]DHCPServer, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/dhcp_servers", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []DHCPServer
+	if err := s.client.decode(data, &servers); err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// Create adds a DHCP server to a VLAN of a site
+func (s *DHCPServersService) Create(ctx context.Context, siteID string, params *CreateDHCPServerParams) (*DHCPServer, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/dhcp_servers", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server DHCPServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Get retrieves a DHCP server of a site
+func (s *DHCPServersService) Get(ctx context.Context, siteID, serverID string) (*DHCPServer, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/dhcp_servers/"+serverID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server DHCPServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Update updates a DHCP server. Existing leases are kept until they expire,
+// even when their address is no longer in a range.
+func (s *DHCPServersService) Update(ctx context.Context, siteID, serverID string, params *UpdateDHCPServerParams) (*DHCPServer, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/dhcp_servers/"+serverID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var server DHCPServer
+	if err := s.client.decode(data, &server); err != nil {
+		return nil, err
+	}
+
+	return &server, nil
+}
+
+// Delete removes a DHCP server from a site
+func (s *DHCPServersService) Delete(ctx context.Context, siteID, serverID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/dhcp_servers/"+serverID, nil)
+}
@@ -0,0 +1,176 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Guest WiFi Vouchers
+// =============================================================================
+
+// MaxVoucherBatchSize is the largest number of vouchers a single batch may hold
+const MaxVoucherBatchSize = 1000
+
+// Guest voucher statuses. A voucher is unused until a guest first redeems
+// it on the captive portal; its duration starts counting from then.
+const (
+	VoucherUnused  = "unused"
+	VoucherActive  = "active"
+	VoucherExpired = "expired"
+	VoucherRevoked = "revoked"
+)
+
+// GuestVouchersService provides access to captive-portal guest access vouchers
+type GuestVouchersService struct {
+	client *Client
+}
+
+// VoucherBandwidth limits the traffic of a guest. Zero values are unlimited.
+type VoucherBandwidth struct {
+	DownstreamMbps int `json:"downstream_mbps,omitempty"`
+	UpstreamMbps   int `json:"upstream_mbps,omitempty"`
+	DataLimitMB    int `json:"data_limit_mb,omitempty"`
+}
+
+// GuestVoucher is a code that grants a guest network access for
+// DurationMinutes after it is redeemed
+type GuestVoucher struct {
+	ID              string            `json:"id"`
+	BatchID         string            `json:"batch_id"`
+	Code            string            `json:"code"`
+	Status          string            `json:"status"`
+	DurationMinutes int               `json:"duration_minutes"`
+	Bandwidth       *VoucherBandwidth `json:"bandwidth,omitempty"`
+	SiteIDs         []string          `json:"site_ids,omitempty"`
+	MaxDevices      int               `json:"max_devices"`
+	DevicesUsed     int               `json:"devices_used"`
+	Note            string            `json:"note,omitempty"`
+	RedeemBy        *time.Time        `json:"redeem_by,omitempty"`
+	ActivatedAt     *time.Time        `json:"activated_at,omitempty"`
+	ExpiresAt       *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+}
+
+// VoucherBatch is a set of vouchers generated together
+type VoucherBatch struct {
+	ID        string         `json:"id"`
+	Count     int            `json:"count"`
+	Vouchers  []GuestVoucher `json:"vouchers"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// GenerateVouchersParams contains parameters for generating a voucher
+// batch. An empty SiteIDs makes the vouchers valid at every site with a
+// captive portal. Unused vouchers lapse at RedeemBy.
+type GenerateVouchersParams struct {
+	Count           int               `json:"count"`
+	DurationMinutes int               `json:"duration_minutes"`
+	Bandwidth       *VoucherBandwidth `json:"bandwidth,omitempty"`
+	SiteIDs         []string          `json:"site_ids,omitempty"`
+	MaxDevices      int               `json:"max_devices,omitempty"`
+	Note            string            `json:"note,omitempty"`
+	RedeemBy        *time.Time        `json:"redeem_by,omitempty"`
+}
+
+// ListVouchersParams contains parameters for listing vouchers
+type ListVouchersParams struct {
+	Limit   int    `json:"limit,omitempty"`
+	Cursor  string `json:"cursor,omitempty"`
+	BatchID string `json:"batch_id,omitempty"`
+	Status  string `json:"status,omitempty"`
+	SiteID  string `json:"site_id,omitempty"`
+}
+
+// GuestVoucherListResponse contains a list of vouchers with cursor pagination
+type GuestVoucherListResponse struct {
+	Data       []GuestVoucher   `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// Generate creates a batch of vouchers, ready to print from the returned
+// batch
+func (s *GuestVouchersService) Generate(ctx context.Context, params *GenerateVouchersParams) (*VoucherBatch, error) {
+	if params.Count < 1 || params.Count > MaxVoucherBatchSize {
+		return nil, fmt.Errorf("opensase: voucher count must be between 1 and %d", MaxVoucherBatchSize)
+	}
+	if params.DurationMinutes <= 0 {
+		return nil, fmt.Errorf("opensase: voucher duration must be positive")
+	}
+
+	data, err := s.client.post(ctx, "/guest_vouchers/batches", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch VoucherBatch
+	if err := s.client.decode(data, &batch); err != nil {
+		return nil, err
+	}
+
+	return &batch, nil
+}
+
+// List retrieves vouchers with cursor pagination
+func (s *GuestVouchersService) List(ctx context.Context, params *ListVouchersParams) (*GuestVoucherListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Limit > 0 {
+			v.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			v.Set("cursor", params.Cursor)
+		}
+		if params.BatchID != "" {
+			v.Set("batch_id", params.BatchID)
+		}
+		if params.Status != "" {
+			v.Set("status", params.Status)
+		}
+		if params.SiteID != "" {
+			v.Set("site_id", params.SiteID)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/guest_vouchers", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GuestVoucherListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get retrieves a voucher by ID
+func (s *GuestVouchersService) Get(ctx context.Context, voucherID string) (*GuestVoucher, error) {
+	data, err := s.client.get(ctx, "/guest_vouchers/"+voucherID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var voucher GuestVoucher
+	if err := s.client.decode(data, &voucher); err != nil {
+		return nil, err
+	}
+
+	return &voucher, nil
+}
+
+// Revoke revokes a voucher and disconnects any guests using it
+func (s *GuestVouchersService) Revoke(ctx context.Context, voucherID string) error {
+	_, err := s.client.post(ctx, "/guest_vouchers/"+voucherID+"/revoke", nil, nil)
+	return err
+}
+
+// RevokeBatch revokes every voucher in a batch, e.g. a lost printout
+func (s *GuestVouchersService) RevokeBatch(ctx context.Context, batchID string) error {
+	_, err := s.client.post(ctx, "/guest_vouchers/batches/"+batchID+"/revoke", nil, nil)
+	return err
+}
@@ -0,0 +1,206 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Host Tables
+// =============================================================================
+
+// DHCP lease states
+const (
+	DHCPLeaseActive   = "active"
+	DHCPLeaseExpired  = "expired"
+	DHCPLeaseReserved = "reserved"
+)
+
+// Neighbor table families. ARP entries are IPv4, ND entries are IPv6.
+const (
+	NeighborFamilyARP = "arp"
+	NeighborFamilyND  = "nd"
+)
+
+// Neighbor entry states, as reported by the edge device's kernel
+const (
+	NeighborReachable  = "reachable"
+	NeighborStale      = "stale"
+	NeighborIncomplete = "incomplete"
+	NeighborFailed     = "failed"
+	NeighborPermanent  = "permanent"
+)
+
+// Host location sources
+const (
+	HostSourceDHCP = "dhcp"
+	HostSourceARP  = "arp"
+	HostSourceND   = "nd"
+)
+
+// HostsService provides read access to the DHCP leases and ARP/ND neighbor
+// tables of the tenant's edge devices. Tables are read live from the
+// devices, so offline devices contribute no entries.
+type HostsService struct {
+	client *Client
+}
+
+// DHCPLease is a lease handed out by a site's DHCP server
+type DHCPLease struct {
+	SiteID     string     `json:"site_id"`
+	DeviceID   string     `json:"device_id"`
+	Interface  string     `json:"interface"`
+	VLAN       int        `json:"vlan,omitempty"`
+	MACAddress string     `json:"mac_address"`
+	IPAddress  string     `json:"ip_address"`
+	Hostname   string     `json:"hostname,omitempty"`
+	ClientID   string     `json:"client_id,omitempty"`
+	State      string     `json:"state"`
+	StartsAt   *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+// Neighbor is an ARP or IPv6 neighbor discovery entry on an edge device
+type Neighbor struct {
+	SiteID     string     `json:"site_id"`
+	DeviceID   string     `json:"device_id"`
+	Interface  string     `json:"interface"`
+	VLAN       int        `json:"vlan,omitempty"`
+	Family     string     `json:"family"`
+	IPAddress  string     `json:"ip_address"`
+	MACAddress string     `json:"mac_address,omitempty"`
+	State      string     `json:"state"`
+	Router     bool       `json:"router,omitempty"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// HostLocation is where a host was last seen, merged from the DHCP and
+// neighbor tables of every site
+type HostLocation struct {
+	SiteID      string    `json:"site_id"`
+	SiteName    string    `json:"site_name"`
+	DeviceID    string    `json:"device_id"`
+	Interface   string    `json:"interface"`
+	VLAN        int       `json:"vlan,omitempty"`
+	MACAddress  string    `json:"mac_address"`
+	IPAddresses []string  `json:"ip_addresses"`
+	Hostname    string    `json:"hostname,omitempty"`
+	Sources     []string  `json:"sources"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
+
+// HostSearchParams filters host table entries. MACAddress accepts any
+// format net.ParseMAC does, e.g. aa:bb:cc:dd:ee:ff, AA-BB-CC-DD-EE-FF or
+// aabb.ccdd.eeff.
+type HostSearchParams struct {
+	MACAddress string `json:"mac_address,omitempty"`
+	IPAddress  string `json:"ip_address,omitempty"`
+	Hostname   string `json:"hostname,omitempty"`
+	Interface  string `json:"interface,omitempty"`
+	State      string `json:"state,omitempty"`
+}
+
+// values validates the filters and encodes them with the MAC and IP
+// address in canonical form, so every spelling matches the same entries
+func (p *HostSearchParams) values() (url.Values, error) {
+	v := url.Values{}
+	if p == nil {
+		return v, nil
+	}
+	if p.MACAddress != "" {
+		mac, err := net.ParseMAC(p.MACAddress)
+		if err != nil {
+			return nil, fmt.Errorf("opensase: invalid MAC address %q", p.MACAddress)
+		}
+		v.Set("mac_address", mac.String())
+	}
+	if p.IPAddress != "" {
+		addr, err := netip.ParseAddr(p.IPAddress)
+		if err != nil {
+			return nil, fmt.Errorf("opensase: invalid IP address %q", p.IPAddress)
+		}
+		v.Set("ip_address", addr.Unmap().String())
+	}
+	if p.Hostname != "" {
+		v.Set("hostname", p.Hostname)
+	}
+	if p.Interface != "" {
+		v.Set("interface", p.Interface)
+	}
+	if p.State != "" {
+		v.Set("state", p.State)
+	}
+	return v, nil
+}
+
+// DHCPLeases retrieves the DHCP leases of a site
+func (s *HostsService) DHCPLeases(ctx context.Context, siteID string, params *HostSearchParams) ([]DHCPLease, error) {
+	v, err := params.values()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/dhcp_leases", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var leases []DHCPLease
+	if err := s.client.decode(data, &leases); err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+// Neighbors retrieves the ARP and ND entries of a site. Pass family to
+// limit the result to NeighborFamilyARP or NeighborFamilyND.
+func (s *HostsService) Neighbors(ctx context.Context, siteID, family string, params *HostSearchParams) ([]Neighbor, error) {
+	v, err := params.values()
+	if err != nil {
+		return nil, err
+	}
+	if family != "" {
+		v.Set("family", family)
+	}
+
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/neighbors", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var neighbors []Neighbor
+	if err := s.client.decode(data, &neighbors); err != nil {
+		return nil, err
+	}
+
+	return neighbors, nil
+}
+
+// Locate finds where a host is across all sites. A MAC or IP address is
+// required; the most recently seen location comes first.
+func (s *HostsService) Locate(ctx context.Context, params *HostSearchParams) ([]HostLocation, error) {
+	if params == nil || (params.MACAddress == "" && params.IPAddress == "") {
+		return nil, fmt.Errorf("opensase: locating a host requires a MAC or IP address")
+	}
+	v, err := params.values()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.client.get(ctx, "/hosts", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []HostLocation
+	if err := s.client.decode(data, &locations); err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
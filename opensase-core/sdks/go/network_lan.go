@@ -0,0 +1,360 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// =============================================================================
+// Integrated Branch LAN
+// =============================================================================
+
+// Switch port modes
+const (
+	SwitchPortAccess = "access"
+	SwitchPortTrunk  = "trunk"
+)
+
+// SSID security modes
+const (
+	SSIDSecurityOpen           = "open"
+	SSIDSecurityWPA2PSK        = "wpa2_psk"
+	SSIDSecurityWPA3SAE        = "wpa3_sae"
+	SSIDSecurityWPA2Enterprise = "wpa2_enterprise"
+)
+
+// LANService provides access to the managed switches and access points of
+// the integrated branch LAN offering. Tenants without the offering get a
+// forbidden error from every call except Status.
+type LANService struct {
+	client *Client
+}
+
+// LANStatus reports whether the tenant has the integrated LAN offering
+type LANStatus struct {
+	Enabled      bool `json:"enabled"`
+	Switches     int  `json:"switches"`
+	AccessPoints int  `json:"access_points"`
+}
+
+// LANSwitch is a managed switch at a site
+type LANSwitch struct {
+	ID              string     `json:"id"`
+	SiteID          string     `json:"site_id"`
+	Name            string     `json:"name"`
+	Model           string     `json:"model"`
+	SerialNumber    string     `json:"serial_number"`
+	FirmwareVersion string     `json:"firmware_version,omitempty"`
+	Status          string     `json:"status"`
+	PortCount       int        `json:"port_count"`
+	PoEBudgetWatts  float64    `json:"poe_budget_watts,omitempty"`
+	PoEUsedWatts    float64    `json:"poe_used_watts,omitempty"`
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// SwitchPort is a port on a managed switch. Access ports carry AccessVLAN
+// untagged; trunk ports carry AllowedVLANs tagged and NativeVLAN untagged.
+type SwitchPort struct {
+	SwitchID      string  `json:"switch_id"`
+	Number        int     `json:"number"`
+	Name          string  `json:"name,omitempty"`
+	Enabled       bool    `json:"enabled"`
+	LinkUp        bool    `json:"link_up"`
+	SpeedMbps     int     `json:"speed_mbps,omitempty"`
+	Mode          string  `json:"mode"`
+	AccessVLAN    int     `json:"access_vlan,omitempty"`
+	NativeVLAN    int     `json:"native_vlan,omitempty"`
+	AllowedVLANs  []int   `json:"allowed_vlans,omitempty"`
+	PoECapable    bool    `json:"poe_capable"`
+	PoEEnabled    bool    `json:"poe_enabled"`
+	PoEPowerWatts float64 `json:"poe_power_watts,omitempty"`
+}
+
+// UpdateSwitchPortParams contains parameters for updating a switch port.
+// AllowedVLANs replaces the existing list.
+type UpdateSwitchPortParams struct {
+	Name         *string `json:"name,omitempty"`
+	Enabled      *bool   `json:"enabled,omitempty"`
+	Mode         *string `json:"mode,omitempty"`
+	AccessVLAN   *int    `json:"access_vlan,omitempty"`
+	NativeVLAN   *int    `json:"native_vlan,omitempty"`
+	AllowedVLANs *[]int  `json:"allowed_vlans,omitempty"`
+	PoEEnabled   *bool   `json:"poe_enabled,omitempty"`
+}
+
+// BouncePortParams contains parameters for bouncing a switch port. With
+// PoE set, power is cycled as well, which reboots powered devices such as
+// phones and access points.
+type BouncePortParams struct {
+	PoE         bool `json:"poe,omitempty"`
+	DownSeconds int  `json:"down_seconds,omitempty"`
+}
+
+// AccessPoint is a managed wireless access point at a site
+type AccessPoint struct {
+	ID              string     `json:"id"`
+	SiteID          string     `json:"site_id"`
+	Name            string     `json:"name"`
+	Model           string     `json:"model"`
+	SerialNumber    string     `json:"serial_number"`
+	FirmwareVersion string     `json:"firmware_version,omitempty"`
+	Status          string     `json:"status"`
+	SwitchID        string     `json:"switch_id,omitempty"`
+	SwitchPort      int        `json:"switch_port,omitempty"`
+	ClientCount     int        `json:"client_count"`
+	LastSeenAt      *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// SSID is a wireless network broadcast by the access points of its sites.
+// The passphrase is write-only.
+type SSID struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Enabled         bool      `json:"enabled"`
+	Hidden          bool      `json:"hidden"`
+	Security        string    `json:"security"`
+	VLAN            int       `json:"vlan,omitempty"`
+	Bands           []string  `json:"bands"`
+	ClientIsolation bool      `json:"client_isolation"`
+	SiteIDs         []string  `json:"site_ids"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// CreateSSIDParams contains parameters for creating an SSID. Passphrase is
+// required for the PSK and SAE security modes.
+type CreateSSIDParams struct {
+	Name            string   `json:"name"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+	Hidden          bool     `json:"hidden,omitempty"`
+	Security        string   `json:"security"`
+	Passphrase      string   `json:"passphrase,omitempty"`
+	VLAN            int      `json:"vlan,omitempty"`
+	Bands           []string `json:"bands,omitempty"`
+	ClientIsolation bool     `json:"client_isolation,omitempty"`
+	SiteIDs         []string `json:"site_ids"`
+}
+
+// UpdateSSIDParams contains parameters for updating an SSID. Lists replace
+// the existing values.
+type UpdateSSIDParams struct {
+	Name            *string   `json:"name,omitempty"`
+	Enabled         *bool     `json:"enabled,omitempty"`
+	Hidden          *bool     `json:"hidden,omitempty"`
+	Security        *string   `json:"security,omitempty"`
+	Passphrase      *string   `json:"passphrase,omitempty"`
+	VLAN            *int      `json:"vlan,omitempty"`
+	Bands           *[]string `json:"bands,omitempty"`
+	ClientIsolation *bool     `json:"client_isolation,omitempty"`
+	SiteIDs         *[]string `json:"site_ids,omitempty"`
+}
+
+// WirelessClient is a device associated with an access point
+type WirelessClient struct {
+	MACAddress    string    `json:"mac_address"`
+	IPAddress     string    `json:"ip_address,omitempty"`
+	Hostname      string    `json:"hostname,omitempty"`
+	AccessPointID string    `json:"access_point_id"`
+	SSIDID        string    `json:"ssid_id"`
+	SSID          string    `json:"ssid"`
+	Band          string    `json:"band"`
+	Channel       int       `json:"channel"`
+	RSSI          int       `json:"rssi"`
+	TxRateMbps    int       `json:"tx_rate_mbps"`
+	RxRateMbps    int       `json:"rx_rate_mbps"`
+	ConnectedAt   time.Time `json:"connected_at"`
+}
+
+// ListWirelessClientsParams filters connected wireless clients
+type ListWirelessClientsParams struct {
+	SiteID        string `json:"site_id,omitempty"`
+	AccessPointID string `json:"access_point_id,omitempty"`
+	SSIDID        string `json:"ssid_id,omitempty"`
+}
+
+// Status reports whether the tenant has the integrated LAN offering
+func (s *LANService) Status(ctx context.Context) (*LANStatus, error) {
+	data, err := s.client.get(ctx, "/lan", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status LANStatus
+	if err := s.client.decode(data, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// ListSwitches retrieves the managed switches, optionally only those at a site
+func (s *LANService) ListSwitches(ctx context.Context, siteID string) ([]LANSwitch, error) {
+	v := url.Values{}
+	if siteID != "" {
+		v.Set("site_id", siteID)
+	}
+
+	data, err := s.client.get(ctx, "/lan/switches", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var switches []LANSwitch
+	if err := s.client.decode(data, &switches); err != nil {
+		return nil, err
+	}
+
+	return switches, nil
+}
+
+// ListPorts retrieves the ports of a switch
+func (s *LANService) ListPorts(ctx context.Context, switchID string) ([]SwitchPort, error) {
+	data, err := s.client.get(ctx, "/lan/switches/"+switchID+"/ports", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ports []SwitchPort
+	if err := s.client.decode(data, &ports); err != nil {
+		return nil, err
+	}
+
+	return ports, nil
+}
+
+// UpdatePort changes a port's VLAN assignment, admin state or PoE
+func (s *LANService) UpdatePort(ctx context.Context, switchID string, port int, params *UpdateSwitchPortParams) (*SwitchPort, error) {
+	data, err := s.client.patch(ctx, "/lan/switches/"+switchID+"/ports/"+strconv.Itoa(port), params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated SwitchPort
+	if err := s.client.decode(data, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// BouncePort takes a port down and back up, e.g. to make a client renew
+// its DHCP lease after a VLAN change
+func (s *LANService) BouncePort(ctx context.Context, switchID string, port int, params *BouncePortParams) error {
+	_, err := s.client.post(ctx, "/lan/switches/"+switchID+"/ports/"+strconv.Itoa(port)+"/bounce", params, nil)
+	return err
+}
+
+// ListAccessPoints retrieves the access points, optionally only those at a site
+func (s *LANService) ListAccessPoints(ctx context.Context, siteID string) ([]AccessPoint, error) {
+	v := url.Values{}
+	if siteID != "" {
+		v.Set("site_id", siteID)
+	}
+
+	data, err := s.client.get(ctx, "/lan/access_points", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var aps []AccessPoint
+	if err := s.client.decode(data, &aps); err != nil {
+		return nil, err
+	}
+
+	return aps, nil
+}
+
+// ListSSIDs retrieves all SSIDs
+func (s *LANService) ListSSIDs(ctx context.Context) ([]SSID, error) {
+	data, err := s.client.get(ctx, "/lan/ssids", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ssids []SSID
+	if err := s.client.decode(data, &ssids); err != nil {
+		return nil, err
+	}
+
+	return ssids, nil
+}
+
+// CreateSSID creates a new SSID
+func (s *LANService) CreateSSID(ctx context.Context, params *CreateSSIDParams) (*SSID, error) {
+	data, err := s.client.post(ctx, "/lan/ssids", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ssid SSID
+	if err := s.client.decode(data, &ssid); err != nil {
+		return nil, err
+	}
+
+	return &ssid, nil
+}
+
+// GetSSID retrieves an SSID by ID
+func (s *LANService) GetSSID(ctx context.Context, ssidID string) (*SSID, error) {
+	data, err := s.client.get(ctx, "/lan/ssids/"+ssidID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ssid SSID
+	if err := s.client.decode(data, &ssid); err != nil {
+		return nil, err
+	}
+
+	return &ssid, nil
+}
+
+// UpdateSSID updates an SSID
+func (s *LANService) UpdateSSID(ctx context.Context, ssidID string, params *UpdateSSIDParams) (*SSID, error) {
+	data, err := s.client.patch(ctx, "/lan/ssids/"+ssidID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ssid SSID
+	if err := s.client.decode(data, &ssid); err != nil {
+		return nil, err
+	}
+
+	return &ssid, nil
+}
+
+// DeleteSSID deletes an SSID; its clients are disconnected
+func (s *LANService) DeleteSSID(ctx context.Context, ssidID string) error {
+	return s.client.delete(ctx, "/lan/ssids/"+ssidID, nil)
+}
+
+// ListClients retrieves the wireless clients currently associated
+func (s *LANService) ListClients(ctx context.Context, params *ListWirelessClientsParams) ([]WirelessClient, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.SiteID != "" {
+			v.Set("site_id", params.SiteID)
+		}
+		if params.AccessPointID != "" {
+			v.Set("access_point_id", params.AccessPointID)
+		}
+		if params.SSIDID != "" {
+			v.Set("ssid_id", params.SSIDID)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/lan/wireless_clients", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []WirelessClient
+	if err := s.client.decode(data, &clients); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
@@ -0,0 +1,324 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// NAT Rules
+// =============================================================================
+
+// NAT rule types
+const (
+	NATTypeSource      = "source"
+	NATTypeDestination = "destination"
+	NATTypeStatic      = "static"
+	NATTypePortForward = "port_forward"
+)
+
+// NAT translation modes
+const (
+	NATTranslateInterface = "interface"
+	NATTranslatePool      = "pool"
+	NATTranslateStatic    = "static"
+)
+
+// NATRulesService provides access to the NAT rules of individual sites
+type NATRulesService struct {
+	client *Client
+}
+
+// NATRule represents a source, destination, static 1:1 or port-forwarding
+// NAT rule on a site. Port-forwarding rules are destination rules that map
+// the external port range in Match.DestinationPorts on WANLinkID to the
+// same-sized range starting at Translation.Port on an internal host.
+// Rules are evaluated in ascending Priority; like firewall rules, priorities
+// are sparse, unique within the site and never renumbered by the server.
+type NATRule struct {
+	ID          string         `json:"id"`
+	SiteID      string         `json:"site_id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Type        string         `json:"type"`
+	Priority    int            `json:"priority"`
+	Enabled     bool           `json:"enabled"`
+	WANLinkID   string         `json:"wan_link_id,omitempty"`
+	Match       NATMatch       `json:"match"`
+	Translation NATTranslation `json:"translation"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// NATMatch selects the traffic a NAT rule applies to. Empty lists match any.
+type NATMatch struct {
+	SourceAddresses      []string `json:"source_addresses,omitempty"`
+	DestinationAddresses []string `json:"destination_addresses,omitempty"`
+	Protocol             string   `json:"protocol,omitempty"`
+	DestinationPorts     []string `json:"destination_ports,omitempty"`
+}
+
+// NATTranslation describes how matched traffic is rewritten. Pool mode uses
+// Addresses as a PAT pool; static mode maps to the single address in Addresses.
+type NATTranslation struct {
+	Mode      string   `json:"mode"`
+	Addresses []string `json:"addresses,omitempty"`
+	Port      int      `json:"port,omitempty"`
+}
+
+// CreateNATRuleParams contains parameters for adding a NAT rule to a site
+type CreateNATRuleParams struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Type        string         `json:"type"`
+	Priority    int            `json:"priority"`
+	Enabled     *bool          `json:"enabled,omitempty"`
+	WANLinkID   string         `json:"wan_link_id,omitempty"`
+	Match       NATMatch       `json:"match"`
+	Translation NATTranslation `json:"translation"`
+}
+
+// UpdateNATRuleParams contains parameters for updating a NAT rule
+type UpdateNATRuleParams struct {
+	Name        *string         `json:"name,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Priority    *int            `json:"priority,omitempty"`
+	Enabled     *bool           `json:"enabled,omitempty"`
+	WANLinkID   *string         `json:"wan_link_id,omitempty"`
+	Match       *NATMatch       `json:"match,omitempty"`
+	Translation *NATTranslation `json:"translation,omitempty"`
+}
+
+// List retrieves the NAT rules of a site in evaluation order
+func (s *NATRulesService) List(ctx context.Context, siteID string) ([]NATRule, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/nat_rules", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []NATRule
+	if err := s.client.decode(data, &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Create adds a NAT rule to a site
+func (s *NATRulesService) Create(ctx context.Context, siteID string, params *CreateNATRuleParams) (*NATRule, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/nat_rules", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule NATRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// Get retrieves a NAT rule of a site
+func (s *NATRulesService) Get(ctx context.Context, siteID, ruleID string) (*NATRule, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/nat_rules/"+ruleID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule NATRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// Update updates a NAT rule. Changing Priority moves the rule without
+// renumbering the site's other rules.
+func (s *NATRulesService) Update(ctx context.Context, siteID, ruleID string, params *UpdateNATRuleParams) (*NATRule, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/nat_rules/"+ruleID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule NATRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// Delete removes a NAT rule from a site
+func (s *NATRulesService) Delete(ctx context.Context, siteID, ruleID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/nat_rules/"+ruleID, nil)
+}
+
+// NATConflict is an existing inbound rule whose external address, protocol
+// and port range overlap those of another rule, so the two would compete
+// for the same traffic
+type NATConflict struct {
+	RuleID   string `json:"rule_id"`
+	RuleName string `json:"rule_name"`
+	Protocol string `json:"protocol,omitempty"`
+	Ports    string `json:"ports,omitempty"`
+}
+
+func (c NATConflict) String() string {
+	if c.Ports == "" {
+		return fmt.Sprintf("rule %s (%s)", c.RuleName, c.RuleID)
+	}
+	return fmt.Sprintf("rule %s (%s) on %s ports %s", c.RuleName, c.RuleID, c.Protocol, c.Ports)
+}
+
+// Conflicts lists the enabled inbound rules of a site that overlap rule.
+// Call it before Create or Update to reject overlapping port forwards; rule
+// may be a new rule without an ID.
+func (s *NATRulesService) Conflicts(ctx context.Context, siteID string, rule *NATRule) ([]NATConflict, error) {
+	rules, err := s.List(ctx, siteID)
+	if err != nil {
+		return nil, err
+	}
+	return FindNATConflicts(rules, rule)
+}
+
+// FindNATConflicts reports the rules in existing that overlap rule. Only
+// destination, port-forwarding and static rules receive inbound traffic,
+// so source rules never conflict. Rules overlap when they share a WAN link
+// (an empty WANLinkID means all links), a protocol, a destination address
+// and, for destination and port-forwarding rules, an external port.
+func FindNATConflicts(existing []NATRule, rule *NATRule) ([]NATConflict, error) {
+	if !isInboundNAT(rule.Type) {
+		return nil, nil
+	}
+	ports, err := parsePortRanges(rule.Match.DestinationPorts)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []NATConflict
+	for _, other := range existing {
+		if other.ID == rule.ID || !other.Enabled || !isInboundNAT(other.Type) {
+			continue
+		}
+		if rule.WANLinkID != "" && other.WANLinkID != "" && rule.WANLinkID != other.WANLinkID {
+			continue
+		}
+		if !protocolsOverlap(rule.Match.Protocol, other.Match.Protocol) {
+			continue
+		}
+		if !addressesOverlap(rule.Match.DestinationAddresses, other.Match.DestinationAddresses) {
+			continue
+		}
+
+		conflict := NATConflict{RuleID: other.ID, RuleName: other.Name}
+		if rule.Type != NATTypeStatic && other.Type != NATTypeStatic {
+			otherPorts, err := parsePortRanges(other.Match.DestinationPorts)
+			if err != nil {
+				return nil, fmt.Errorf("opensase: NAT rule %s: %w", other.ID, err)
+			}
+			overlap, ok := portsOverlap(ports, otherPorts)
+			if !ok {
+				continue
+			}
+			conflict.Protocol = other.Match.Protocol
+			if conflict.Protocol == "" {
+				conflict.Protocol = rule.Match.Protocol
+			}
+			conflict.Ports = overlap
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts, nil
+}
+
+func isInboundNAT(ruleType string) bool {
+	return ruleType == NATTypeDestination || ruleType == NATTypePortForward || ruleType == NATTypeStatic
+}
+
+func protocolsOverlap(a, b string) bool {
+	return a == "" || b == "" || a == "any" || b == "any" || a == b
+}
+
+// addressesOverlap reports whether two address lists share an address.
+// Entries are addresses or CIDR prefixes; an empty list matches any.
+func addressesOverlap(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, x := range a {
+		px, err := parsePrefix(x)
+		if err != nil {
+			continue
+		}
+		for _, y := range b {
+			py, err := parsePrefix(y)
+			if err != nil {
+				continue
+			}
+			if px.Overlaps(py) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+type portRange struct{ lo, hi int }
+
+// parsePortRanges parses ports such as "443" and "8000-8100". No ports
+// means all ports.
+func parsePortRanges(ports []string) ([]portRange, error) {
+	if len(ports) == 0 {
+		return []portRange{{1, 65535}}, nil
+	}
+	ranges := make([]portRange, 0, len(ports))
+	for _, p := range ports {
+		lo, hi, found := strings.Cut(p, "-")
+		start, err := strconv.Atoi(strings.TrimSpace(lo))
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", p)
+		}
+		end := start
+		if found {
+			if end, err = strconv.Atoi(strings.TrimSpace(hi)); err != nil || end < start {
+				return nil, fmt.Errorf("invalid port range %q", p)
+			}
+		}
+		ranges = append(ranges, portRange{start, end})
+	}
+	return ranges, nil
+}
+
+// portsOverlap returns the first overlapping range of a and b
+func portsOverlap(a, b []portRange) (string, bool) {
+	for _, x := range a {
+		for _, y := range b {
+			lo, hi := max(x.lo, y.lo), min(x.hi, y.hi)
+			if lo > hi {
+				continue
+			}
+			if lo == hi {
+				return strconv.Itoa(lo), true
+			}
+			return fmt.Sprintf("%d-%d", lo, hi), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,131 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// SD-WAN QoS Profiles
+// =============================================================================
+
+// QoSProfilesService provides access to SD-WAN quality of service profile APIs
+type QoSProfilesService struct {
+	client *Client
+}
+
+// QoSProfile divides WAN link bandwidth between traffic classes and assigns
+// shaping rates to the links it is applied to
+type QoSProfile struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Classes     []QoSClass       `json:"classes"`
+	LinkShaping []QoSLinkShaping `json:"link_shaping,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// QoSClass is a traffic class within a QoS profile. Classes are served in
+// ascending Priority. GuaranteedPercent and MaxPercent are shares of the
+// shaped link bandwidth; the guarantees of all classes may not exceed 100.
+// DSCPMarking rewrites the DSCP of matching packets; nil leaves it unchanged.
+// A class with an empty Match receives all traffic not matched by another.
+type QoSClass struct {
+	Name              string       `json:"name"`
+	Priority          int          `json:"priority"`
+	Match             TrafficMatch `json:"match"`
+	DSCPMarking       *int         `json:"dscp_marking,omitempty"`
+	GuaranteedPercent int          `json:"guaranteed_percent"`
+	MaxPercent        int          `json:"max_percent"`
+}
+
+// QoSLinkShaping applies a profile to a WAN link of a site and sets the
+// rates the link is shaped to
+type QoSLinkShaping struct {
+	SiteID         string `json:"site_id"`
+	WANLinkID      string `json:"wan_link_id"`
+	UpstreamMbps   int    `json:"upstream_mbps"`
+	DownstreamMbps int    `json:"downstream_mbps,omitempty"`
+}
+
+// CreateQoSProfileParams contains parameters for creating a QoS profile
+type CreateQoSProfileParams struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Classes     []QoSClass       `json:"classes"`
+	LinkShaping []QoSLinkShaping `json:"link_shaping,omitempty"`
+}
+
+// UpdateQoSProfileParams contains parameters for updating a QoS profile
+type UpdateQoSProfileParams struct {
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Classes     *[]QoSClass       `json:"classes,omitempty"`
+	LinkShaping *[]QoSLinkShaping `json:"link_shaping,omitempty"`
+}
+
+// List retrieves all QoS profiles
+func (s *QoSProfilesService) List(ctx context.Context) ([]QoSProfile, error) {
+	data, err := s.client.get(ctx, "/sdwan/qos_profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []QoSProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new QoS profile
+func (s *QoSProfilesService) Create(ctx context.Context, params *CreateQoSProfileParams) (*QoSProfile, error) {
+	data, err := s.client.post(ctx, "/sdwan/qos_profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile QoSProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves a QoS profile by ID
+func (s *QoSProfilesService) Get(ctx context.Context, profileID string) (*QoSProfile, error) {
+	data, err := s.client.get(ctx, "/sdwan/qos_profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile QoSProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates a QoS profile. Classes and LinkShaping replace the existing lists.
+func (s *QoSProfilesService) Update(ctx context.Context, profileID string, params *UpdateQoSProfileParams) (*QoSProfile, error) {
+	data, err := s.client.patch(ctx, "/sdwan/qos_profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile QoSProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes a QoS profile and removes its shaping from all links
+func (s *QoSProfilesService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/sdwan/qos_profiles/"+profileID, nil)
+}
@@ -0,0 +1,240 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Network Segments
+// =============================================================================
+
+// Segment trust levels, from most to least trusted. Traffic between
+// segments is denied unless a segment policy allows it; a trust level only
+// drives defaults such as inspection depth and logging.
+const (
+	SegmentTrustRestricted = "restricted"
+	SegmentTrustInternal   = "internal"
+	SegmentTrustUntrusted  = "untrusted"
+	SegmentTrustQuarantine = "quarantine"
+)
+
+// SegmentsService provides access to micro-segmentation APIs: segment
+// definitions and the policies allowing east-west traffic between them
+type SegmentsService struct {
+	client *Client
+}
+
+// Segment is a set of networks isolated from other segments. A segment is
+// identified on the wire by its VLAN ID at sites and its VXLAN network
+// identifier across the overlay.
+type Segment struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	VLANID      int       `json:"vlan_id,omitempty"`
+	VNI         int       `json:"vni,omitempty"`
+	CIDRs       []string  `json:"cidrs"`
+	TrustLevel  string    `json:"trust_level"`
+	SiteIDs     []string  `json:"site_ids,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateSegmentParams contains parameters for creating a segment. At least
+// one of VLANID and VNI is required. An empty SiteIDs extends the segment
+// to every site.
+type CreateSegmentParams struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	VLANID      int      `json:"vlan_id,omitempty"`
+	VNI         int      `json:"vni,omitempty"`
+	CIDRs       []string `json:"cidrs"`
+	TrustLevel  string   `json:"trust_level"`
+	SiteIDs     []string `json:"site_ids,omitempty"`
+}
+
+// UpdateSegmentParams contains parameters for updating a segment. Lists
+// replace the existing values.
+type UpdateSegmentParams struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	VLANID      *int      `json:"vlan_id,omitempty"`
+	VNI         *int      `json:"vni,omitempty"`
+	CIDRs       *[]string `json:"cidrs,omitempty"`
+	TrustLevel  *string   `json:"trust_level,omitempty"`
+	SiteIDs     *[]string `json:"site_ids,omitempty"`
+}
+
+// SegmentPolicy allows or denies traffic from one segment to another.
+// ServiceObjectIDs limits the policy to those services; empty matches all
+// traffic. Bidirectional applies the policy to the reverse direction too.
+type SegmentPolicy struct {
+	ID                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	SourceSegmentID      string    `json:"source_segment_id"`
+	DestinationSegmentID string    `json:"destination_segment_id"`
+	Action               string    `json:"action"`
+	ServiceObjectIDs     []string  `json:"service_object_ids,omitempty"`
+	Bidirectional        bool      `json:"bidirectional"`
+	Log                  bool      `json:"log"`
+	Priority             int       `json:"priority"`
+	Enabled              bool      `json:"enabled"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+// CreateSegmentPolicyParams contains parameters for creating a segment policy
+type CreateSegmentPolicyParams struct {
+	Name                 string   `json:"name"`
+	SourceSegmentID      string   `json:"source_segment_id"`
+	DestinationSegmentID string   `json:"destination_segment_id"`
+	Action               string   `json:"action"`
+	ServiceObjectIDs     []string `json:"service_object_ids,omitempty"`
+	Bidirectional        bool     `json:"bidirectional,omitempty"`
+	Log                  bool     `json:"log,omitempty"`
+	Priority             int      `json:"priority,omitempty"`
+	Enabled              *bool    `json:"enabled,omitempty"`
+}
+
+// UpdateSegmentPolicyParams contains parameters for updating a segment
+// policy. The segments of a policy cannot be changed.
+type UpdateSegmentPolicyParams struct {
+	Name             *string   `json:"name,omitempty"`
+	Action           *string   `json:"action,omitempty"`
+	ServiceObjectIDs *[]string `json:"service_object_ids,omitempty"`
+	Bidirectional    *bool     `json:"bidirectional,omitempty"`
+	Log              *bool     `json:"log,omitempty"`
+	Priority         *int      `json:"priority,omitempty"`
+	Enabled          *bool     `json:"enabled,omitempty"`
+}
+
+// List retrieves all segments
+func (s *SegmentsService) List(ctx context.Context) ([]Segment, error) {
+	data, err := s.client.get(ctx, "/segments", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []Segment
+	if err := s.client.decode(data, &segments); err != nil {
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+// Create creates a new segment
+func (s *SegmentsService) Create(ctx context.Context, params *CreateSegmentParams) (*Segment, error) {
+	data, err := s.client.post(ctx, "/segments", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var segment Segment
+	if err := s.client.decode(data, &segment); err != nil {
+		return nil, err
+	}
+
+	return &segment, nil
+}
+
+// Get retrieves a segment by ID
+func (s *SegmentsService) Get(ctx context.Context, segmentID string) (*Segment, error) {
+	data, err := s.client.get(ctx, "/segments/"+segmentID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var segment Segment
+	if err := s.client.decode(data, &segment); err != nil {
+		return nil, err
+	}
+
+	return &segment, nil
+}
+
+// Update updates a segment
+func (s *SegmentsService) Update(ctx context.Context, segmentID string, params *UpdateSegmentParams) (*Segment, error) {
+	data, err := s.client.patch(ctx, "/segments/"+segmentID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var segment Segment
+	if err := s.client.decode(data, &segment); err != nil {
+		return nil, err
+	}
+
+	return &segment, nil
+}
+
+// Delete deletes a segment. Segments referenced by a policy cannot be deleted.
+func (s *SegmentsService) Delete(ctx context.Context, segmentID string) error {
+	return s.client.delete(ctx, "/segments/"+segmentID, nil)
+}
+
+// ListPolicies retrieves all segment policies in evaluation order
+func (s *SegmentsService) ListPolicies(ctx context.Context) ([]SegmentPolicy, error) {
+	data, err := s.client.get(ctx, "/segment_policies", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []SegmentPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// CreatePolicy creates a new segment policy
+func (s *SegmentsService) CreatePolicy(ctx context.Context, params *CreateSegmentPolicyParams) (*SegmentPolicy, error) {
+	data, err := s.client.post(ctx, "/segment_policies", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy SegmentPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// GetPolicy retrieves a segment policy by ID
+func (s *SegmentsService) GetPolicy(ctx context.Context, policyID string) (*SegmentPolicy, error) {
+	data, err := s.client.get(ctx, "/segment_policies/"+policyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy SegmentPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdatePolicy updates a segment policy
+func (s *SegmentsService) UpdatePolicy(ctx context.Context, policyID string, params *UpdateSegmentPolicyParams) (*SegmentPolicy, error) {
+	data, err := s.client.patch(ctx, "/segment_policies/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy SegmentPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// DeletePolicy deletes a segment policy
+func (s *SegmentsService) DeletePolicy(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/segment_policies/"+policyID, nil)
+}
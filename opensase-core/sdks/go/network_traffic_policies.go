@@ -0,0 +1,147 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// SD-WAN Traffic Steering
+// =============================================================================
+
+// Failover actions taken when no preferred link meets the SLA
+const (
+	FailoverNextPreferred = "next_preferred"
+	FailoverBestAvailable = "best_available"
+	FailoverDrop          = "drop"
+)
+
+// TrafficPoliciesService provides access to SD-WAN path steering policy APIs
+type TrafficPoliciesService struct {
+	client *Client
+}
+
+// TrafficPolicy steers matching traffic onto preferred WAN links
+type TrafficPolicy struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	Priority       int              `json:"priority"`
+	SiteIDs        []string         `json:"site_ids,omitempty"`
+	Match          TrafficMatch     `json:"match"`
+	PreferredLinks []string         `json:"preferred_links"`
+	SLA            *SLAThresholds   `json:"sla,omitempty"`
+	Failover       FailoverBehavior `json:"failover"`
+	Enabled        bool             `json:"enabled"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// TrafficMatch selects the traffic a policy applies to. Criteria are ANDed;
+// values within a criterion are ORed.
+type TrafficMatch struct {
+	Applications []string `json:"applications,omitempty"`
+	DSCP         []int    `json:"dscp,omitempty"`
+}
+
+// SLAThresholds are the limits a link must stay within to carry the traffic
+type SLAThresholds struct {
+	MaxLatencyMs   int     `json:"max_latency_ms,omitempty"`
+	MaxJitterMs    int     `json:"max_jitter_ms,omitempty"`
+	MaxLossPercent float64 `json:"max_loss_percent,omitempty"`
+}
+
+// FailoverBehavior controls what happens when preferred links violate the SLA
+type FailoverBehavior struct {
+	Action          string `json:"action"`
+	HoldDownSeconds int    `json:"hold_down_seconds,omitempty"`
+	Revert          bool   `json:"revert"`
+}
+
+// CreateTrafficPolicyParams contains parameters for creating a traffic policy
+type CreateTrafficPolicyParams struct {
+	Name           string            `json:"name"`
+	Priority       int               `json:"priority,omitempty"`
+	SiteIDs        []string          `json:"site_ids,omitempty"`
+	Match          TrafficMatch      `json:"match"`
+	PreferredLinks []string          `json:"preferred_links"`
+	SLA            *SLAThresholds    `json:"sla,omitempty"`
+	Failover       *FailoverBehavior `json:"failover,omitempty"`
+	Enabled        *bool             `json:"enabled,omitempty"`
+}
+
+// UpdateTrafficPolicyParams contains parameters for updating a traffic policy
+type UpdateTrafficPolicyParams struct {
+	Name           *string           `json:"name,omitempty"`
+	Priority       *int              `json:"priority,omitempty"`
+	SiteIDs        *[]string         `json:"site_ids,omitempty"`
+	Match          *TrafficMatch     `json:"match,omitempty"`
+	PreferredLinks []string          `json:"preferred_links,omitempty"`
+	SLA            *SLAThresholds    `json:"sla,omitempty"`
+	Failover       *FailoverBehavior `json:"failover,omitempty"`
+	Enabled        *bool             `json:"enabled,omitempty"`
+}
+
+// List retrieves all traffic policies in evaluation order
+func (s *TrafficPoliciesService) List(ctx context.Context) ([]TrafficPolicy, error) {
+	data, err := s.client.get(ctx, "/sdwan/traffic_policies", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []TrafficPolicy
+	if err := s.client.decode(data, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Create creates a new traffic policy
+func (s *TrafficPoliciesService) Create(ctx context.Context, params *CreateTrafficPolicyParams) (*TrafficPolicy, error) {
+	data, err := s.client.post(ctx, "/sdwan/traffic_policies", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy TrafficPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Get retrieves a traffic policy by ID
+func (s *TrafficPoliciesService) Get(ctx context.Context, policyID string) (*TrafficPolicy, error) {
+	data, err := s.client.get(ctx, "/sdwan/traffic_policies/"+policyID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy TrafficPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update updates a traffic policy
+func (s *TrafficPoliciesService) Update(ctx context.Context, policyID string, params *UpdateTrafficPolicyParams) (*TrafficPolicy, error) {
+	data, err := s.client.patch(ctx, "/sdwan/traffic_policies/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy TrafficPolicy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Delete deletes a traffic policy
+func (s *TrafficPoliciesService) Delete(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/sdwan/traffic_policies/"+policyID, nil)
+}
@@ -0,0 +1,114 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Site VLANs
+// =============================================================================
+
+// VLANsService provides access to the VLAN interfaces of individual sites
+type VLANsService struct {
+	client *Client
+}
+
+// VLAN is a routed LAN interface of a site's edge device. The device
+// answers on GatewayIP, which defaults to the first address of CIDR. A VLAN
+// with a SegmentID places its hosts in that network segment.
+type VLAN struct {
+	ID        string    `json:"id"`
+	SiteID    string    `json:"site_id"`
+	Name      string    `json:"name"`
+	VLANID    int       `json:"vlan_id"`
+	CIDR      string    `json:"cidr"`
+	GatewayIP string    `json:"gateway_ip"`
+	SegmentID string    `json:"segment_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateVLANParams contains parameters for adding a VLAN to a site. VLANID
+// is 1 to 4094 and unique within the site.
+type CreateVLANParams struct {
+	Name      string `json:"name"`
+	VLANID    int    `json:"vlan_id"`
+	CIDR      string `json:"cidr"`
+	GatewayIP string `json:"gateway_ip,omitempty"`
+	SegmentID string `json:"segment_id,omitempty"`
+}
+
+// UpdateVLANParams contains parameters for updating a VLAN. The VLAN ID
+// cannot be changed; an empty SegmentID removes the VLAN from its segment.
+type UpdateVLANParams struct {
+	Name      *string `json:"name,omitempty"`
+	CIDR      *string `json:"cidr,omitempty"`
+	GatewayIP *string `json:"gateway_ip,omitempty"`
+	SegmentID *string `json:"segment_id,omitempty"`
+}
+
+// List retrieves the VLANs of a site
+func (s *VLANsService) List(ctx context.Context, siteID string) ([]VLAN, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/vlans", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vlans []VLAN
+	if err := s.client.decode(data, &vlans); err != nil {
+		return nil, err
+	}
+
+	return vlans, nil
+}
+
+// Create adds a VLAN to a site
+func (s *VLANsService) Create(ctx context.Context, siteID string, params *CreateVLANParams) (*VLAN, error) {
+	data, err := s.client.post(ctx, "/sites/"+siteID+"/vlans", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vlan VLAN
+	if err := s.client.decode(data, &vlan); err != nil {
+		return nil, err
+	}
+
+	return &vlan, nil
+}
+
+// Get retrieves a VLAN of a site
+func (s *VLANsService) Get(ctx context.Context, siteID, vlanID string) (*VLAN, error) {
+	data, err := s.client.get(ctx, "/sites/"+siteID+"/vlans/"+vlanID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vlan VLAN
+	if err := s.client.decode(data, &vlan); err != nil {
+		return nil, err
+	}
+
+	return &vlan, nil
+}
+
+// Update updates a VLAN of a site
+func (s *VLANsService) Update(ctx context.Context, siteID, vlanID string, params *UpdateVLANParams) (*VLAN, error) {
+	data, err := s.client.patch(ctx, "/sites/"+siteID+"/vlans/"+vlanID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var vlan VLAN
+	if err := s.client.decode(data, &vlan); err != nil {
+		return nil, err
+	}
+
+	return &vlan, nil
+}
+
+// Delete removes a VLAN from a site
+func (s *VLANsService) Delete(ctx context.Context, siteID, vlanID string) error {
+	return s.client.delete(ctx, "/sites/"+siteID+"/vlans/"+vlanID, nil)
+}
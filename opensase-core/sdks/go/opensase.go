@@ -31,6 +31,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/billyronks/opensase-go/enum"
 )
 
 const (
@@ -50,16 +52,35 @@ const (
 // Client is the OpenSASE API client
 type Client struct {
 	// Services
-	Identity *IdentityService
-	CRM      *CRMService
-	Payments *PaymentsService
+	Identity   *IdentityService
+	CRM        *CRMService
+	Payments   *PaymentsService
+	Catalog    *CatalogService
+	Network    *NetworkService
+	Security   *SecurityService
+	Compliance *ComplianceService
+	Privacy    *PrivacyService
+	Analytics  *AnalyticsService
+	Monitoring *MonitoringService
+	Exports    *ExportsService
+	Jobs       *JobsService
+	Quotas     *QuotasService
+	Tenants    *TenantsService
+	Status     *StatusService
 
 	// Configuration
 	baseURL    string
 	apiKey     string
+	tenantID   string
 	httpClient *http.Client
 	maxRetries int
 	retryDelay time.Duration
+	scheduler  *scheduler
+	refCache   *referenceCache
+	middleware []Middleware
+	session    *TokenCache
+
+	strictDecoding bool
 }
 
 // ClientOption is a function that configures the client
@@ -72,6 +93,13 @@ func WithBaseURL(url string) ClientOption {
 	}
 }
 
+// WithTenant scopes every request to a tenant, for keys that can manage several
+func WithTenant(tenantID string) ClientOption {
+	return func(c *Client) {
+		c.tenantID = tenantID
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Client) {
@@ -86,6 +114,17 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// Middleware wraps the HTTP transport used for API requests
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// WithMiddleware wraps the client's transport. The first middleware given is
+// the outermost and sees each request first.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
 // WithMaxRetries sets the maximum number of retries
 func WithMaxRetries(maxRetries int) ClientOption {
 	return func(c *Client) {
@@ -102,10 +141,6 @@ func WithRetryDelay(delay time.Duration) ClientOption {
 
 // NewClient creates a new OpenSASE API client
 func NewClient(apiKey string, opts ...ClientOption) *Client {
-	if apiKey == "" {
-		panic("opensase: API key is required")
-	}
-
 	c := &Client{
 		baseURL: DefaultBaseURL,
 		apiKey:  apiKey,
@@ -119,22 +154,155 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.apiKey == "" && c.session == nil {
+		panic("opensase: API key is required")
+	}
+
+	// Applied last so that it also wraps a client given with WithHTTPClient,
+	// which is copied rather than modified
+	if len(c.middleware) > 0 {
+		hc := *c.httpClient
+		transport := hc.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		for i := len(c.middleware) - 1; i >= 0; i-- {
+			transport = c.middleware[i](transport)
+		}
+		hc.Transport = transport
+		c.httpClient = &hc
+	}
 
 	// Initialize services
-	c.Identity = &IdentityService{client: c}
-	c.CRM = &CRMService{client: c}
-	c.Payments = &PaymentsService{client: c}
+	c.Identity = &IdentityService{
+		client:  c,
+		Users:   &UsersService{client: c},
+		Auth:    &AuthService{client: c},
+		Groups:  &GroupsService{client: c},
+		Roles:   &RolesService{client: c},
+		APIKeys: &APIKeysService{client: c},
+		IdPs:    &IdentityProvidersService{client: c},
+		SCIM:    &SCIMService{client: c},
+		RADIUS:  &RADIUSServersService{client: c},
+		LDAP:    &LDAPServersService{client: c},
+	}
+	c.CRM = &CRMService{
+		client:    c,
+		Contacts:  &ContactsService{client: c},
+		Deals:     &DealsService{client: c},
+		Pipelines: &PipelinesService{client: c},
+		Sequences: &SequencesService{client: c},
+		Products:  &ProductsService{client: c},
+		Quotes:    &QuotesService{client: c},
+		Scoring:   &ScoringService{client: c},
+	}
+	c.Payments = &PaymentsService{
+		client:            c,
+		Intents:           &PaymentIntentsService{client: c},
+		Subscriptions:     &SubscriptionsService{client: c},
+		Refunds:           &RefundsService{client: c},
+		CheckoutSessions:  &CheckoutSessionsService{client: c},
+		Links:             &PaymentLinksService{client: c},
+		ConnectedAccounts: &ConnectedAccountsService{client: c},
+		Transfers:         &TransfersService{client: c},
+		Tax:               &TaxService{client: c},
+		Dunning:           &DunningService{client: c},
+	}
+	c.Catalog = &CatalogService{client: c}
+	c.Network = &NetworkService{
+		client:    c,
+		Sites:     &SitesService{client: c},
+		Templates: &SiteTemplatesService{client: c},
+		Tunnels:   &TunnelsService{client: c},
+		WANLinks:  &WANLinksService{client: c},
+		VLANs:     &VLANsService{client: c},
+		DHCP:      &DHCPServersService{client: c},
+		SNMP:      &SNMPService{client: c},
+		Syslog:    &SyslogServersService{client: c},
+		NAT:       &NATRulesService{client: c},
+		Routes:    &StaticRoutesService{client: c},
+		BGP:       &BGPPeersService{client: c},
+		Traffic:   &TrafficPoliciesService{client: c},
+		QoS:       &QoSProfilesService{client: c},
+		Devices:   &EdgeDevicesService{client: c},
+		HAPairs:   &HAPairsService{client: c},
+		Hosts:     &HostsService{client: c},
+		ClientVPN: &ClientVPNProfilesService{client: c},
+		LAN:       &LANService{client: c},
+		Segments:  &SegmentsService{client: c},
+		Vouchers:  &GuestVouchersService{client: c},
+		Cellular:  &CellularService{client: c},
+	}
+	c.Security = &SecurityService{
+		client:     c,
+		Policies:   &PoliciesService{client: c},
+		KMS:        &KMSService{client: c},
+		Apps:       &AppsService{client: c},
+		CustomApps: &CustomAppsService{client: c},
+		Firewall:   &FirewallRulesService{client: c},
+
+		ZTNAApplications:   &ZTNAApplicationsService{client: c},
+		ZTNAAccessPolicies: &ZTNAAccessPoliciesService{client: c},
+		URLFiltering:       &URLFilteringService{client: c},
+		DLP:                &DLPService{client: c},
+		DNS:                &DNSSecurityService{client: c},
+		ThreatPrevention:   &ThreatPreventionService{client: c},
+		SSLInspection:      &SSLInspectionService{client: c},
+		CASB:               &CASBService{client: c},
+		GeoRestriction:     &GeoRestrictionService{client: c},
+		BrowserIsolation:   &BrowserIsolationService{client: c},
+		AddressObjects:     &AddressObjectsService{client: c},
+		ServiceObjects:     &ServiceObjectsService{client: c},
+		Certificates:       &CertificatesService{client: c},
+	}
+	c.Security.Objects = &ObjectsService{
+		client:        c,
+		Addresses:     c.Security.AddressObjects,
+		Services:      c.Security.ServiceObjects,
+		ServiceGroups: &ServiceGroupsService{client: c},
+		Schedules:     &ScheduleObjectsService{client: c},
+	}
+	c.Compliance = &ComplianceService{
+		client:     c,
+		Reports:    &ComplianceReportsService{client: c},
+		Retention:  &RetentionService{client: c},
+		LegalHolds: &LegalHoldsService{client: c},
+	}
+	c.Privacy = &PrivacyService{
+		client:           c,
+		Pseudonymization: &PseudonymizationService{client: c},
+	}
+	c.Analytics = &AnalyticsService{
+		client:    c,
+		Anomalies: &AnomaliesService{client: c},
+	}
+	c.Monitoring = &MonitoringService{
+		client:     c,
+		Synthetics: &SyntheticsService{client: c},
+		Experience: &ExperienceService{client: c},
+		Logs:       &LogsService{client: c},
+		Alerts:     &AlertsService{client: c},
+		LogExports: &LogExportsService{client: c},
+
+		MaintenanceWindows: &MaintenanceWindowsService{client: c},
+	}
+	c.Exports = &ExportsService{client: c}
+	c.Jobs = &JobsService{client: c}
+	c.Quotas = &QuotasService{client: c}
+	c.Tenants = &TenantsService{client: c}
+	c.Status = &StatusService{client: c}
 
 	return c
 }
 
 // Error represents an API error
 type Error struct {
-	Code       string        `json:"code"`
-	Message    string        `json:"message"`
-	RequestID  string        `json:"request_id,omitempty"`
-	StatusCode int           `json:"-"`
-	Details    []ErrorDetail `json:"details,omitempty"`
+	Code             string        `json:"code"`
+	Message          string        `json:"message"`
+	RequestID        string        `json:"request_id,omitempty"`
+	StatusCode       int           `json:"-"`
+	Details          []ErrorDetail `json:"details,omitempty"`
+	DocumentationURL string        `json:"documentation_url,omitempty"`
 }
 
 // ErrorDetail provides additional error information
@@ -175,12 +343,21 @@ func (e *Error) IsRateLimitError() bool {
 
 // RateLimitError contains rate limit specific information
 type RateLimitError struct {
-	*Error
+	Err        *Error
 	RetryAfter int
 	Limit      int
 	Remaining  int
 }
 
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying API error
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
 // Pagination contains pagination information
 type Pagination struct {
 	Page       int `json:"page"`
@@ -208,15 +385,21 @@ type ListParams struct {
 type RequestOptions struct {
 	IdempotencyKey string
 	Headers        map[string]string
+
+	// DryRun validates a mutating request without committing it
+	DryRun bool
 }
 
 // Helper functions for optional parameters
-func String(v string) *string { return &v }
-func Int(v int) *int          { return &v }
-func Int64(v int64) *int64    { return &v }
-func Bool(v bool) *bool       { return &v }
+func String(v string) *string    { return &v }
+func Int(v int) *int             { return &v }
+func Int64(v int64) *int64       { return &v }
+func Bool(v bool) *bool          { return &v }
 func Float64(v float64) *float64 { return &v }
 
+// Ptr returns a pointer to v, for optional parameters of enum types
+func Ptr[T any](v T) *T { return &v }
+
 // request makes an HTTP request to the API
 func (c *Client) request(ctx context.Context, method, path string, body interface{}, opts *RequestOptions) (json.RawMessage, error) {
 	u, err := url.Parse(c.baseURL + path)
@@ -224,36 +407,60 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 		return nil, err
 	}
 
-	var bodyReader io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		bodyReader = bytes.NewReader(jsonBody)
+	}
+
+	authorization, err := c.authorization(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	var lastErr error
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		var bodyReader io.Reader
+		if jsonBody != nil {
+			bodyReader = bytes.NewReader(jsonBody)
+		}
+
 		req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 		if err != nil {
 			return nil, err
 		}
 
-		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("User-Agent", "opensase-go/"+Version)
+		if c.tenantID != "" {
+			req.Header.Set("X-Tenant-ID", c.tenantID)
+		}
+		callOptionsFrom(ctx).apply(req)
 
 		if opts != nil {
 			if opts.IdempotencyKey != "" {
 				req.Header.Set("Idempotency-Key", opts.IdempotencyKey)
 			}
+			if opts.DryRun && method != http.MethodGet {
+				req.Header.Set(DryRunHeader, "true")
+			}
 			for k, v := range opts.Headers {
 				req.Header.Set(k, v)
 			}
 		}
 
+		if c.scheduler != nil {
+			if err := c.scheduler.acquire(ctx, callOptionsFrom(ctx).requestPriority()); err != nil {
+				return nil, err
+			}
+		}
+
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = err
@@ -293,17 +500,7 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 			return nil, parseError(respBody, resp.StatusCode, requestID, resp.Header)
 		}
 
-		var response struct {
-			Data json.RawMessage `json:"data"`
-		}
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			// If it doesn't have a data wrapper, return the raw body
-			return respBody, nil
-		}
-
-		if response.Data != nil {
-			return response.Data, nil
-		}
+		// Envelopes are unwrapped by decode, which knows the target type
 		return respBody, nil
 	}
 
@@ -320,15 +517,16 @@ func isRetryable(statusCode int) bool {
 func parseError(body []byte, statusCode int, requestID string, headers http.Header) error {
 	var errorResponse struct {
 		Error struct {
-			Code    string        `json:"code"`
-			Message string        `json:"message"`
-			Details []ErrorDetail `json:"details,omitempty"`
+			Code             string        `json:"code"`
+			Message          string        `json:"message"`
+			Details          []ErrorDetail `json:"details,omitempty"`
+			DocumentationURL string        `json:"documentation_url,omitempty"`
 		} `json:"error"`
 	}
 
 	if err := json.Unmarshal(body, &errorResponse); err != nil {
 		return &Error{
-			Code:       "unknown_error",
+			Code:       string(ErrCodeUnknown),
 			Message:    string(body),
 			StatusCode: statusCode,
 			RequestID:  requestID,
@@ -336,11 +534,12 @@ func parseError(body []byte, statusCode int, requestID string, headers http.Head
 	}
 
 	apiErr := &Error{
-		Code:       errorResponse.Error.Code,
-		Message:    errorResponse.Error.Message,
-		StatusCode: statusCode,
-		RequestID:  requestID,
-		Details:    errorResponse.Error.Details,
+		Code:             errorResponse.Error.Code,
+		Message:          errorResponse.Error.Message,
+		StatusCode:       statusCode,
+		RequestID:        requestID,
+		Details:          errorResponse.Error.Details,
+		DocumentationURL: errorResponse.Error.DocumentationURL,
 	}
 
 	if statusCode == 429 {
@@ -349,7 +548,7 @@ func parseError(body []byte, statusCode int, requestID string, headers http.Head
 		remaining, _ := strconv.Atoi(headers.Get("X-RateLimit-Remaining"))
 
 		return &RateLimitError{
-			Error:      apiErr,
+			Err:        apiErr,
 			RetryAfter: retryAfter,
 			Limit:      limit,
 			Remaining:  remaining,
@@ -385,10 +584,16 @@ func (c *Client) delete(ctx context.Context, path string, opts *RequestOptions)
 
 // IdentityService provides access to identity management APIs
 type IdentityService struct {
-	client *Client
-	Users  *UsersService
-	Auth   *AuthService
-	Groups *GroupsService
+	client  *Client
+	Users   *UsersService
+	Auth    *AuthService
+	Groups  *GroupsService
+	Roles   *RolesService
+	APIKeys *APIKeysService
+	IdPs    *IdentityProvidersService
+	SCIM    *SCIMService
+	RADIUS  *RADIUSServersService
+	LDAP    *LDAPServersService
 }
 
 // UsersService provides access to user management APIs
@@ -463,6 +668,8 @@ type ListUsersParams struct {
 	Status  *string `json:"status,omitempty"`
 	Sort    *string `json:"sort,omitempty"`
 	Order   *string `json:"order,omitempty"`
+
+	Metadata *MetadataQuery `json:"-"`
 }
 
 // UserListResponse contains a list of users with pagination
@@ -493,6 +700,7 @@ func (s *UsersService) List(ctx context.Context, params *ListUsersParams) (*User
 		if params.Order != nil {
 			v.Set("order", *params.Order)
 		}
+		params.Metadata.encode(v)
 	}
 
 	data, err := s.client.get(ctx, "/identity/users", v, nil)
@@ -501,13 +709,8 @@ func (s *UsersService) List(ctx context.Context, params *ListUsersParams) (*User
 	}
 
 	var response UserListResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		// Try unmarshaling as array
-		var users []User
-		if err := json.Unmarshal(data, &users); err != nil {
-			return nil, err
-		}
-		response.Data = users
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
 	}
 
 	return &response, nil
@@ -521,13 +724,19 @@ func (s *UsersService) Create(ctx context.Context, params *CreateUserParams) (*U
 	}
 
 	var user User
-	if err := json.Unmarshal(data, &user); err != nil {
+	if err := s.client.decode(data, &user); err != nil {
 		return nil, err
 	}
 
 	return &user, nil
 }
 
+// BulkCreate creates several users in one request. Items that fail are
+// reported in the result rather than as an error; see BulkResult.
+func (s *UsersService) BulkCreate(ctx context.Context, params []CreateUserParams, opts *RequestOptions) (*BulkResult, error) {
+	return s.client.bulk(ctx, "/identity/users/bulk", params, opts)
+}
+
 // Get retrieves a user by ID
 func (s *UsersService) Get(ctx context.Context, userID string) (*User, error) {
 	data, err := s.client.get(ctx, "/identity/users/"+userID, nil, nil)
@@ -536,7 +745,7 @@ func (s *UsersService) Get(ctx context.Context, userID string) (*User, error) {
 	}
 
 	var user User
-	if err := json.Unmarshal(data, &user); err != nil {
+	if err := s.client.decode(data, &user); err != nil {
 		return nil, err
 	}
 
@@ -551,7 +760,7 @@ func (s *UsersService) Update(ctx context.Context, userID string, params *Update
 	}
 
 	var user User
-	if err := json.Unmarshal(data, &user); err != nil {
+	if err := s.client.decode(data, &user); err != nil {
 		return nil, err
 	}
 
@@ -563,6 +772,14 @@ func (s *UsersService) Delete(ctx context.Context, userID string) error {
 	return s.client.delete(ctx, "/identity/users/"+userID, nil)
 }
 
+// RevokeSessions signs a user out everywhere by revoking their access and
+// refresh tokens. It does not stop them signing in again; suspend the user
+// for that.
+func (s *UsersService) RevokeSessions(ctx context.Context, userID string) error {
+	_, err := s.client.post(ctx, "/identity/users/"+userID+"/sessions/revoke", nil, nil)
+	return err
+}
+
 // AuthService provides access to authentication APIs
 type AuthService struct {
 	client *Client
@@ -592,13 +809,13 @@ type LoginResponse struct {
 
 // Login authenticates a user
 func (s *AuthService) Login(ctx context.Context, params *LoginParams) (*LoginResponse, error) {
-	data, err := s.client.post(ctx, "/identity/auth/login", params, nil)
+	data, err := s.client.post(withoutSession(ctx), "/identity/auth/login", params, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var response LoginResponse
-	if err := json.Unmarshal(data, &response); err != nil {
+	if err := s.client.decode(data, &response); err != nil {
 		return nil, err
 	}
 
@@ -613,13 +830,13 @@ func (s *AuthService) VerifyMFA(ctx context.Context, mfaToken, method, code stri
 		"code":      code,
 	}
 
-	data, err := s.client.post(ctx, "/identity/auth/mfa/verify", params, nil)
+	data, err := s.client.post(withoutSession(ctx), "/identity/auth/mfa/verify", params, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var response LoginResponse
-	if err := json.Unmarshal(data, &response); err != nil {
+	if err := s.client.decode(data, &response); err != nil {
 		return nil, err
 	}
 
@@ -632,13 +849,13 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*LoginR
 		"refresh_token": refreshToken,
 	}
 
-	data, err := s.client.post(ctx, "/identity/auth/refresh", params, nil)
+	data, err := s.client.post(withoutSession(ctx), "/identity/auth/refresh", params, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var response LoginResponse
-	if err := json.Unmarshal(data, &response); err != nil {
+	if err := s.client.decode(data, &response); err != nil {
 		return nil, err
 	}
 
@@ -679,6 +896,19 @@ type CreateGroupParams struct {
 	Roles       []string `json:"roles,omitempty"`
 }
 
+// UpdateGroupParams contains parameters for updating a group
+type UpdateGroupParams struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Roles       *[]string `json:"roles,omitempty"`
+}
+
+// ListGroupMembersParams contains parameters for listing group members
+type ListGroupMembersParams struct {
+	Page    int `json:"page,omitempty"`
+	PerPage int `json:"per_page,omitempty"`
+}
+
 // Create creates a new group
 func (s *GroupsService) Create(ctx context.Context, params *CreateGroupParams) (*Group, error) {
 	data, err := s.client.post(ctx, "/identity/groups", params, nil)
@@ -687,7 +917,7 @@ func (s *GroupsService) Create(ctx context.Context, params *CreateGroupParams) (
 	}
 
 	var group Group
-	if err := json.Unmarshal(data, &group); err != nil {
+	if err := s.client.decode(data, &group); err != nil {
 		return nil, err
 	}
 
@@ -704,6 +934,76 @@ func (s *GroupsService) AddMembers(ctx context.Context, groupID string, userIDs
 	return err
 }
 
+// Get retrieves a group by ID
+func (s *GroupsService) Get(ctx context.Context, groupID string) (*Group, error) {
+	data, err := s.client.get(ctx, "/identity/groups/"+groupID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var group Group
+	if err := s.client.decode(data, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Update updates a group. Roles replaces the existing roles.
+func (s *GroupsService) Update(ctx context.Context, groupID string, params *UpdateGroupParams) (*Group, error) {
+	data, err := s.client.patch(ctx, "/identity/groups/"+groupID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var group Group
+	if err := s.client.decode(data, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Delete deletes a group. Its members are not deleted.
+func (s *GroupsService) Delete(ctx context.Context, groupID string) error {
+	return s.client.delete(ctx, "/identity/groups/"+groupID, nil)
+}
+
+// ListMembers retrieves the users in a group with pagination
+func (s *GroupsService) ListMembers(ctx context.Context, groupID string, params *ListGroupMembersParams) (*UserListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+	}
+
+	data, err := s.client.get(ctx, "/identity/groups/"+groupID+"/members", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response UserListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RemoveMembers removes members from a group
+func (s *GroupsService) RemoveMembers(ctx context.Context, groupID string, userIDs []string) error {
+	params := map[string][]string{
+		"user_ids": userIDs,
+	}
+
+	_, err := s.client.request(ctx, "DELETE", "/identity/groups/"+groupID+"/members", params, nil)
+	return err
+}
+
 // =============================================================================
 // CRM Service
 // =============================================================================
@@ -714,6 +1014,10 @@ type CRMService struct {
 	Contacts  *ContactsService
 	Deals     *DealsService
 	Pipelines *PipelinesService
+	Sequences *SequencesService
+	Products  *ProductsService
+	Quotes    *QuotesService
+	Scoring   *ScoringService
 }
 
 // ContactsService provides access to contact APIs
@@ -835,7 +1139,7 @@ func (s *ContactsService) List(ctx context.Context, params *ListContactsParams)
 	}
 
 	var response ContactListResponse
-	if err := json.Unmarshal(data, &response); err != nil {
+	if err := s.client.decode(data, &response); err != nil {
 		return nil, err
 	}
 
@@ -850,13 +1154,19 @@ func (s *ContactsService) Create(ctx context.Context, params *CreateContactParam
 	}
 
 	var contact Contact
-	if err := json.Unmarshal(data, &contact); err != nil {
+	if err := s.client.decode(data, &contact); err != nil {
 		return nil, err
 	}
 
 	return &contact, nil
 }
 
+// BulkCreate creates several contacts in one request. Items that fail are
+// reported in the result rather than as an error; see BulkResult.
+func (s *ContactsService) BulkCreate(ctx context.Context, params []CreateContactParams, opts *RequestOptions) (*BulkResult, error) {
+	return s.client.bulk(ctx, "/crm/contacts/bulk", params, opts)
+}
+
 // Get retrieves a contact by ID
 func (s *ContactsService) Get(ctx context.Context, contactID string) (*Contact, error) {
 	data, err := s.client.get(ctx, "/crm/contacts/"+contactID, nil, nil)
@@ -865,7 +1175,7 @@ func (s *ContactsService) Get(ctx context.Context, contactID string) (*Contact,
 	}
 
 	var contact Contact
-	if err := json.Unmarshal(data, &contact); err != nil {
+	if err := s.client.decode(data, &contact); err != nil {
 		return nil, err
 	}
 
@@ -947,7 +1257,7 @@ func (s *DealsService) Create(ctx context.Context, params *CreateDealParams) (*D
 	}
 
 	var deal Deal
-	if err := json.Unmarshal(data, &deal); err != nil {
+	if err := s.client.decode(data, &deal); err != nil {
 		return nil, err
 	}
 
@@ -969,7 +1279,7 @@ func (s *DealsService) MoveToStage(ctx context.Context, dealID, stageID string,
 	}
 
 	var deal Deal
-	if err := json.Unmarshal(data, &deal); err != nil {
+	if err := s.client.decode(data, &deal); err != nil {
 		return nil, err
 	}
 
@@ -987,10 +1297,16 @@ type PipelinesService struct {
 
 // PaymentsService provides access to payment APIs
 type PaymentsService struct {
-	client        *Client
-	Intents       *PaymentIntentsService
-	Subscriptions *SubscriptionsService
-	Refunds       *RefundsService
+	client            *Client
+	Intents           *PaymentIntentsService
+	Subscriptions     *SubscriptionsService
+	Refunds           *RefundsService
+	CheckoutSessions  *CheckoutSessionsService
+	Links             *PaymentLinksService
+	ConnectedAccounts *ConnectedAccountsService
+	Transfers         *TransfersService
+	Tax               *TaxService
+	Dunning           *DunningService
 }
 
 // PaymentIntentsService provides access to payment intent APIs
@@ -1000,22 +1316,32 @@ type PaymentIntentsService struct {
 
 // PaymentIntent represents a payment intent
 type PaymentIntent struct {
-	ID               string                 `json:"id"`
-	Amount           int64                  `json:"amount"`
-	Currency         string                 `json:"currency"`
-	Status           string                 `json:"status"`
-	ClientSecret     string                 `json:"client_secret,omitempty"`
-	CustomerID       string                 `json:"customer_id,omitempty"`
-	PaymentMethodID  string                 `json:"payment_method_id,omitempty"`
-	PaymentMethod    *PaymentMethod         `json:"payment_method,omitempty"`
-	CaptureMethod    string                 `json:"capture_method"`
-	AmountCapturable int64                  `json:"amount_capturable,omitempty"`
-	AmountReceived   int64                  `json:"amount_received,omitempty"`
-	NextAction       *NextAction            `json:"next_action,omitempty"`
-	Charges          []Charge               `json:"charges,omitempty"`
-	Metadata         map[string]interface{} `json:"metadata,omitempty"`
-	ReceiptEmail     string                 `json:"receipt_email,omitempty"`
-	CreatedAt        time.Time              `json:"created_at"`
+	ID               string                   `json:"id"`
+	Amount           int64                    `json:"amount"`
+	Currency         string                   `json:"currency"`
+	Status           enum.PaymentIntentStatus `json:"status"`
+	ClientSecret     string                   `json:"client_secret,omitempty"`
+	CustomerID       string                   `json:"customer_id,omitempty"`
+	PaymentMethodID  string                   `json:"payment_method_id,omitempty"`
+	PaymentMethod    *PaymentMethod           `json:"payment_method,omitempty"`
+	CaptureMethod    string                   `json:"capture_method"`
+	AmountCapturable int64                    `json:"amount_capturable,omitempty"`
+	AmountReceived   int64                    `json:"amount_received,omitempty"`
+	NextAction       *NextAction              `json:"next_action,omitempty"`
+	Charges          []Charge                 `json:"charges,omitempty"`
+	Metadata         map[string]interface{}   `json:"metadata,omitempty"`
+	ReceiptEmail     string                   `json:"receipt_email,omitempty"`
+	OnBehalfOf       string                   `json:"on_behalf_of,omitempty"`
+	TransferData     *TransferData            `json:"transfer_data,omitempty"`
+	TransferGroup    string                   `json:"transfer_group,omitempty"`
+	ApplicationFee   int64                    `json:"application_fee_amount,omitempty"`
+	CreatedAt        time.Time                `json:"created_at"`
+}
+
+// TransferData routes funds from a payment to a connected account (destination charge)
+type TransferData struct {
+	DestinationID string `json:"destination_id"`
+	Amount        *int64 `json:"amount,omitempty"`
 }
 
 // PaymentMethod represents a payment method
@@ -1062,6 +1388,67 @@ type CreatePaymentIntentParams struct {
 	CaptureMethod      string                 `json:"capture_method,omitempty"`
 	Metadata           map[string]interface{} `json:"metadata,omitempty"`
 	ReceiptEmail       string                 `json:"receipt_email,omitempty"`
+
+	// Marketplace fields for destination charges and separate transfers
+	OnBehalfOf           string        `json:"on_behalf_of,omitempty"`
+	TransferData         *TransferData `json:"transfer_data,omitempty"`
+	TransferGroup        string        `json:"transfer_group,omitempty"`
+	ApplicationFeeAmount *int64        `json:"application_fee_amount,omitempty"`
+}
+
+// ListPaymentIntentsParams contains parameters for listing payment intents
+type ListPaymentIntentsParams struct {
+	Page          int                       `json:"page,omitempty"`
+	PerPage       int                       `json:"per_page,omitempty"`
+	CustomerID    *string                   `json:"customer_id,omitempty"`
+	Status        *enum.PaymentIntentStatus `json:"status,omitempty"`
+	CreatedAfter  *time.Time                `json:"created_after,omitempty"`
+	CreatedBefore *time.Time                `json:"created_before,omitempty"`
+	Metadata      *MetadataQuery            `json:"-"`
+}
+
+// PaymentIntentListResponse contains a list of payment intents with pagination
+type PaymentIntentListResponse struct {
+	Data       []PaymentIntent `json:"data"`
+	Pagination Pagination      `json:"pagination"`
+}
+
+// List retrieves payment intents with pagination
+func (s *PaymentIntentsService) List(ctx context.Context, params *ListPaymentIntentsParams) (*PaymentIntentListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.CustomerID != nil {
+			v.Set("customer_id", *params.CustomerID)
+		}
+		if params.Status != nil {
+			v.Set("status", params.Status.String())
+		}
+		if params.CreatedAfter != nil {
+			v.Set("created_after", params.CreatedAfter.UTC().Format(time.RFC3339))
+		}
+		if params.CreatedBefore != nil {
+			v.Set("created_before", params.CreatedBefore.UTC().Format(time.RFC3339))
+		}
+		params.Metadata.encode(v)
+	}
+
+	data, err := s.client.get(ctx, "/payments/intents", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response PaymentIntentListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
 }
 
 // Create creates a new payment intent
@@ -1072,7 +1459,7 @@ func (s *PaymentIntentsService) Create(ctx context.Context, params *CreatePaymen
 	}
 
 	var intent PaymentIntent
-	if err := json.Unmarshal(data, &intent); err != nil {
+	if err := s.client.decode(data, &intent); err != nil {
 		return nil, err
 	}
 
@@ -1087,7 +1474,7 @@ func (s *PaymentIntentsService) Get(ctx context.Context, intentID string) (*Paym
 	}
 
 	var intent PaymentIntent
-	if err := json.Unmarshal(data, &intent); err != nil {
+	if err := s.client.decode(data, &intent); err != nil {
 		return nil, err
 	}
 
@@ -1109,7 +1496,7 @@ func (s *PaymentIntentsService) Confirm(ctx context.Context, intentID, paymentMe
 	}
 
 	var intent PaymentIntent
-	if err := json.Unmarshal(data, &intent); err != nil {
+	if err := s.client.decode(data, &intent); err != nil {
 		return nil, err
 	}
 
@@ -1129,7 +1516,7 @@ func (s *PaymentIntentsService) Capture(ctx context.Context, intentID string, am
 	}
 
 	var intent PaymentIntent
-	if err := json.Unmarshal(data, &intent); err != nil {
+	if err := s.client.decode(data, &intent); err != nil {
 		return nil, err
 	}
 
@@ -1149,7 +1536,7 @@ func (s *PaymentIntentsService) Cancel(ctx context.Context, intentID string, rea
 	}
 
 	var intent PaymentIntent
-	if err := json.Unmarshal(data, &intent); err != nil {
+	if err := s.client.decode(data, &intent); err != nil {
 		return nil, err
 	}
 
@@ -1163,21 +1550,21 @@ type SubscriptionsService struct {
 
 // Subscription represents a subscription
 type Subscription struct {
-	ID                     string                 `json:"id"`
-	CustomerID             string                 `json:"customer_id"`
-	Plan                   *SubscriptionPlan      `json:"plan"`
-	Status                 string                 `json:"status"`
-	CurrentPeriodStart     time.Time              `json:"current_period_start"`
-	CurrentPeriodEnd       time.Time              `json:"current_period_end"`
-	TrialStart             *time.Time             `json:"trial_start,omitempty"`
-	TrialEnd               *time.Time             `json:"trial_end,omitempty"`
-	CancelAtPeriodEnd      bool                   `json:"cancel_at_period_end"`
-	CanceledAt             *time.Time             `json:"canceled_at,omitempty"`
-	CancelAt               *time.Time             `json:"cancel_at,omitempty"`
-	DefaultPaymentMethodID string                 `json:"default_payment_method_id,omitempty"`
-	LatestInvoice          *InvoiceRef            `json:"latest_invoice,omitempty"`
-	Metadata               map[string]interface{} `json:"metadata,omitempty"`
-	CreatedAt              time.Time              `json:"created_at"`
+	ID                     string                  `json:"id"`
+	CustomerID             string                  `json:"customer_id"`
+	Plan                   *SubscriptionPlan       `json:"plan"`
+	Status                 enum.SubscriptionStatus `json:"status"`
+	CurrentPeriodStart     time.Time               `json:"current_period_start"`
+	CurrentPeriodEnd       time.Time               `json:"current_period_end"`
+	TrialStart             *time.Time              `json:"trial_start,omitempty"`
+	TrialEnd               *time.Time              `json:"trial_end,omitempty"`
+	CancelAtPeriodEnd      bool                    `json:"cancel_at_period_end"`
+	CanceledAt             *time.Time              `json:"canceled_at,omitempty"`
+	CancelAt               *time.Time              `json:"cancel_at,omitempty"`
+	DefaultPaymentMethodID string                  `json:"default_payment_method_id,omitempty"`
+	LatestInvoice          *InvoiceRef             `json:"latest_invoice,omitempty"`
+	Metadata               map[string]interface{}  `json:"metadata,omitempty"`
+	CreatedAt              time.Time               `json:"created_at"`
 }
 
 // SubscriptionPlan represents a subscription plan
@@ -1192,9 +1579,11 @@ type SubscriptionPlan struct {
 
 // InvoiceRef is a reference to an invoice
 type InvoiceRef struct {
-	ID        string `json:"id"`
-	AmountDue int64  `json:"amount_due"`
-	Status    string `json:"status"`
+	ID        string    `json:"id"`
+	AmountDue int64     `json:"amount_due"`
+	Status    string    `json:"status"`
+	Tax       int64     `json:"tax,omitempty"`
+	TaxLines  []TaxLine `json:"tax_lines,omitempty"`
 }
 
 // CreateSubscriptionParams contains parameters for creating a subscription
@@ -1208,6 +1597,57 @@ type CreateSubscriptionParams struct {
 	Metadata           map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// ListSubscriptionsParams contains parameters for listing subscriptions
+type ListSubscriptionsParams struct {
+	Page       int                      `json:"page,omitempty"`
+	PerPage    int                      `json:"per_page,omitempty"`
+	CustomerID *string                  `json:"customer_id,omitempty"`
+	Status     *enum.SubscriptionStatus `json:"status,omitempty"`
+	PlanID     *string                  `json:"plan_id,omitempty"`
+	Metadata   *MetadataQuery           `json:"-"`
+}
+
+// SubscriptionListResponse contains a list of subscriptions with pagination
+type SubscriptionListResponse struct {
+	Data       []Subscription `json:"data"`
+	Pagination Pagination     `json:"pagination"`
+}
+
+// List retrieves subscriptions with pagination
+func (s *SubscriptionsService) List(ctx context.Context, params *ListSubscriptionsParams) (*SubscriptionListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.CustomerID != nil {
+			v.Set("customer_id", *params.CustomerID)
+		}
+		if params.Status != nil {
+			v.Set("status", params.Status.String())
+		}
+		if params.PlanID != nil {
+			v.Set("plan_id", *params.PlanID)
+		}
+		params.Metadata.encode(v)
+	}
+
+	data, err := s.client.get(ctx, "/payments/subscriptions", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Create creates a new subscription
 func (s *SubscriptionsService) Create(ctx context.Context, params *CreateSubscriptionParams, opts *RequestOptions) (*Subscription, error) {
 	data, err := s.client.post(ctx, "/payments/subscriptions", params, opts)
@@ -1216,7 +1656,7 @@ func (s *SubscriptionsService) Create(ctx context.Context, params *CreateSubscri
 	}
 
 	var sub Subscription
-	if err := json.Unmarshal(data, &sub); err != nil {
+	if err := s.client.decode(data, &sub); err != nil {
 		return nil, err
 	}
 
@@ -1238,7 +1678,7 @@ func (s *SubscriptionsService) Cancel(ctx context.Context, subscriptionID string
 	}
 
 	var sub Subscription
-	if err := json.Unmarshal(data, &sub); err != nil {
+	if err := s.client.decode(data, &sub); err != nil {
 		return nil, err
 	}
 
@@ -1280,7 +1720,7 @@ func (s *RefundsService) Create(ctx context.Context, params *CreateRefundParams,
 	}
 
 	var refund Refund
-	if err := json.Unmarshal(data, &refund); err != nil {
+	if err := s.client.decode(data, &refund); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,210 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Payments Tax
+// =============================================================================
+
+// TaxService provides access to tax registration, calculation and reporting APIs
+type TaxService struct {
+	client *Client
+}
+
+// TaxRegistration represents a jurisdiction in which the tenant collects tax
+type TaxRegistration struct {
+	ID                 string     `json:"id"`
+	Country            string     `json:"country"`
+	State              string     `json:"state,omitempty"`
+	Type               string     `json:"type"`
+	RegistrationNumber string     `json:"registration_number,omitempty"`
+	Status             string     `json:"status"`
+	ActiveFrom         time.Time  `json:"active_from"`
+	ExpiresAt          *time.Time `json:"expires_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+// CreateTaxRegistrationParams contains parameters for creating a tax registration
+type CreateTaxRegistrationParams struct {
+	Country            string  `json:"country"`
+	State              string  `json:"state,omitempty"`
+	Type               string  `json:"type"`
+	RegistrationNumber string  `json:"registration_number,omitempty"`
+	ActiveFrom         *string `json:"active_from,omitempty"`
+}
+
+// AutomaticTax enables automatic tax calculation on a checkout session or invoice
+type AutomaticTax struct {
+	Enabled bool   `json:"enabled"`
+	Status  string `json:"status,omitempty"`
+}
+
+// TaxLine is the tax applied to a single line of an invoice or checkout
+type TaxLine struct {
+	LineReference string  `json:"line_reference,omitempty"`
+	Jurisdiction  string  `json:"jurisdiction"`
+	TaxType       string  `json:"tax_type"`
+	Rate          float64 `json:"rate"`
+	TaxableAmount int64   `json:"taxable_amount"`
+	Amount        int64   `json:"amount"`
+	Inclusive     bool    `json:"inclusive"`
+	Reason        string  `json:"taxability_reason,omitempty"`
+}
+
+// TaxCalculation is the result of a tax calculation
+type TaxCalculation struct {
+	ID                string    `json:"id"`
+	Currency          string    `json:"currency"`
+	AmountTotal       int64     `json:"amount_total"`
+	TaxAmountExcluded int64     `json:"tax_amount_exclusive"`
+	TaxAmountIncluded int64     `json:"tax_amount_inclusive"`
+	Lines             []TaxLine `json:"tax_lines"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+// TaxCalculationLineItem is a line item submitted for tax calculation
+type TaxCalculationLineItem struct {
+	Reference string `json:"reference"`
+	Amount    int64  `json:"amount"`
+	Quantity  int    `json:"quantity,omitempty"`
+	TaxCode   string `json:"tax_code,omitempty"`
+}
+
+// CalculateTaxParams contains parameters for an ad-hoc tax calculation
+type CalculateTaxParams struct {
+	Currency        string                   `json:"currency"`
+	CustomerID      string                   `json:"customer_id,omitempty"`
+	CustomerAddress *Address                 `json:"customer_address,omitempty"`
+	LineItems       []TaxCalculationLineItem `json:"line_items"`
+}
+
+// TaxReportParams contains parameters for exporting a tax report
+type TaxReportParams struct {
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+	Country     string `json:"country,omitempty"`
+	Format      string `json:"format,omitempty"`
+}
+
+// TaxReport represents an exported tax report
+type TaxReport struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	PeriodStart string     `json:"period_start"`
+	PeriodEnd   string     `json:"period_end"`
+	Format      string     `json:"format"`
+	DownloadURL string     `json:"download_url,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListRegistrations retrieves all tax registrations
+func (s *TaxService) ListRegistrations(ctx context.Context) ([]TaxRegistration, error) {
+	data, err := s.client.get(ctx, "/payments/tax/registrations", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var registrations []TaxRegistration
+	if err := s.client.decode(data, &registrations); err != nil {
+		return nil, err
+	}
+
+	return registrations, nil
+}
+
+// CreateRegistration creates a new tax registration
+func (s *TaxService) CreateRegistration(ctx context.Context, params *CreateTaxRegistrationParams) (*TaxRegistration, error) {
+	data, err := s.client.post(ctx, "/payments/tax/registrations", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var registration TaxRegistration
+	if err := s.client.decode(data, &registration); err != nil {
+		return nil, err
+	}
+
+	return &registration, nil
+}
+
+// ExpireRegistration stops collecting tax for a registration
+func (s *TaxService) ExpireRegistration(ctx context.Context, registrationID string) error {
+	return s.client.delete(ctx, "/payments/tax/registrations/"+registrationID, nil)
+}
+
+// Calculate computes tax for an arbitrary set of line items without persisting it
+func (s *TaxService) Calculate(ctx context.Context, params *CalculateTaxParams) (*TaxCalculation, error) {
+	data, err := s.client.post(ctx, "/payments/tax/calculations", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var calculation TaxCalculation
+	if err := s.client.decode(data, &calculation); err != nil {
+		return nil, err
+	}
+
+	return &calculation, nil
+}
+
+// PreviewInvoice previews the tax lines that would be applied to an invoice
+func (s *TaxService) PreviewInvoice(ctx context.Context, invoiceID string) (*TaxCalculation, error) {
+	return s.preview(ctx, "invoice_id", invoiceID)
+}
+
+// PreviewCheckout previews the tax lines that would be applied to a checkout session
+func (s *TaxService) PreviewCheckout(ctx context.Context, sessionID string) (*TaxCalculation, error) {
+	return s.preview(ctx, "checkout_session_id", sessionID)
+}
+
+func (s *TaxService) preview(ctx context.Context, key, id string) (*TaxCalculation, error) {
+	v := url.Values{}
+	v.Set(key, id)
+
+	data, err := s.client.get(ctx, "/payments/tax/calculations/preview", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var calculation TaxCalculation
+	if err := s.client.decode(data, &calculation); err != nil {
+		return nil, err
+	}
+
+	return &calculation, nil
+}
+
+// CreateReport starts an asynchronous tax report export
+func (s *TaxService) CreateReport(ctx context.Context, params *TaxReportParams) (*TaxReport, error) {
+	data, err := s.client.post(ctx, "/payments/tax/reports", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var report TaxReport
+	if err := s.client.decode(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// GetReport retrieves a tax report, including its download URL once ready
+func (s *TaxService) GetReport(ctx context.Context, reportID string) (*TaxReport, error) {
+	data, err := s.client.get(ctx, "/payments/tax/reports/"+reportID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var report TaxReport
+	if err := s.client.decode(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
@@ -0,0 +1,137 @@
+package opensase
+
+import (
+	"reflect"
+	"testing"
+)
+
+// applyPolicyMoves applies moves to order the way the server does
+func applyPolicyMoves(t *testing.T, order []string, moves []PolicyMove) []string {
+	t.Helper()
+	out := append([]string{}, order...)
+	for _, m := range moves {
+		from := indexOf(out, m.PolicyID)
+		if from < 0 {
+			t.Fatalf("move of unknown policy %s", m.PolicyID)
+		}
+		out = append(out[:from], out[from+1:]...)
+
+		to := 0
+		if m.AfterID != "" {
+			after := indexOf(out, m.AfterID)
+			if after < 0 {
+				t.Fatalf("move of %s after unknown policy %s", m.PolicyID, m.AfterID)
+			}
+			to = after + 1
+		}
+		out = append(out[:to], append([]string{m.PolicyID}, out[to:]...)...)
+	}
+	return out
+}
+
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestComputePolicyMoves(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   []string
+		desired   []string
+		wantMoves int
+		wantErr   bool
+	}{
+		{
+			name:      "empty",
+			current:   []string{},
+			desired:   []string{},
+			wantMoves: 0,
+		},
+		{
+			name:      "identity",
+			current:   []string{"a", "b", "c", "d", "e"},
+			desired:   []string{"a", "b", "c", "d", "e"},
+			wantMoves: 0,
+		},
+		{
+			name:      "last to front",
+			current:   []string{"a", "b", "c", "d", "e"},
+			desired:   []string{"e", "a", "b", "c", "d"},
+			wantMoves: 1,
+		},
+		{
+			name:      "first to back",
+			current:   []string{"a", "b", "c", "d", "e"},
+			desired:   []string{"b", "c", "d", "e", "a"},
+			wantMoves: 1,
+		},
+		{
+			name:      "middle to front",
+			current:   []string{"a", "b", "c", "d", "e"},
+			desired:   []string{"c", "a", "b", "d", "e"},
+			wantMoves: 1,
+		},
+		{
+			name:      "swap of neighbours",
+			current:   []string{"a", "b", "c", "d", "e"},
+			desired:   []string{"a", "c", "b", "d", "e"},
+			wantMoves: 1,
+		},
+		{
+			name:      "full reversal",
+			current:   []string{"a", "b", "c", "d", "e"},
+			desired:   []string{"e", "d", "c", "b", "a"},
+			wantMoves: 4,
+		},
+		{
+			name:      "interleaved",
+			current:   []string{"a", "b", "c", "d", "e", "f"},
+			desired:   []string{"d", "a", "e", "b", "f", "c"},
+			wantMoves: 3,
+		},
+		{
+			name:    "desired ID missing from current",
+			current: []string{"a", "b", "c"},
+			desired: []string{"a", "b", "x"},
+			wantErr: true,
+		},
+		{
+			name:    "different lengths",
+			current: []string{"a", "b", "c"},
+			desired: []string{"a", "b", "c", "d"},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate in desired",
+			current: []string{"a", "b", "c"},
+			desired: []string{"a", "b", "b"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves, err := ComputePolicyMoves(tt.current, tt.desired)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ComputePolicyMoves() = %+v, want an error", moves)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ComputePolicyMoves() error = %v", err)
+			}
+			if len(moves) != tt.wantMoves {
+				t.Errorf("ComputePolicyMoves() made %d moves %+v, want %d", len(moves), moves, tt.wantMoves)
+			}
+			if got := applyPolicyMoves(t, tt.current, moves); !reflect.DeepEqual(got, tt.desired) {
+				t.Errorf("applying %+v to %v = %v, want %v", moves, tt.current, got, tt.desired)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package opensase
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// Data Subject Requests
+// =============================================================================
+
+// Data subject request operation statuses
+const (
+	DSAROperationPending   = "pending"
+	DSAROperationRunning   = "running"
+	DSAROperationSucceeded = "succeeded"
+	DSAROperationFailed    = "failed"
+)
+
+// Data stores covered by data subject requests
+const (
+	DataStoreIdentity = "identity"
+	DataStoreCRM      = "crm"
+	DataStoreLogs     = "logs"
+)
+
+// DataSubject identifies the person a data subject request is about.
+// At least one field must be set.
+type DataSubject struct {
+	UserID    string `json:"user_id,omitempty"`
+	ContactID string `json:"contact_id,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+// DataSubjectRequestParams contains parameters for exporting or erasing a subject's data.
+// Stores defaults to every store.
+type DataSubjectRequestParams struct {
+	Subject     DataSubject `json:"subject"`
+	Stores      []string    `json:"stores,omitempty"`
+	ExternalRef string      `json:"external_reference,omitempty"`
+}
+
+// DSAROperation is a handle to an asynchronous export or erasure
+type DSAROperation struct {
+	ID          string           `json:"id"`
+	Type        string           `json:"type"`
+	Status      string           `json:"status"`
+	Subject     DataSubject      `json:"subject"`
+	Stores      []DSARStoreState `json:"stores"`
+	ExternalRef string           `json:"external_reference,omitempty"`
+	DownloadURL string           `json:"download_url,omitempty"`
+	ExpiresAt   *time.Time       `json:"expires_at,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	CompletedAt *time.Time       `json:"completed_at,omitempty"`
+}
+
+// DSARStoreState is the progress of an operation in a single data store
+type DSARStoreState struct {
+	Store       string `json:"store"`
+	Status      string `json:"status"`
+	Records     int64  `json:"records"`
+	Withheld    int64  `json:"withheld,omitempty"`
+	WithheldWhy string `json:"withheld_reason,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// CompletionCertificate attests that a data subject request was fulfilled
+type CompletionCertificate struct {
+	OperationID string           `json:"operation_id"`
+	Type        string           `json:"type"`
+	Subject     DataSubject      `json:"subject"`
+	Stores      []DSARStoreState `json:"stores"`
+	ExternalRef string           `json:"external_reference,omitempty"`
+	IssuedAt    time.Time        `json:"issued_at"`
+	Signature   string           `json:"signature"`
+	KeyID       string           `json:"key_id"`
+	PDFURL      string           `json:"pdf_url,omitempty"`
+}
+
+// ExportUserData starts an export of everything held about a data subject
+func (s *PrivacyService) ExportUserData(ctx context.Context, params *DataSubjectRequestParams, opts *RequestOptions) (*DSAROperation, error) {
+	return s.startOperation(ctx, "/privacy/exports", params, opts)
+}
+
+// EraseUserData starts erasure of a data subject's data. Records under a
+// legal hold are withheld and reported on the operation.
+func (s *PrivacyService) EraseUserData(ctx context.Context, params *DataSubjectRequestParams, opts *RequestOptions) (*DSAROperation, error) {
+	return s.startOperation(ctx, "/privacy/erasures", params, opts)
+}
+
+func (s *PrivacyService) startOperation(ctx context.Context, path string, params *DataSubjectRequestParams, opts *RequestOptions) (*DSAROperation, error) {
+	subject := params.Subject
+	if subject.UserID == "" && subject.ContactID == "" && subject.Email == "" {
+		return nil, fmt.Errorf("opensase: data subject requires a user_id, contact_id or email")
+	}
+
+	data, err := s.client.post(ctx, path, params, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var op DSAROperation
+	if err := s.client.decode(data, &op); err != nil {
+		return nil, err
+	}
+
+	return &op, nil
+}
+
+// GetOperation retrieves a data subject request operation by ID
+func (s *PrivacyService) GetOperation(ctx context.Context, operationID string) (*DSAROperation, error) {
+	data, err := s.client.get(ctx, "/privacy/operations/"+operationID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var op DSAROperation
+	if err := s.client.decode(data, &op); err != nil {
+		return nil, err
+	}
+
+	return &op, nil
+}
+
+// WaitOperation polls an operation until it succeeds or fails
+func (s *PrivacyService) WaitOperation(ctx context.Context, operationID string, interval time.Duration) (*DSAROperation, error) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		op, err := s.GetOperation(ctx, operationID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op.Status {
+		case DSAROperationSucceeded:
+			return op, nil
+		case DSAROperationFailed:
+			return op, fmt.Errorf("opensase: privacy operation %s failed", op.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// GetCertificate retrieves the completion certificate of a succeeded operation
+func (s *PrivacyService) GetCertificate(ctx context.Context, operationID string) (*CompletionCertificate, error) {
+	data, err := s.client.get(ctx, "/privacy/operations/"+operationID+"/certificate", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert CompletionCertificate
+	if err := s.client.decode(data, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
@@ -0,0 +1,396 @@
+// Package provisioning onboards branch sites.
+//
+// A SiteSpec describes everything a branch needs: the site, its WAN links
+// and VLANs, the SD-WAN traffic policies it joins and the edge device to
+// claim for it. An Onboarder applies specs in dependency order, several
+// sites at a time, and rolls back a site whose onboarding fails so the spec
+// can simply be run again:
+//
+//	ob := provisioning.New(client,
+//	    provisioning.WithConcurrency(8),
+//	    provisioning.WithProgress(func(e provisioning.Event) {
+//	        log.Printf("%s: %s %s", e.Site, e.Step, e.Status)
+//	    }),
+//	)
+//	results, err := ob.Run(ctx, specs)
+//
+// The device is claimed last, so an appliance only comes online once its
+// site is fully configured.
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/internal/saga"
+)
+
+// Defaults used when no option overrides them
+const (
+	DefaultConcurrency     = 4
+	DefaultRollbackTimeout = 2 * time.Minute
+)
+
+// Step is a stage of onboarding a site. Steps run in the order declared.
+type Step string
+
+const (
+	StepSite     Step = "site"
+	StepWANLinks Step = "wan_links"
+	StepVLANs    Step = "vlans"
+	StepPolicies Step = "policies"
+	StepDevice   Step = "device"
+)
+
+// Status is the state of a step reported in an Event
+type Status string
+
+const (
+	StatusStarted    Status = "started"
+	StatusDone       Status = "done"
+	StatusFailed     Status = "failed"
+	StatusRolledBack Status = "rolled_back"
+)
+
+// SiteSpec describes a site to onboard. Steps with nothing to do are
+// skipped.
+type SiteSpec struct {
+	Site     opensase.CreateSiteParams      `json:"site"`
+	WANLinks []opensase.CreateWANLinkParams `json:"wan_links,omitempty"`
+	VLANs    []opensase.CreateVLANParams    `json:"vlans,omitempty"`
+	// TrafficPolicyIDs are SD-WAN traffic policies extended to the site.
+	// Policies without site IDs already apply to every site and are left
+	// unchanged.
+	TrafficPolicyIDs []string `json:"traffic_policy_ids,omitempty"`
+	// Device is claimed for the site; its SiteID is set by the Onboarder
+	Device *opensase.ClaimDeviceParams `json:"device,omitempty"`
+}
+
+// Event reports progress on one site. Err is set on failed events. For
+// rolled back events Step is the step that failed.
+type Event struct {
+	Site   string
+	SiteID string
+	Step   Step
+	Status Status
+	Err    error
+}
+
+// Result is the outcome of onboarding one site
+type Result struct {
+	Name     string `json:"name"`
+	SiteID   string `json:"site_id,omitempty"`
+	DeviceID string `json:"device_id,omitempty"`
+	// Completed are the steps that succeeded, in order
+	Completed []Step `json:"completed"`
+	Err       error  `json:"-"`
+	// RolledBack is set when a failed site was removed again. RollbackErr
+	// holds anything that could not be undone.
+	RolledBack  bool  `json:"rolled_back"`
+	RollbackErr error `json:"-"`
+}
+
+// MarshalJSON includes the errors of a result as strings
+func (r Result) MarshalJSON() ([]byte, error) {
+	type result Result
+	return json.Marshal(struct {
+		result
+		saga.Errors
+	}{result(r), saga.ErrorStrings(r.Err, r.RollbackErr)})
+}
+
+// Option configures an Onboarder
+type Option func(*Onboarder)
+
+// WithConcurrency sets how many sites are onboarded at a time
+func WithConcurrency(n int) Option {
+	return func(o *Onboarder) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithProgress calls fn for every step started, finished, failed or rolled
+// back. Calls are serialized, so fn need not be safe for concurrent use,
+// and should return quickly.
+func WithProgress(fn func(Event)) Option {
+	return func(o *Onboarder) {
+		o.progress = fn
+	}
+}
+
+// WithoutRollback leaves the completed steps of a failed site in place, for
+// inspecting what went wrong. The site must then be cleaned up by hand
+// before its spec is run again.
+func WithoutRollback() Option {
+	return func(o *Onboarder) {
+		o.rollback = false
+	}
+}
+
+// WithRollbackTimeout bounds the time spent undoing a failed site. Rollback
+// runs even after the context passed to Run is cancelled.
+func WithRollbackTimeout(d time.Duration) Option {
+	return func(o *Onboarder) {
+		o.rollbackTimeout = d
+	}
+}
+
+// Onboarder applies site specs
+type Onboarder struct {
+	client          *opensase.Client
+	concurrency     int
+	progress        func(Event)
+	rollback        bool
+	rollbackTimeout time.Duration
+
+	progressMu sync.Mutex
+	// policyLocks serializes the read-modify-write of a traffic policy's
+	// site list between sites onboarded concurrently
+	policyLocks sync.Map
+}
+
+// New returns an Onboarder using client
+func New(client *opensase.Client, opts ...Option) *Onboarder {
+	o := &Onboarder{
+		client:          client,
+		concurrency:     DefaultConcurrency,
+		rollback:        true,
+		rollbackTimeout: DefaultRollbackTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Run onboards the sites in specs and returns a result for each, in the
+// same order. A failed site does not stop the others; the returned error
+// reports how many failed. Specs are checked before anything is created.
+// When ctx is cancelled, sites not yet started fail with the context's
+// error and sites in progress are rolled back.
+func (o *Onboarder) Run(ctx context.Context, specs []SiteSpec) ([]Result, error) {
+	if err := validate(specs); err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(specs))
+	sem := make(chan struct{}, o.concurrency)
+	var wg sync.WaitGroup
+	for i := range specs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i] = Result{Name: specs[i].Site.Name, Err: ctx.Err()}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = o.onboard(ctx, &specs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	failed := 0
+	for i := range results {
+		if results[i].Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("provisioning: %d of %d sites failed", failed, len(specs))
+	}
+	return results, nil
+}
+
+func validate(specs []SiteSpec) error {
+	names := map[string]bool{}
+	for i := range specs {
+		name := specs[i].Site.Name
+		if name == "" {
+			return fmt.Errorf("provisioning: spec %d: site name is required", i)
+		}
+		if names[name] {
+			return fmt.Errorf("provisioning: site %q is specified more than once", name)
+		}
+		names[name] = true
+
+		if d := specs[i].Device; d != nil && d.SerialNumber == "" {
+			return fmt.Errorf("provisioning: site %q: device serial number is required", name)
+		}
+	}
+	return nil
+}
+
+func (o *Onboarder) onboard(ctx context.Context, spec *SiteSpec) Result {
+	res := Result{Name: spec.Site.Name, Completed: []Step{}}
+	var undo saga.Log
+	sites := o.client.Network.Sites
+
+	steps := []struct {
+		step Step
+		skip bool
+		run  func() error
+	}{
+		{StepSite, false, func() error {
+			site, err := sites.Create(ctx, &spec.Site)
+			if err != nil {
+				return err
+			}
+			res.SiteID = site.ID
+			undo.Add(func(ctx context.Context) error {
+				return sites.Delete(ctx, site.ID)
+			})
+			return nil
+		}},
+		{StepWANLinks, len(spec.WANLinks) == 0, func() error {
+			for i := range spec.WANLinks {
+				link, err := o.client.Network.WANLinks.Create(ctx, res.SiteID, &spec.WANLinks[i])
+				if err != nil {
+					return fmt.Errorf("link %q: %w", spec.WANLinks[i].Name, err)
+				}
+				undo.Add(func(ctx context.Context) error {
+					return o.client.Network.WANLinks.Delete(ctx, res.SiteID, link.ID)
+				})
+			}
+			return nil
+		}},
+		{StepVLANs, len(spec.VLANs) == 0, func() error {
+			for i := range spec.VLANs {
+				vlan, err := o.client.Network.VLANs.Create(ctx, res.SiteID, &spec.VLANs[i])
+				if err != nil {
+					return fmt.Errorf("VLAN %d: %w", spec.VLANs[i].VLANID, err)
+				}
+				undo.Add(func(ctx context.Context) error {
+					return o.client.Network.VLANs.Delete(ctx, res.SiteID, vlan.ID)
+				})
+			}
+			return nil
+		}},
+		{StepPolicies, len(spec.TrafficPolicyIDs) == 0, func() error {
+			for _, policyID := range spec.TrafficPolicyIDs {
+				attached, err := o.attachPolicy(ctx, policyID, res.SiteID)
+				if err != nil {
+					return fmt.Errorf("traffic policy %s: %w", policyID, err)
+				}
+				if attached {
+					policyID := policyID
+					undo.Add(func(ctx context.Context) error {
+						return o.detachPolicy(ctx, policyID, res.SiteID)
+					})
+				}
+			}
+			return nil
+		}},
+		{StepDevice, spec.Device == nil, func() error {
+			params := *spec.Device
+			params.SiteID = res.SiteID
+			device, err := o.client.Network.Devices.Claim(ctx, &params)
+			if err != nil {
+				return err
+			}
+			res.DeviceID = device.ID
+			undo.Add(func(ctx context.Context) error {
+				return o.client.Network.Devices.Release(ctx, device.ID)
+			})
+			return nil
+		}},
+	}
+
+	for _, s := range steps {
+		if s.skip {
+			continue
+		}
+		o.emit(Event{Site: res.Name, SiteID: res.SiteID, Step: s.step, Status: StatusStarted})
+		if err := s.run(); err != nil {
+			res.Err = fmt.Errorf("provisioning: site %q: %s: %w", res.Name, s.step, err)
+			o.emit(Event{Site: res.Name, SiteID: res.SiteID, Step: s.step, Status: StatusFailed, Err: res.Err})
+			if o.rollback && undo.Len() > 0 {
+				res.RollbackErr = undo.Rollback(ctx, o.rollbackTimeout)
+				res.RolledBack = res.RollbackErr == nil
+				o.emit(Event{Site: res.Name, SiteID: res.SiteID, Step: s.step, Status: StatusRolledBack, Err: res.RollbackErr})
+			}
+			return res
+		}
+		res.Completed = append(res.Completed, s.step)
+		o.emit(Event{Site: res.Name, SiteID: res.SiteID, Step: s.step, Status: StatusDone})
+	}
+	return res
+}
+
+// attachPolicy adds siteID to the sites of a traffic policy. It reports
+// false when the policy already applies to every site or to siteID.
+func (o *Onboarder) attachPolicy(ctx context.Context, policyID, siteID string) (bool, error) {
+	unlock := o.lockPolicy(policyID)
+	defer unlock()
+
+	policy, err := o.client.Network.Traffic.Get(ctx, policyID)
+	if err != nil {
+		return false, err
+	}
+	if len(policy.SiteIDs) == 0 {
+		return false, nil
+	}
+	for _, id := range policy.SiteIDs {
+		if id == siteID {
+			return false, nil
+		}
+	}
+
+	siteIDs := append(append([]string{}, policy.SiteIDs...), siteID)
+	if _, err := o.client.Network.Traffic.Update(ctx, policyID, &opensase.UpdateTrafficPolicyParams{SiteIDs: &siteIDs}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// detachPolicy removes siteID from the sites of a traffic policy. It
+// refuses to empty the list, which would apply the policy to every site.
+func (o *Onboarder) detachPolicy(ctx context.Context, policyID, siteID string) error {
+	unlock := o.lockPolicy(policyID)
+	defer unlock()
+
+	policy, err := o.client.Network.Traffic.Get(ctx, policyID)
+	if err != nil {
+		return err
+	}
+
+	siteIDs := make([]string, 0, len(policy.SiteIDs))
+	for _, id := range policy.SiteIDs {
+		if id != siteID {
+			siteIDs = append(siteIDs, id)
+		}
+	}
+	switch {
+	case len(siteIDs) == len(policy.SiteIDs):
+		return nil
+	case len(siteIDs) == 0:
+		return fmt.Errorf("traffic policy %s: not detaching site %s, its last site", policyID, siteID)
+	}
+
+	_, err = o.client.Network.Traffic.Update(ctx, policyID, &opensase.UpdateTrafficPolicyParams{SiteIDs: &siteIDs})
+	return err
+}
+
+func (o *Onboarder) lockPolicy(policyID string) (unlock func()) {
+	mu, _ := o.policyLocks.LoadOrStore(policyID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+func (o *Onboarder) emit(e Event) {
+	if o.progress == nil {
+		return
+	}
+	o.progressMu.Lock()
+	defer o.progressMu.Unlock()
+	o.progress(e)
+}
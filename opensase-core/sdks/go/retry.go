@@ -0,0 +1,40 @@
+package opensase
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+)
+
+// =============================================================================
+// Retry Classification
+// =============================================================================
+
+// IsRetryable reports whether err is a transient failure that may succeed
+// if the call is repeated: rate limiting, a server error or a network
+// error. The client has already retried such calls up to its retry limit
+// (WithMaxRetries) before returning the error, so callers retrying further
+// should back off for longer.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return isRetryable(apiErr.StatusCode)
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// RetryAfter returns how long the API asked callers to wait after a rate
+// limit error, or 0 if err is not one or carries no delay
+func RetryAfter(err error) time.Duration {
+	var rlErr *RateLimitError
+	if errors.As(err, &rlErr) && rlErr.RetryAfter > 0 {
+		return time.Duration(rlErr.RetryAfter) * time.Second
+	}
+	return 0
+}
@@ -0,0 +1,204 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/billyronks/opensase-go/enum"
+)
+
+// =============================================================================
+// Security Service
+// =============================================================================
+
+// SecurityService provides access to security policy APIs
+type SecurityService struct {
+	client     *Client
+	Policies   *PoliciesService
+	KMS        *KMSService
+	Apps       *AppsService
+	CustomApps *CustomAppsService
+	Firewall   *FirewallRulesService
+
+	ZTNAApplications   *ZTNAApplicationsService
+	ZTNAAccessPolicies *ZTNAAccessPoliciesService
+	URLFiltering       *URLFilteringService
+	DLP                *DLPService
+	DNS                *DNSSecurityService
+	ThreatPrevention   *ThreatPreventionService
+	SSLInspection      *SSLInspectionService
+	CASB               *CASBService
+	GeoRestriction     *GeoRestrictionService
+	BrowserIsolation   *BrowserIsolationService
+	AddressObjects     *AddressObjectsService
+	ServiceObjects     *ServiceObjectsService
+	Certificates       *CertificatesService
+
+	// Objects groups AddressObjects and ServiceObjects with service groups
+	// and reference tracking; its Addresses and Services are the same
+	// services
+	Objects *ObjectsService
+}
+
+// PoliciesService provides access to security policy APIs
+type PoliciesService struct {
+	client *Client
+}
+
+// Policy represents a security policy rule
+type Policy struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Priority    int               `json:"priority"`
+	Action      enum.PolicyAction `json:"action"`
+	Enabled     bool              `json:"enabled"`
+	Conditions  []PolicyCondition `json:"conditions,omitempty"`
+	Version     int               `json:"version,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+
+	RuleUsage
+}
+
+// RuleUsage reports how often a policy or rule has matched traffic. A
+// LastMatchedAt of nil means the rule has never matched since it was
+// created or its counters were last reset.
+type RuleUsage struct {
+	HitCount      int64      `json:"hit_count"`
+	LastMatchedAt *time.Time `json:"last_matched_at,omitempty"`
+}
+
+// PolicyCondition is a match condition of a policy
+type PolicyCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// CreatePolicyParams contains parameters for creating a policy
+type CreatePolicyParams struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Priority    int               `json:"priority,omitempty"`
+	Action      enum.PolicyAction `json:"action"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Conditions  []PolicyCondition `json:"conditions,omitempty"`
+}
+
+// UpdatePolicyParams contains parameters for updating a policy. Conditions
+// replace the existing ones; set them to an empty slice to clear them.
+type UpdatePolicyParams struct {
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Priority    *int               `json:"priority,omitempty"`
+	Action      *enum.PolicyAction `json:"action,omitempty"`
+	Enabled     *bool              `json:"enabled,omitempty"`
+	Conditions  *[]PolicyCondition `json:"conditions,omitempty"`
+}
+
+// ListPoliciesParams contains parameters for listing policies
+type ListPoliciesParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	Search  *string `json:"search,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+}
+
+// PolicyListResponse contains a list of policies with pagination
+type PolicyListResponse struct {
+	Data       []Policy   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// List retrieves all policies in priority order. Honors AsOf.
+func (s *PoliciesService) List(ctx context.Context, params *ListPoliciesParams) (*PolicyListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Search != nil {
+			v.Set("search", *params.Search)
+		}
+		if params.Enabled != nil {
+			v.Set("enabled", strconv.FormatBool(*params.Enabled))
+		}
+	}
+	setAsOf(ctx, v)
+
+	data, err := s.client.get(ctx, "/policies", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response PolicyListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Create creates a new policy
+func (s *PoliciesService) Create(ctx context.Context, params *CreatePolicyParams) (*Policy, error) {
+	data, err := s.client.post(ctx, "/policies", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy Policy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Get retrieves a policy by ID. Honors AsOf.
+func (s *PoliciesService) Get(ctx context.Context, policyID string) (*Policy, error) {
+	v := url.Values{}
+	setAsOf(ctx, v)
+
+	data, err := s.client.get(ctx, "/policies/"+policyID, v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy Policy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Update updates a policy
+func (s *PoliciesService) Update(ctx context.Context, policyID string, params *UpdatePolicyParams) (*Policy, error) {
+	data, err := s.client.patch(ctx, "/policies/"+policyID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy Policy
+	if err := s.client.decode(data, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Delete deletes a policy
+func (s *PoliciesService) Delete(ctx context.Context, policyID string) error {
+	return s.client.delete(ctx, "/policies/"+policyID, nil)
+}
+
+// History retrieves the change log of a policy
+func (s *PoliciesService) History(ctx context.Context, policyID string, params *HistoryParams) (*ChangeLog, error) {
+	return s.client.history(ctx, "/policies/"+policyID+"/history", params)
+}
@@ -0,0 +1,145 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Application Rules
+// =============================================================================
+
+// AppsService provides access to per-application access control APIs
+type AppsService struct {
+	client *Client
+}
+
+// App is an access rule for an application or application category. An
+// app with Signatures is a custom application defined by the tenant rather
+// than one from the catalog.
+type App struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Category   string         `json:"category"`
+	Action     string         `json:"action"`
+	RiskLevel  int            `json:"risk_level,omitempty"`
+	QoSClass   string         `json:"qos_class,omitempty"`
+	Signatures *AppSignatures `json:"signatures,omitempty"`
+	Custom     bool           `json:"custom"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// App risk levels, matching Application.RiskLevel in the catalog
+const (
+	AppRiskMinimal  = 1
+	AppRiskLow      = 2
+	AppRiskMedium   = 3
+	AppRiskHigh     = 4
+	AppRiskCritical = 5
+)
+
+// AppSignatures identify the traffic of a custom application. Traffic
+// matching any TLS server name or any port is classified as the app.
+// TLS server names may start with a *. wildcard label.
+type AppSignatures struct {
+	TLSServerNames []string  `json:"tls_server_names,omitempty"`
+	Ports          []AppPort `json:"ports,omitempty"`
+}
+
+// AppPort is a port or port range of a custom application. EndPort is zero
+// for a single port.
+type AppPort struct {
+	Protocol string `json:"protocol"`
+	Port     int    `json:"port"`
+	EndPort  int    `json:"end_port,omitempty"`
+}
+
+// CreateAppParams contains parameters for creating an application rule.
+// RiskLevel defaults to the catalog risk for catalog apps and is required
+// for custom apps. QoSClass names a class of the QoS profiles the app's
+// traffic is placed in when no traffic policy classifies it.
+type CreateAppParams struct {
+	Name       string         `json:"name"`
+	Category   string         `json:"category"`
+	Action     string         `json:"action"`
+	RiskLevel  int            `json:"risk_level,omitempty"`
+	QoSClass   string         `json:"qos_class,omitempty"`
+	Signatures *AppSignatures `json:"signatures,omitempty"`
+}
+
+// UpdateAppParams contains parameters for updating an application rule.
+// Signatures replaces the existing signatures.
+type UpdateAppParams struct {
+	Name       *string        `json:"name,omitempty"`
+	Category   *string        `json:"category,omitempty"`
+	Action     *string        `json:"action,omitempty"`
+	RiskLevel  *int           `json:"risk_level,omitempty"`
+	QoSClass   *string        `json:"qos_class,omitempty"`
+	Signatures *AppSignatures `json:"signatures,omitempty"`
+}
+
+// List retrieves all application rules
+func (s *AppsService) List(ctx context.Context) ([]App, error) {
+	data, err := s.client.get(ctx, "/security/apps", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []App
+	if err := s.client.decode(data, &apps); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// Create creates a new application rule
+func (s *AppsService) Create(ctx context.Context, params *CreateAppParams) (*App, error) {
+	data, err := s.client.post(ctx, "/security/apps", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app App
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Get retrieves an application rule by ID
+func (s *AppsService) Get(ctx context.Context, appID string) (*App, error) {
+	data, err := s.client.get(ctx, "/security/apps/"+appID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app App
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Update updates an application rule
+func (s *AppsService) Update(ctx context.Context, appID string, params *UpdateAppParams) (*App, error) {
+	data, err := s.client.patch(ctx, "/security/apps/"+appID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app App
+	if err := s.client.decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// Delete deletes an application rule
+func (s *AppsService) Delete(ctx context.Context, appID string) error {
+	return s.client.delete(ctx, "/security/apps/"+appID, nil)
+}
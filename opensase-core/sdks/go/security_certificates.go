@@ -0,0 +1,200 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Certificates
+// =============================================================================
+
+// Certificate types
+const (
+	CertificateTypeServer = "server"
+	CertificateTypeCA     = "ca"
+)
+
+// Certificate statuses
+const (
+	CertificatePendingCSR = "pending_csr"
+	CertificateActive     = "active"
+	CertificateExpired    = "expired"
+)
+
+// Key algorithms for server-generated CSRs
+const (
+	KeyAlgorithmRSA2048   = "rsa_2048"
+	KeyAlgorithmRSA4096   = "rsa_4096"
+	KeyAlgorithmECDSAP256 = "ecdsa_p256"
+	KeyAlgorithmECDSAP384 = "ecdsa_p384"
+)
+
+// CertificatesService provides access to the certificates used by SSL
+// inspection profiles and IPsec tunnels
+type CertificatesService struct {
+	client *Client
+}
+
+// Certificate represents an X.509 certificate and its private key. Private
+// keys are never returned. A certificate created from a CSR stays in
+// pending_csr until the signed chain is supplied with Complete.
+type Certificate struct {
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	Type              string             `json:"type"`
+	Status            string             `json:"status"`
+	Subject           CertificateSubject `json:"subject"`
+	SANs              []string           `json:"sans,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	SerialNumber      string             `json:"serial_number,omitempty"`
+	FingerprintSHA256 string             `json:"fingerprint_sha256,omitempty"`
+	KeyAlgorithm      string             `json:"key_algorithm,omitempty"`
+	CSRPEM            string             `json:"csr_pem,omitempty"`
+	CertificatePEM    string             `json:"certificate_pem,omitempty"`
+	NotBefore         *time.Time         `json:"not_before,omitempty"`
+	NotAfter          *time.Time         `json:"not_after,omitempty"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+// ExpiresWithin reports whether the certificate expires within d. Certificates
+// without a signed chain never expire.
+func (c *Certificate) ExpiresWithin(d time.Duration) bool {
+	return c.NotAfter != nil && time.Until(*c.NotAfter) < d
+}
+
+// CertificateSubject is the distinguished name of a certificate
+type CertificateSubject struct {
+	CommonName         string `json:"common_name"`
+	Organization       string `json:"organization,omitempty"`
+	OrganizationalUnit string `json:"organizational_unit,omitempty"`
+	Country            string `json:"country,omitempty"`
+	State              string `json:"state,omitempty"`
+	Locality           string `json:"locality,omitempty"`
+}
+
+// UploadCertificateParams contains parameters for uploading a PEM certificate
+// chain, leaf first, with its private key
+type UploadCertificateParams struct {
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	CertificatePEM string `json:"certificate_pem"`
+	PrivateKeyPEM  string `json:"private_key_pem"`
+	Passphrase     string `json:"passphrase,omitempty"`
+}
+
+// CreateCSRParams contains parameters for generating a key pair and CSR on the server
+type CreateCSRParams struct {
+	Name         string             `json:"name"`
+	Type         string             `json:"type"`
+	Subject      CertificateSubject `json:"subject"`
+	SANs         []string           `json:"sans,omitempty"`
+	KeyAlgorithm string             `json:"key_algorithm,omitempty"`
+}
+
+// UpdateCertificateParams contains parameters for updating a certificate
+type UpdateCertificateParams struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// List retrieves all certificates
+func (s *CertificatesService) List(ctx context.Context) ([]Certificate, error) {
+	data, err := s.client.get(ctx, "/security/certificates", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []Certificate
+	if err := s.client.decode(data, &certs); err != nil {
+		return nil, err
+	}
+
+	return certs, nil
+}
+
+// Upload imports an existing certificate chain and private key
+func (s *CertificatesService) Upload(ctx context.Context, params *UploadCertificateParams) (*Certificate, error) {
+	data, err := s.client.post(ctx, "/security/certificates", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := s.client.decode(data, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// CreateCSR generates a key pair on the server and returns a certificate in
+// pending_csr whose CSRPEM can be submitted to a CA. The private key never
+// leaves the platform.
+func (s *CertificatesService) CreateCSR(ctx context.Context, params *CreateCSRParams) (*Certificate, error) {
+	data, err := s.client.post(ctx, "/security/certificates/csr", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := s.client.decode(data, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Complete supplies the signed chain, leaf first, for a certificate in pending_csr
+func (s *CertificatesService) Complete(ctx context.Context, certID, certificatePEM string) (*Certificate, error) {
+	params := map[string]interface{}{"certificate_pem": certificatePEM}
+
+	data, err := s.client.post(ctx, "/security/certificates/"+certID+"/complete", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := s.client.decode(data, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Get retrieves a certificate by ID
+func (s *CertificatesService) Get(ctx context.Context, certID string) (*Certificate, error) {
+	data, err := s.client.get(ctx, "/security/certificates/"+certID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := s.client.decode(data, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Update updates a certificate's metadata. Certificate material is
+// immutable; rotate by creating a new certificate and repointing its users.
+func (s *CertificatesService) Update(ctx context.Context, certID string, params *UpdateCertificateParams) (*Certificate, error) {
+	data, err := s.client.patch(ctx, "/security/certificates/"+certID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert Certificate
+	if err := s.client.decode(data, &cert); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Delete deletes a certificate. Certificates still referenced by an SSL
+// inspection profile or tunnel cannot be deleted.
+func (s *CertificatesService) Delete(ctx context.Context, certID string) error {
+	return s.client.delete(ctx, "/security/certificates/"+certID, nil)
+}
@@ -0,0 +1,132 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Data Loss Prevention
+// =============================================================================
+
+// DLP pattern types
+const (
+	DLPPatternRegex      = "regex"
+	DLPPatternPredefined = "predefined"
+)
+
+// DLPService provides access to data loss prevention profile APIs
+type DLPService struct {
+	client *Client
+}
+
+// DLPProfile describes sensitive data to detect and what to do when it is found
+type DLPProfile struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Patterns    []DLPPattern `json:"patterns"`
+	FileTypes   []string     `json:"file_types"`
+	Severity    string       `json:"severity"`
+	Action      string       `json:"action"`
+	Enabled     bool         `json:"enabled"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// DLPPattern is a data pattern. Regex patterns set Regex; predefined
+// patterns set Identifier, such as "pan", "ssn" or "iban".
+type DLPPattern struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Regex      string `json:"regex,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	MinMatches int    `json:"min_matches,omitempty"`
+}
+
+// CreateDLPProfileParams contains parameters for creating a DLP profile
+type CreateDLPProfileParams struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Patterns    []DLPPattern `json:"patterns"`
+	FileTypes   []string     `json:"file_types,omitempty"`
+	Severity    string       `json:"severity"`
+	Action      string       `json:"action"`
+	Enabled     *bool        `json:"enabled,omitempty"`
+}
+
+// UpdateDLPProfileParams contains parameters for updating a DLP profile
+type UpdateDLPProfileParams struct {
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Patterns    []DLPPattern `json:"patterns,omitempty"`
+	FileTypes   *[]string    `json:"file_types,omitempty"`
+	Severity    *string      `json:"severity,omitempty"`
+	Action      *string      `json:"action,omitempty"`
+	Enabled     *bool        `json:"enabled,omitempty"`
+}
+
+// List retrieves all DLP profiles
+func (s *DLPService) List(ctx context.Context) ([]DLPProfile, error) {
+	data, err := s.client.get(ctx, "/security/dlp/profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []DLPProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new DLP profile
+func (s *DLPService) Create(ctx context.Context, params *CreateDLPProfileParams) (*DLPProfile, error) {
+	data, err := s.client.post(ctx, "/security/dlp/profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile DLPProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves a DLP profile by ID
+func (s *DLPService) Get(ctx context.Context, profileID string) (*DLPProfile, error) {
+	data, err := s.client.get(ctx, "/security/dlp/profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile DLPProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates a DLP profile
+func (s *DLPService) Update(ctx context.Context, profileID string, params *UpdateDLPProfileParams) (*DLPProfile, error) {
+	data, err := s.client.patch(ctx, "/security/dlp/profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile DLPProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes a DLP profile
+func (s *DLPService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/security/dlp/profiles/"+profileID, nil)
+}
@@ -0,0 +1,148 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// DNS Security
+// =============================================================================
+
+// DNS-over-HTTPS handling modes
+const (
+	DoHAllow    = "allow"
+	DoHBlock    = "block"
+	DoHRedirect = "redirect"
+)
+
+// DNS query logging levels
+const (
+	DNSLogAll     = "all"
+	DNSLogBlocked = "blocked"
+	DNSLogNone    = "none"
+)
+
+// DNSSecurityService provides access to DNS-layer security profile APIs
+type DNSSecurityService struct {
+	client *Client
+}
+
+// DNSSecurityProfile controls DNS resolution for the sites it is assigned to
+type DNSSecurityProfile struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	Description       string     `json:"description,omitempty"`
+	BlockedCategories []string   `json:"blocked_categories"`
+	SinkholeIPv4      string     `json:"sinkhole_ipv4,omitempty"`
+	SinkholeIPv6      string     `json:"sinkhole_ipv6,omitempty"`
+	DoHMode           string     `json:"doh_mode"`
+	BlockDomains      []string   `json:"block_domains"`
+	AllowDomains      []string   `json:"allow_domains"`
+	Logging           DNSLogging `json:"logging"`
+	SiteIDs           []string   `json:"site_ids"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// DNSLogging controls which DNS queries are logged
+type DNSLogging struct {
+	Level          string `json:"level"`
+	AnonymizeUsers bool   `json:"anonymize_users"`
+}
+
+// CreateDNSSecurityProfileParams contains parameters for creating a DNS security profile
+type CreateDNSSecurityProfileParams struct {
+	Name              string      `json:"name"`
+	Description       string      `json:"description,omitempty"`
+	BlockedCategories []string    `json:"blocked_categories,omitempty"`
+	SinkholeIPv4      string      `json:"sinkhole_ipv4,omitempty"`
+	SinkholeIPv6      string      `json:"sinkhole_ipv6,omitempty"`
+	DoHMode           string      `json:"doh_mode,omitempty"`
+	BlockDomains      []string    `json:"block_domains,omitempty"`
+	AllowDomains      []string    `json:"allow_domains,omitempty"`
+	Logging           *DNSLogging `json:"logging,omitempty"`
+	SiteIDs           []string    `json:"site_ids,omitempty"`
+}
+
+// UpdateDNSSecurityProfileParams contains parameters for updating a DNS
+// security profile. List fields replace the existing list; set them to an
+// empty slice to clear it. An empty sinkhole address returns NXDOMAIN for
+// blocked queries.
+type UpdateDNSSecurityProfileParams struct {
+	Name              *string     `json:"name,omitempty"`
+	Description       *string     `json:"description,omitempty"`
+	BlockedCategories *[]string   `json:"blocked_categories,omitempty"`
+	SinkholeIPv4      *string     `json:"sinkhole_ipv4,omitempty"`
+	SinkholeIPv6      *string     `json:"sinkhole_ipv6,omitempty"`
+	DoHMode           *string     `json:"doh_mode,omitempty"`
+	BlockDomains      *[]string   `json:"block_domains,omitempty"`
+	AllowDomains      *[]string   `json:"allow_domains,omitempty"`
+	Logging           *DNSLogging `json:"logging,omitempty"`
+	SiteIDs           *[]string   `json:"site_ids,omitempty"`
+}
+
+// List retrieves all DNS security profiles
+func (s *DNSSecurityService) List(ctx context.Context) ([]DNSSecurityProfile, error) {
+	data, err := s.client.get(ctx, "/security/dns/profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []DNSSecurityProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new DNS security profile
+func (s *DNSSecurityService) Create(ctx context.Context, params *CreateDNSSecurityProfileParams) (*DNSSecurityProfile, error) {
+	data, err := s.client.post(ctx, "/security/dns/profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile DNSSecurityProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves a DNS security profile by ID
+func (s *DNSSecurityService) Get(ctx context.Context, profileID string) (*DNSSecurityProfile, error) {
+	data, err := s.client.get(ctx, "/security/dns/profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile DNSSecurityProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates a DNS security profile
+func (s *DNSSecurityService) Update(ctx context.Context, profileID string, params *UpdateDNSSecurityProfileParams) (*DNSSecurityProfile, error) {
+	data, err := s.client.patch(ctx, "/security/dns/profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile DNSSecurityProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes a DNS security profile
+func (s *DNSSecurityService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/security/dns/profiles/"+profileID, nil)
+}
@@ -0,0 +1,189 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/billyronks/opensase-go/enum"
+)
+
+// =============================================================================
+// Firewall Rules
+// =============================================================================
+
+// FirewallRulesService provides access to the ordered firewall rule base
+type FirewallRulesService struct {
+	client *Client
+}
+
+// FirewallRule represents a rule in the firewall rule base. Rules are
+// evaluated in ascending Priority. Priorities are sparse, unique within the
+// rule base and stored exactly as given; the server never renumbers them.
+// ServiceObjects and ServiceGroups hold IDs of service objects and service
+// groups, matched in addition to Services.
+// A rule with a ScheduleID only matches while that schedule object is active.
+type FirewallRule struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	Priority       int               `json:"priority"`
+	Enabled        bool              `json:"enabled"`
+	Source         RuleEndpoint      `json:"source"`
+	Destination    RuleEndpoint      `json:"destination"`
+	Services       []string          `json:"services,omitempty"`
+	ServiceObjects []string          `json:"service_objects,omitempty"`
+	ServiceGroups  []string          `json:"service_groups,omitempty"`
+	Applications   []string          `json:"applications,omitempty"`
+	ScheduleID     string            `json:"schedule_id,omitempty"`
+	Action         enum.PolicyAction `json:"action"`
+	LogStart       bool              `json:"log_start"`
+	LogEnd         bool              `json:"log_end"`
+	Version        int               `json:"version,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+
+	RuleUsage
+}
+
+// RuleEndpoint matches the source or destination of traffic. Empty lists match
+// any. AddressObjects holds IDs of address objects, matched in addition to
+// Addresses.
+type RuleEndpoint struct {
+	Zones          []string `json:"zones,omitempty"`
+	Addresses      []string `json:"addresses,omitempty"`
+	AddressObjects []string `json:"address_objects,omitempty"`
+	Users          []string `json:"users,omitempty"`
+	Negate         bool     `json:"negate,omitempty"`
+}
+
+// CreateFirewallRuleParams contains parameters for creating a firewall rule
+type CreateFirewallRuleParams struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	Priority       int               `json:"priority"`
+	Enabled        *bool             `json:"enabled,omitempty"`
+	Source         RuleEndpoint      `json:"source"`
+	Destination    RuleEndpoint      `json:"destination"`
+	Services       []string          `json:"services,omitempty"`
+	ServiceObjects []string          `json:"service_objects,omitempty"`
+	ServiceGroups  []string          `json:"service_groups,omitempty"`
+	Applications   []string          `json:"applications,omitempty"`
+	ScheduleID     string            `json:"schedule_id,omitempty"`
+	Action         enum.PolicyAction `json:"action"`
+	LogStart       bool              `json:"log_start,omitempty"`
+	LogEnd         bool              `json:"log_end,omitempty"`
+}
+
+// UpdateFirewallRuleParams contains parameters for updating a firewall rule.
+// List fields replace the existing list; set them to an empty slice to clear
+// it. An empty ScheduleID removes the rule's schedule.
+type UpdateFirewallRuleParams struct {
+	Name           *string            `json:"name,omitempty"`
+	Description    *string            `json:"description,omitempty"`
+	Priority       *int               `json:"priority,omitempty"`
+	Enabled        *bool              `json:"enabled,omitempty"`
+	Source         *RuleEndpoint      `json:"source,omitempty"`
+	Destination    *RuleEndpoint      `json:"destination,omitempty"`
+	Services       *[]string          `json:"services,omitempty"`
+	ServiceObjects *[]string          `json:"service_objects,omitempty"`
+	ServiceGroups  *[]string          `json:"service_groups,omitempty"`
+	Applications   *[]string          `json:"applications,omitempty"`
+	ScheduleID     *string            `json:"schedule_id,omitempty"`
+	Action         *enum.PolicyAction `json:"action,omitempty"`
+	LogStart       *bool              `json:"log_start,omitempty"`
+	LogEnd         *bool              `json:"log_end,omitempty"`
+}
+
+// ListFirewallRulesParams contains parameters for listing firewall rules
+type ListFirewallRulesParams struct {
+	Page    int     `json:"page,omitempty"`
+	PerPage int     `json:"per_page,omitempty"`
+	Search  *string `json:"search,omitempty"`
+}
+
+// FirewallRuleListResponse contains firewall rules in evaluation order with pagination
+type FirewallRuleListResponse struct {
+	Data       []FirewallRule `json:"data"`
+	Pagination Pagination     `json:"pagination"`
+}
+
+// List retrieves firewall rules in evaluation order
+func (s *FirewallRulesService) List(ctx context.Context, params *ListFirewallRulesParams) (*FirewallRuleListResponse, error) {
+	v := url.Values{}
+	if params != nil {
+		if params.Page > 0 {
+			v.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.PerPage > 0 {
+			v.Set("per_page", strconv.Itoa(params.PerPage))
+		}
+		if params.Search != nil {
+			v.Set("search", *params.Search)
+		}
+	}
+
+	data, err := s.client.get(ctx, "/security/firewall/rules", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response FirewallRuleListResponse
+	if err := s.client.decode(data, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Create creates a new firewall rule at the given priority
+func (s *FirewallRulesService) Create(ctx context.Context, params *CreateFirewallRuleParams) (*FirewallRule, error) {
+	data, err := s.client.post(ctx, "/security/firewall/rules", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule FirewallRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// Get retrieves a firewall rule by ID
+func (s *FirewallRulesService) Get(ctx context.Context, ruleID string) (*FirewallRule, error) {
+	data, err := s.client.get(ctx, "/security/firewall/rules/"+ruleID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule FirewallRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// Update updates a firewall rule. Changing Priority moves the rule without
+// affecting the priority of any other rule.
+func (s *FirewallRulesService) Update(ctx context.Context, ruleID string, params *UpdateFirewallRuleParams) (*FirewallRule, error) {
+	data, err := s.client.patch(ctx, "/security/firewall/rules/"+ruleID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule FirewallRule
+	if err := s.client.decode(data, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// Delete deletes a firewall rule
+func (s *FirewallRulesService) Delete(ctx context.Context, ruleID string) error {
+	return s.client.delete(ctx, "/security/firewall/rules/"+ruleID, nil)
+}
@@ -0,0 +1,500 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Address & Service Objects
+// =============================================================================
+
+// Address object types
+const (
+	AddressObjectCIDR  = "cidr"
+	AddressObjectFQDN  = "fqdn"
+	AddressObjectRange = "range"
+	AddressObjectGroup = "group"
+)
+
+// Object reference types returned by WhereUsed
+const (
+	ReferenceFirewallRule     = "firewall_rule"
+	ReferencePolicy           = "policy"
+	ReferenceNATRule          = "nat_rule"
+	ReferenceZTNAAccessPolicy = "ztna_access_policy"
+	ReferenceSegmentPolicy    = "segment_policy"
+	ReferenceAddressGroup     = "address_group"
+	ReferenceServiceGroup     = "service_group"
+)
+
+// Schedule object types. Recurring schedules are active in their time ranges
+// every week; one-time schedules between StartsAt and EndsAt.
+const (
+	ScheduleRecurring = "recurring"
+	ScheduleOneTime   = "one_time"
+)
+
+// ObjectsService groups the reusable address, service, service group and
+// schedule objects, and reports where any of them is referenced
+type ObjectsService struct {
+	client        *Client
+	Addresses     *AddressObjectsService
+	Services      *ServiceObjectsService
+	ServiceGroups *ServiceGroupsService
+	Schedules     *ScheduleObjectsService
+}
+
+// ObjectReference is a rule, policy or group that references an object
+type ObjectReference struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	SiteID string `json:"site_id,omitempty"`
+	// Field is the attribute holding the reference, e.g. source_addresses
+	Field string `json:"field"`
+}
+
+// WhereUsed retrieves everything that references an address, service,
+// service group or schedule object, including groups it is a member of. An empty
+// result means the object can be deleted.
+func (s *ObjectsService) WhereUsed(ctx context.Context, objectID string) ([]ObjectReference, error) {
+	data, err := s.client.get(ctx, "/security/objects/"+objectID+"/references", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []ObjectReference
+	if err := s.client.decode(data, &refs); err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+// AddressObjectsService provides access to reusable named address objects
+type AddressObjectsService struct {
+	client *Client
+}
+
+// AddressObject is a named address that rules reference by ID. Value holds
+// the CIDR, FQDN or "first-last" IP range; groups list the IDs of other
+// address objects in Members instead. Objects still referenced by a rule
+// or group cannot be deleted.
+type AddressObject struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description,omitempty"`
+	Type           string    `json:"type"`
+	Value          string    `json:"value,omitempty"`
+	Members        []string  `json:"members,omitempty"`
+	ReferenceCount int       `json:"reference_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CreateAddressObjectParams contains parameters for creating an address object
+type CreateAddressObjectParams struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type"`
+	Value       string   `json:"value,omitempty"`
+	Members     []string `json:"members,omitempty"`
+}
+
+// UpdateAddressObjectParams contains parameters for updating an address object.
+// The type of an object cannot be changed.
+type UpdateAddressObjectParams struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Value       *string   `json:"value,omitempty"`
+	Members     *[]string `json:"members,omitempty"`
+}
+
+// List retrieves all address objects
+func (s *AddressObjectsService) List(ctx context.Context) ([]AddressObject, error) {
+	data, err := s.client.get(ctx, "/security/objects/addresses", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []AddressObject
+	if err := s.client.decode(data, &objects); err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// Create creates a new address object
+func (s *AddressObjectsService) Create(ctx context.Context, params *CreateAddressObjectParams) (*AddressObject, error) {
+	data, err := s.client.post(ctx, "/security/objects/addresses", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var object AddressObject
+	if err := s.client.decode(data, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// Get retrieves an address object by ID
+func (s *AddressObjectsService) Get(ctx context.Context, objectID string) (*AddressObject, error) {
+	data, err := s.client.get(ctx, "/security/objects/addresses/"+objectID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var object AddressObject
+	if err := s.client.decode(data, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// Update updates an address object
+func (s *AddressObjectsService) Update(ctx context.Context, objectID string, params *UpdateAddressObjectParams) (*AddressObject, error) {
+	data, err := s.client.patch(ctx, "/security/objects/addresses/"+objectID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var object AddressObject
+	if err := s.client.decode(data, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// Delete deletes an address object. It fails with a conflict error while
+// the object is still referenced.
+func (s *AddressObjectsService) Delete(ctx context.Context, objectID string) error {
+	return s.client.delete(ctx, "/security/objects/addresses/"+objectID, nil)
+}
+
+// ServiceObjectsService provides access to reusable named service objects
+type ServiceObjectsService struct {
+	client *Client
+}
+
+// ServiceObject is a named set of protocols and ports that rules reference by ID
+type ServiceObject struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description,omitempty"`
+	Entries        []ServicePort `json:"entries"`
+	ReferenceCount int           `json:"reference_count"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// ServicePort matches a protocol ("tcp", "udp", "icmp" or "any") and, for
+// TCP and UDP, a port or port range such as "443" or "8000-8080"
+type ServicePort struct {
+	Protocol string `json:"protocol"`
+	Ports    string `json:"ports,omitempty"`
+}
+
+// CreateServiceObjectParams contains parameters for creating a service object
+type CreateServiceObjectParams struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Entries     []ServicePort `json:"entries"`
+}
+
+// UpdateServiceObjectParams contains parameters for updating a service object
+type UpdateServiceObjectParams struct {
+	Name        *string        `json:"name,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Entries     *[]ServicePort `json:"entries,omitempty"`
+}
+
+// List retrieves all service objects
+func (s *ServiceObjectsService) List(ctx context.Context) ([]ServiceObject, error) {
+	data, err := s.client.get(ctx, "/security/objects/services", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []ServiceObject
+	if err := s.client.decode(data, &objects); err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// Create creates a new service object
+func (s *ServiceObjectsService) Create(ctx context.Context, params *CreateServiceObjectParams) (*ServiceObject, error) {
+	data, err := s.client.post(ctx, "/security/objects/services", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var object ServiceObject
+	if err := s.client.decode(data, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// Get retrieves a service object by ID
+func (s *ServiceObjectsService) Get(ctx context.Context, objectID string) (*ServiceObject, error) {
+	data, err := s.client.get(ctx, "/security/objects/services/"+objectID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var object ServiceObject
+	if err := s.client.decode(data, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// Update updates a service object
+func (s *ServiceObjectsService) Update(ctx context.Context, objectID string, params *UpdateServiceObjectParams) (*ServiceObject, error) {
+	data, err := s.client.patch(ctx, "/security/objects/services/"+objectID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var object ServiceObject
+	if err := s.client.decode(data, &object); err != nil {
+		return nil, err
+	}
+
+	return &object, nil
+}
+
+// Delete deletes a service object. It fails with a conflict error while the
+// object is still referenced.
+func (s *ServiceObjectsService) Delete(ctx context.Context, objectID string) error {
+	return s.client.delete(ctx, "/security/objects/services/"+objectID, nil)
+}
+
+// ServiceGroupsService provides access to named groups of service objects
+type ServiceGroupsService struct {
+	client *Client
+}
+
+// ServiceGroup is a named set of service objects that rules reference by ID.
+// Groups still referenced by a rule cannot be deleted.
+type ServiceGroup struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description,omitempty"`
+	Members        []string  `json:"members"`
+	ReferenceCount int       `json:"reference_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CreateServiceGroupParams contains parameters for creating a service group
+type CreateServiceGroupParams struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Members     []string `json:"members"`
+}
+
+// UpdateServiceGroupParams contains parameters for updating a service group
+type UpdateServiceGroupParams struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Members     *[]string `json:"members,omitempty"`
+}
+
+// List retrieves all service groups
+func (s *ServiceGroupsService) List(ctx context.Context) ([]ServiceGroup, error) {
+	data, err := s.client.get(ctx, "/security/objects/service_groups", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []ServiceGroup
+	if err := s.client.decode(data, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Create creates a new service group
+func (s *ServiceGroupsService) Create(ctx context.Context, params *CreateServiceGroupParams) (*ServiceGroup, error) {
+	data, err := s.client.post(ctx, "/security/objects/service_groups", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var group ServiceGroup
+	if err := s.client.decode(data, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Get retrieves a service group by ID
+func (s *ServiceGroupsService) Get(ctx context.Context, groupID string) (*ServiceGroup, error) {
+	data, err := s.client.get(ctx, "/security/objects/service_groups/"+groupID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var group ServiceGroup
+	if err := s.client.decode(data, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Update updates a service group
+func (s *ServiceGroupsService) Update(ctx context.Context, groupID string, params *UpdateServiceGroupParams) (*ServiceGroup, error) {
+	data, err := s.client.patch(ctx, "/security/objects/service_groups/"+groupID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var group ServiceGroup
+	if err := s.client.decode(data, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// Delete deletes a service group. It fails with a conflict error while the
+// group is still referenced.
+func (s *ServiceGroupsService) Delete(ctx context.Context, groupID string) error {
+	return s.client.delete(ctx, "/security/objects/service_groups/"+groupID, nil)
+}
+
+// ScheduleObjectsService provides access to reusable named schedules that
+// limit when rules apply
+type ScheduleObjectsService struct {
+	client *Client
+}
+
+// ScheduleObject is a named schedule that rules reference by ID. Times are
+// local to Timezone. Schedules still referenced by a rule cannot be deleted.
+type ScheduleObject struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description,omitempty"`
+	Type           string          `json:"type"`
+	Timezone       string          `json:"timezone"`
+	Ranges         []ScheduleRange `json:"ranges,omitempty"`
+	StartsAt       *time.Time      `json:"starts_at,omitempty"`
+	EndsAt         *time.Time      `json:"ends_at,omitempty"`
+	ReferenceCount int             `json:"reference_count"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// ScheduleRange is a weekly time range of a recurring schedule. Days are mon
+// through sun; StartTime and EndTime are HH:MM, and an EndTime before
+// StartTime continues past midnight.
+type ScheduleRange struct {
+	Days      []string `json:"days"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+}
+
+// CreateScheduleObjectParams contains parameters for creating a schedule
+// object. Recurring schedules need Ranges; one-time schedules StartsAt and
+// EndsAt.
+type CreateScheduleObjectParams struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Type        string          `json:"type"`
+	Timezone    string          `json:"timezone"`
+	Ranges      []ScheduleRange `json:"ranges,omitempty"`
+	StartsAt    *time.Time      `json:"starts_at,omitempty"`
+	EndsAt      *time.Time      `json:"ends_at,omitempty"`
+}
+
+// UpdateScheduleObjectParams contains parameters for updating a schedule
+// object. The type of a schedule cannot be changed; Ranges replaces the
+// existing ranges.
+type UpdateScheduleObjectParams struct {
+	Name        *string          `json:"name,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Timezone    *string          `json:"timezone,omitempty"`
+	Ranges      *[]ScheduleRange `json:"ranges,omitempty"`
+	StartsAt    *time.Time       `json:"starts_at,omitempty"`
+	EndsAt      *time.Time       `json:"ends_at,omitempty"`
+}
+
+// List retrieves all schedule objects
+func (s *ScheduleObjectsService) List(ctx context.Context) ([]ScheduleObject, error) {
+	data, err := s.client.get(ctx, "/security/objects/schedules", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []ScheduleObject
+	if err := s.client.decode(data, &schedules); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// Create creates a new schedule object
+func (s *ScheduleObjectsService) Create(ctx context.Context, params *CreateScheduleObjectParams) (*ScheduleObject, error) {
+	data, err := s.client.post(ctx, "/security/objects/schedules", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule ScheduleObject
+	if err := s.client.decode(data, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// Get retrieves a schedule object by ID
+func (s *ScheduleObjectsService) Get(ctx context.Context, scheduleID string) (*ScheduleObject, error) {
+	data, err := s.client.get(ctx, "/security/objects/schedules/"+scheduleID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule ScheduleObject
+	if err := s.client.decode(data, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// Update updates a schedule object
+func (s *ScheduleObjectsService) Update(ctx context.Context, scheduleID string, params *UpdateScheduleObjectParams) (*ScheduleObject, error) {
+	data, err := s.client.patch(ctx, "/security/objects/schedules/"+scheduleID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedule ScheduleObject
+	if err := s.client.decode(data, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// Delete deletes a schedule object. It fails with a conflict error while the
+// schedule is still referenced.
+func (s *ScheduleObjectsService) Delete(ctx context.Context, scheduleID string) error {
+	return s.client.delete(ctx, "/security/objects/schedules/"+scheduleID, nil)
+}
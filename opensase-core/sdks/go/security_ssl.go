@@ -0,0 +1,138 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// SSL Inspection
+// =============================================================================
+
+// SSL decryption rule actions
+const (
+	SSLActionDecrypt   = "decrypt"
+	SSLActionNoDecrypt = "no_decrypt"
+	SSLActionBlock     = "block"
+)
+
+// SSLInspectionService provides access to TLS/SSL inspection profile APIs
+type SSLInspectionService struct {
+	client *Client
+}
+
+// SSLInspectionProfile controls which TLS sessions are decrypted for inspection
+type SSLInspectionProfile struct {
+	ID               string              `json:"id"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description,omitempty"`
+	Rules            []SSLDecryptionRule `json:"rules"`
+	ExemptCategories []string            `json:"exempt_categories"`
+	ExemptDomains    []string            `json:"exempt_domains"`
+	MinTLSVersion    string              `json:"min_tls_version"`
+	CACertificateID  string              `json:"ca_certificate_id"`
+	BlockUntrusted   bool                `json:"block_untrusted_certificates"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
+
+// SSLDecryptionRule matches sessions by URL category, domain or user group.
+// Rules are evaluated in order and the first match applies.
+type SSLDecryptionRule struct {
+	Name       string   `json:"name"`
+	Action     string   `json:"action"`
+	Categories []string `json:"categories,omitempty"`
+	Domains    []string `json:"domains,omitempty"`
+	UserGroups []string `json:"user_groups,omitempty"`
+}
+
+// CreateSSLInspectionProfileParams contains parameters for creating an SSL inspection profile
+type CreateSSLInspectionProfileParams struct {
+	Name             string              `json:"name"`
+	Description      string              `json:"description,omitempty"`
+	Rules            []SSLDecryptionRule `json:"rules,omitempty"`
+	ExemptCategories []string            `json:"exempt_categories,omitempty"`
+	ExemptDomains    []string            `json:"exempt_domains,omitempty"`
+	MinTLSVersion    string              `json:"min_tls_version,omitempty"`
+	CACertificateID  string              `json:"ca_certificate_id"`
+	BlockUntrusted   bool                `json:"block_untrusted_certificates"`
+}
+
+// UpdateSSLInspectionProfileParams contains parameters for updating an SSL
+// inspection profile. List fields replace the existing list; set them to an
+// empty slice to clear it.
+type UpdateSSLInspectionProfileParams struct {
+	Name             *string              `json:"name,omitempty"`
+	Description      *string              `json:"description,omitempty"`
+	Rules            *[]SSLDecryptionRule `json:"rules,omitempty"`
+	ExemptCategories *[]string            `json:"exempt_categories,omitempty"`
+	ExemptDomains    *[]string            `json:"exempt_domains,omitempty"`
+	MinTLSVersion    *string              `json:"min_tls_version,omitempty"`
+	CACertificateID  *string              `json:"ca_certificate_id,omitempty"`
+	BlockUntrusted   *bool                `json:"block_untrusted_certificates,omitempty"`
+}
+
+// List retrieves all SSL inspection profiles
+func (s *SSLInspectionService) List(ctx context.Context) ([]SSLInspectionProfile, error) {
+	data, err := s.client.get(ctx, "/security/ssl_inspection/profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []SSLInspectionProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new SSL inspection profile
+func (s *SSLInspectionService) Create(ctx context.Context, params *CreateSSLInspectionProfileParams) (*SSLInspectionProfile, error) {
+	data, err := s.client.post(ctx, "/security/ssl_inspection/profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile SSLInspectionProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves an SSL inspection profile by ID
+func (s *SSLInspectionService) Get(ctx context.Context, profileID string) (*SSLInspectionProfile, error) {
+	data, err := s.client.get(ctx, "/security/ssl_inspection/profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile SSLInspectionProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates an SSL inspection profile
+func (s *SSLInspectionService) Update(ctx context.Context, profileID string, params *UpdateSSLInspectionProfileParams) (*SSLInspectionProfile, error) {
+	data, err := s.client.patch(ctx, "/security/ssl_inspection/profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile SSLInspectionProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes an SSL inspection profile
+func (s *SSLInspectionService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/security/ssl_inspection/profiles/"+profileID, nil)
+}
@@ -0,0 +1,148 @@
+package opensase
+
+import (
+	"context"
+	"time"
+)
+
+// =============================================================================
+// Threat Prevention
+// =============================================================================
+
+// Threat prevention enforcement modes
+const (
+	ThreatModeInline     = "inline"
+	ThreatModeDetectOnly = "detect_only"
+)
+
+// Threat prevention actions
+const (
+	ThreatActionAllow = "allow"
+	ThreatActionAlert = "alert"
+	ThreatActionBlock = "block"
+	ThreatActionReset = "reset"
+)
+
+// ThreatPreventionService provides access to IPS and anti-malware profile APIs
+type ThreatPreventionService struct {
+	client *Client
+}
+
+// ThreatPreventionProfile selects IPS signatures and the action taken on a match
+type ThreatPreventionProfile struct {
+	ID              string                 `json:"id"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description,omitempty"`
+	Mode            string                 `json:"mode"`
+	SignatureSets   []string               `json:"signature_sets"`
+	SeverityActions []ThreatSeverityAction `json:"severity_actions"`
+	Exceptions      []ThreatException      `json:"exceptions"`
+	AntiMalware     bool                   `json:"anti_malware"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
+
+// ThreatSeverityAction is the action for signatures of a severity: critical,
+// high, medium, low or informational
+type ThreatSeverityAction struct {
+	Severity string `json:"severity"`
+	Action   string `json:"action"`
+}
+
+// ThreatException overrides the action for one signature, identified either
+// by CVE or by signature ID
+type ThreatException struct {
+	CVE         string `json:"cve,omitempty"`
+	SignatureID string `json:"signature_id,omitempty"`
+	Action      string `json:"action"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// CreateThreatPreventionProfileParams contains parameters for creating a threat prevention profile
+type CreateThreatPreventionProfileParams struct {
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description,omitempty"`
+	Mode            string                 `json:"mode,omitempty"`
+	SignatureSets   []string               `json:"signature_sets,omitempty"`
+	SeverityActions []ThreatSeverityAction `json:"severity_actions,omitempty"`
+	Exceptions      []ThreatException      `json:"exceptions,omitempty"`
+	AntiMalware     bool                   `json:"anti_malware"`
+}
+
+// UpdateThreatPreventionProfileParams contains parameters for updating a
+// threat prevention profile. List fields replace the existing list; set them
+// to an empty slice to clear it.
+type UpdateThreatPreventionProfileParams struct {
+	Name            *string                 `json:"name,omitempty"`
+	Description     *string                 `json:"description,omitempty"`
+	Mode            *string                 `json:"mode,omitempty"`
+	SignatureSets   *[]string               `json:"signature_sets,omitempty"`
+	SeverityActions *[]ThreatSeverityAction `json:"severity_actions,omitempty"`
+	Exceptions      *[]ThreatException      `json:"exceptions,omitempty"`
+	AntiMalware     *bool                   `json:"anti_malware,omitempty"`
+}
+
+// List retrieves all threat prevention profiles
+func (s *ThreatPreventionService) List(ctx context.Context) ([]ThreatPreventionProfile, error) {
+	data, err := s.client.get(ctx, "/security/threat_prevention/profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []ThreatPreventionProfile
+	if err := s.client.decode(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// Create creates a new threat prevention profile
+func (s *ThreatPreventionService) Create(ctx context.Context, params *CreateThreatPreventionProfileParams) (*ThreatPreventionProfile, error) {
+	data, err := s.client.post(ctx, "/security/threat_prevention/profiles", params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile ThreatPreventionProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Get retrieves a threat prevention profile by ID
+func (s *ThreatPreventionService) Get(ctx context.Context, profileID string) (*ThreatPreventionProfile, error) {
+	data, err := s.client.get(ctx, "/security/threat_prevention/profiles/"+profileID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile ThreatPreventionProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Update updates a threat prevention profile
+func (s *ThreatPreventionService) Update(ctx context.Context, profileID string, params *UpdateThreatPreventionProfileParams) (*ThreatPreventionProfile, error) {
+	data, err := s.client.patch(ctx, "/security/threat_prevention/profiles/"+profileID, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile ThreatPreventionProfile
+	if err := s.client.decode(data, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Delete deletes a threat prevention profile
+func (s *ThreatPreventionService) Delete(ctx context.Context, profileID string) error {
+	return s.client.delete(ctx, "/security/threat_prevention/profiles/"+profileID, nil)
+}
@@ -0,0 +1,345 @@
+package opensase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// Session Token Cache
+// =============================================================================
+
+// DefaultRefreshLeeway is how long before expiry a cached access token is refreshed
+const DefaultRefreshLeeway = time.Minute
+
+var (
+	// ErrTokenNotFound is returned by a TokenStore that holds no token for a key
+	ErrTokenNotFound = errors.New("opensase: no cached token")
+	// ErrLoginRequired is returned when there is no usable session and the
+	// user must log in again, e.g. with Identity.Auth.DeviceCodeFlow
+	ErrLoginRequired = errors.New("opensase: login required")
+)
+
+// Token is a cached user session
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	// UserID is the signed-in user, when the login response named one
+	UserID string `json:"user_id,omitempty"`
+}
+
+// TokenFromLogin converts a login or refresh response into a Token
+func TokenFromLogin(resp *LoginResponse) *Token {
+	token := &Token{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		TokenType:    resp.TokenType,
+		Scope:        resp.Scope,
+		ExpiresAt:    time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second),
+	}
+	if resp.User != nil {
+		token.UserID = resp.User.ID
+	}
+	return token
+}
+
+// expiresWithin reports whether the access token expires within d
+func (t *Token) expiresWithin(d time.Duration) bool {
+	return !t.ExpiresAt.IsZero() && time.Now().Add(d).After(t.ExpiresAt)
+}
+
+// TokenStore persists session tokens so they can be shared between
+// processes. Keys usually name a CLI profile.
+type TokenStore interface {
+	Load(key string) (*Token, error)
+	Save(key string, token *Token) error
+	Delete(key string) error
+}
+
+// FileTokenStore stores each token as a JSON file readable only by the
+// current user
+type FileTokenStore struct {
+	Dir string
+}
+
+// NewFileTokenStore returns a FileTokenStore in dir, or in the opensase
+// directory under os.UserConfigDir when dir is empty
+func NewFileTokenStore(dir string) (*FileTokenStore, error) {
+	if dir == "" {
+		base, err := os.UserConfigDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(base, "opensase", "tokens")
+	}
+	return &FileTokenStore{Dir: dir}, nil
+}
+
+func (s *FileTokenStore) path(key string) string {
+	return filepath.Join(s.Dir, url.PathEscape(key)+".json")
+}
+
+// Load reads the token for key
+func (s *FileTokenStore) Load(key string) (*Token, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("opensase: reading cached token %s: %w", key, err)
+	}
+	return &token, nil
+}
+
+// Save writes the token for key. The file is replaced atomically so a
+// concurrent Load never sees a partial token.
+func (s *FileTokenStore) Save(key string, token *Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.Dir, ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+// Delete removes the token for key
+func (s *FileTokenStore) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// TokenCache authenticates a client with a cached user session instead of
+// an API key. Access tokens are refreshed shortly before they expire and
+// the result is written back to the store, so every tool sharing the store
+// and key reuses the same session.
+//
+//	store, _ := opensase.NewFileTokenStore("")
+//	cache := opensase.NewTokenCache(store, "default")
+//	client := opensase.NewClient("", opensase.WithTokenCache(cache))
+//	if !cache.LoggedIn() {
+//		resp, err := client.Identity.Auth.DeviceCodeFlow(ctx, params)
+//		...
+//		cache.Save(resp)
+//	}
+type TokenCache struct {
+	store  TokenStore
+	key    string
+	leeway time.Duration
+	client *Client
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewTokenCache returns a cache for the session stored under key
+func NewTokenCache(store TokenStore, key string) *TokenCache {
+	return &TokenCache{store: store, key: key, leeway: DefaultRefreshLeeway}
+}
+
+// SetRefreshLeeway sets how long before expiry the access token is refreshed
+func (tc *TokenCache) SetRefreshLeeway(d time.Duration) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.leeway = d
+}
+
+// WithTokenCache authenticates requests with the session held by cache.
+// The API key passed to NewClient may then be empty.
+func WithTokenCache(cache *TokenCache) ClientOption {
+	return func(c *Client) {
+		c.session = cache
+		cache.client = c
+	}
+}
+
+// LoggedIn reports whether a session is cached, without refreshing it
+func (tc *TokenCache) LoggedIn() bool {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.token == nil {
+		token, err := tc.store.Load(tc.key)
+		if err != nil {
+			return false
+		}
+		tc.token = token
+	}
+	return tc.token.RefreshToken != "" || !tc.token.expiresWithin(0)
+}
+
+// identity names the user of the cached session for keying shared data:
+// the user ID when known, else the access token, which changes on every
+// refresh but is never shared between users. It is empty when no session
+// is cached.
+func (tc *TokenCache) identity() string {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.token == nil {
+		token, err := tc.store.Load(tc.key)
+		if err != nil {
+			return ""
+		}
+		tc.token = token
+	}
+	if tc.token.UserID != "" {
+		return "user:" + tc.token.UserID
+	}
+	return "token:" + tc.token.AccessToken
+}
+
+// Save caches the tokens from a login and writes them to the store
+func (tc *TokenCache) Save(resp *LoginResponse) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	token := TokenFromLogin(resp)
+	if err := tc.store.Save(tc.key, token); err != nil {
+		return err
+	}
+	tc.token = token
+	return nil
+}
+
+// Token returns a valid access token, refreshing it when it expires
+// within the refresh leeway
+func (tc *TokenCache) Token(ctx context.Context) (*Token, error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.token != nil && !tc.token.expiresWithin(tc.leeway) {
+		return tc.token, nil
+	}
+
+	// Another process sharing the store may already have refreshed
+	stored, err := tc.store.Load(tc.key)
+	switch {
+	case errors.Is(err, ErrTokenNotFound):
+		tc.token = nil
+		return nil, ErrLoginRequired
+	case err != nil:
+		return nil, err
+	}
+	tc.token = stored
+	if !stored.expiresWithin(tc.leeway) {
+		return stored, nil
+	}
+	if stored.RefreshToken == "" {
+		if !stored.expiresWithin(0) {
+			return stored, nil
+		}
+		return nil, ErrLoginRequired
+	}
+	if tc.client == nil {
+		return nil, fmt.Errorf("opensase: token cache is not attached to a client")
+	}
+
+	resp, err := tc.client.Identity.Auth.Refresh(ctx, stored.RefreshToken)
+	if err != nil {
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || !apiErr.IsAuthenticationError() {
+			return nil, err
+		}
+		// The refresh token may have been rotated by another process
+		// between our load and refresh
+		if latest, lerr := tc.store.Load(tc.key); lerr == nil && latest.RefreshToken != stored.RefreshToken {
+			tc.token = latest
+			return latest, nil
+		}
+		tc.token = nil
+		tc.store.Delete(tc.key)
+		return nil, fmt.Errorf("%w: %v", ErrLoginRequired, err)
+	}
+
+	token := TokenFromLogin(resp)
+	if token.RefreshToken == "" {
+		token.RefreshToken = stored.RefreshToken
+	}
+	if token.UserID == "" {
+		token.UserID = stored.UserID
+	}
+	if err := tc.store.Save(tc.key, token); err != nil {
+		return nil, err
+	}
+	tc.token = token
+	return token, nil
+}
+
+// Logout revokes the session on the server and removes it from the store.
+// The cached session is removed even if revocation fails.
+func (tc *TokenCache) Logout(ctx context.Context, allDevices bool) error {
+	var revokeErr error
+	if token, err := tc.Token(ctx); err == nil && tc.client != nil {
+		revokeErr = tc.client.Identity.Auth.Logout(ctx, token.RefreshToken, allDevices)
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.token = nil
+	if err := tc.store.Delete(tc.key); err != nil {
+		return err
+	}
+	return revokeErr
+}
+
+type sessionBypassKey struct{}
+
+// withoutSession marks ctx for requests that must not use the token cache,
+// such as the login and refresh calls that obtain the session itself
+func withoutSession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionBypassKey{}, true)
+}
+
+// authorization returns the Authorization header value for a request
+func (c *Client) authorization(ctx context.Context) (string, error) {
+	if c.session == nil || ctx.Value(sessionBypassKey{}) != nil {
+		if c.apiKey == "" {
+			return "", nil
+		}
+		return "Bearer " + c.apiKey, nil
+	}
+
+	token, err := c.session.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	return "Bearer " + token.AccessToken, nil
+}
@@ -0,0 +1,225 @@
+package opensase
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// =============================================================================
+// Platform Status
+// =============================================================================
+
+// Component statuses, from best to worst
+const (
+	ComponentOperational   = "operational"
+	ComponentDegraded      = "degraded_performance"
+	ComponentPartialOutage = "partial_outage"
+	ComponentMajorOutage   = "major_outage"
+	ComponentMaintenance   = "under_maintenance"
+)
+
+// Incident statuses
+const (
+	IncidentInvestigating = "investigating"
+	IncidentIdentified    = "identified"
+	IncidentMonitoring    = "monitoring"
+	IncidentResolved      = "resolved"
+)
+
+// Incident impacts
+const (
+	ImpactNone     = "none"
+	ImpactMinor    = "minor"
+	ImpactMajor    = "major"
+	ImpactCritical = "critical"
+)
+
+// StatusService provides access to platform and PoP health as published on
+// the status page
+type StatusService struct {
+	client *Client
+}
+
+// PlatformStatus is a snapshot of platform health. Status is the worst
+// status of any component.
+type PlatformStatus struct {
+	Status       string            `json:"status"`
+	Components   []StatusComponent `json:"components"`
+	Incidents    []Incident        `json:"incidents"`
+	Maintenances []Maintenance     `json:"maintenances"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// StatusComponent is a platform service or PoP tracked on the status page.
+// PoPID is set for PoP components and matches PoP.ID in the catalog.
+type StatusComponent struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Group     string    `json:"group,omitempty"`
+	PoPID     string    `json:"pop_id,omitempty"`
+	Region    string    `json:"region,omitempty"`
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Incident is an unplanned disruption affecting one or more components
+type Incident struct {
+	ID           string           `json:"id"`
+	Title        string           `json:"title"`
+	Status       string           `json:"status"`
+	Impact       string           `json:"impact"`
+	ComponentIDs []string         `json:"component_ids"`
+	Updates      []IncidentUpdate `json:"updates,omitempty"`
+	StartedAt    time.Time        `json:"started_at"`
+	ResolvedAt   *time.Time       `json:"resolved_at,omitempty"`
+	URL          string           `json:"url,omitempty"`
+}
+
+// IncidentUpdate is a progress note posted on an incident
+type IncidentUpdate struct {
+	Status    string    `json:"status"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Maintenance is a scheduled maintenance window
+type Maintenance struct {
+	ID             string    `json:"id"`
+	Title          string    `json:"title"`
+	Description    string    `json:"description,omitempty"`
+	ComponentIDs   []string  `json:"component_ids"`
+	ScheduledStart time.Time `json:"scheduled_start"`
+	ScheduledEnd   time.Time `json:"scheduled_end"`
+	URL            string    `json:"url,omitempty"`
+}
+
+// Active reports whether the maintenance window covers t
+func (m *Maintenance) Active(t time.Time) bool {
+	return !t.Before(m.ScheduledStart) && t.Before(m.ScheduledEnd)
+}
+
+// Operational reports whether every component is operational and no
+// incident is open
+func (s *PlatformStatus) Operational() bool {
+	if s.Status != ComponentOperational {
+		return false
+	}
+	for i := range s.Incidents {
+		if s.Incidents[i].Status != IncidentResolved {
+			return false
+		}
+	}
+	return true
+}
+
+// Affected returns the open incidents and the maintenance windows active at
+// t that involve any of the given components. With no components, all are
+// considered. Tooling can use it to suppress alarms or pause rollouts.
+func (s *PlatformStatus) Affected(t time.Time, componentIDs ...string) ([]Incident, []Maintenance) {
+	want := make(map[string]bool, len(componentIDs))
+	for _, id := range componentIDs {
+		want[id] = true
+	}
+	matches := func(ids []string) bool {
+		if len(want) == 0 {
+			return true
+		}
+		for _, id := range ids {
+			if want[id] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var incidents []Incident
+	for _, inc := range s.Incidents {
+		if inc.Status != IncidentResolved && matches(inc.ComponentIDs) {
+			incidents = append(incidents, inc)
+		}
+	}
+	var maintenances []Maintenance
+	for _, m := range s.Maintenances {
+		if m.Active(t) && matches(m.ComponentIDs) {
+			maintenances = append(maintenances, m)
+		}
+	}
+	return incidents, maintenances
+}
+
+// ComponentForPoP returns the status component of a PoP
+func (s *PlatformStatus) ComponentForPoP(popID string) (*StatusComponent, bool) {
+	for i := range s.Components {
+		if s.Components[i].PoPID == popID {
+			return &s.Components[i], true
+		}
+	}
+	return nil, false
+}
+
+// Get retrieves current component status, open incidents and upcoming maintenance
+func (s *StatusService) Get(ctx context.Context) (*PlatformStatus, error) {
+	data, err := s.client.get(ctx, "/status", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status PlatformStatus
+	if err := s.client.decode(data, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// ListIncidents retrieves incidents, most recent first. An empty status
+// returns incidents in any status.
+func (s *StatusService) ListIncidents(ctx context.Context, status string) ([]Incident, error) {
+	v := url.Values{}
+	if status != "" {
+		v.Set("status", status)
+	}
+
+	data, err := s.client.get(ctx, "/status/incidents", v, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var incidents []Incident
+	if err := s.client.decode(data, &incidents); err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// GetIncident retrieves an incident with its full update history
+func (s *StatusService) GetIncident(ctx context.Context, incidentID string) (*Incident, error) {
+	data, err := s.client.get(ctx, "/status/incidents/"+incidentID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var incident Incident
+	if err := s.client.decode(data, &incident); err != nil {
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+// ListMaintenances retrieves active and upcoming maintenance windows
+func (s *StatusService) ListMaintenances(ctx context.Context) ([]Maintenance, error) {
+	data, err := s.client.get(ctx, "/status/maintenances", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var maintenances []Maintenance
+	if err := s.client.decode(data, &maintenances); err != nil {
+		return nil, err
+	}
+
+	return maintenances, nil
+}
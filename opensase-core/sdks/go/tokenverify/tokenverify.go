@@ -0,0 +1,604 @@
+// Package tokenverify validates access tokens issued by OpenSASE SSO.
+//
+// Services behind OpenSASE SSO receive the user's access token as a bearer
+// token. A Verifier checks its signature against the platform's published
+// signing keys (JWKS), which it fetches and caches, then checks the issuer,
+// audience, expiry and tenant before returning typed claims:
+//
+//	v := tokenverify.New("https://helpdesk.internal.acme.com",
+//	    tokenverify.WithTenant(tenantID))
+//	claims, err := v.Verify(ctx, token)
+//	if err != nil {
+//	    return err // errors.Is(err, tokenverify.ErrExpired), ...
+//	}
+//	if !claims.HasScope("tickets:write") { ... }
+//
+// Middleware does the same for every request of an http.Handler and stores
+// the claims on the request context.
+package tokenverify
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultIssuer is the issuer of platform access tokens
+	DefaultIssuer = "https://api.opensase.billyronks.io"
+
+	// DefaultJWKSURL serves the platform's token signing keys
+	DefaultJWKSURL = DefaultIssuer + "/v1/identity/.well-known/jwks.json"
+
+	// DefaultCacheTTL is how long fetched signing keys are trusted before refetching
+	DefaultCacheTTL = time.Hour
+
+	// DefaultLeeway is the clock skew tolerated on exp and nbf
+	DefaultLeeway = 30 * time.Second
+
+	// minRefreshInterval limits JWKS refetches triggered by unknown key IDs,
+	// so tokens with made-up kids cannot make the verifier hammer the endpoint
+	minRefreshInterval = time.Minute
+
+	// fetchRetryInterval is how long a failed JWKS fetch is left before the
+	// next attempt; meanwhile the keys fetched last are still used
+	fetchRetryInterval = 5 * time.Second
+
+	// fetchTimeout bounds a JWKS fetch, which runs detached from the
+	// request that started it
+	fetchTimeout = 30 * time.Second
+)
+
+// Verification errors. Errors returned by Verify wrap one of these.
+var (
+	ErrMalformed     = errors.New("tokenverify: malformed token")
+	ErrAlgorithm     = errors.New("tokenverify: unsupported signing algorithm")
+	ErrUnknownKey    = errors.New("tokenverify: unknown signing key")
+	ErrSignature     = errors.New("tokenverify: invalid signature")
+	ErrExpired       = errors.New("tokenverify: token expired")
+	ErrNotYetValid   = errors.New("tokenverify: token not yet valid")
+	ErrIssuer        = errors.New("tokenverify: unexpected issuer")
+	ErrAudience      = errors.New("tokenverify: unexpected audience")
+	ErrTenant        = errors.New("tokenverify: unexpected tenant")
+	ErrMissingBearer = errors.New("tokenverify: missing bearer token")
+)
+
+// Claims are the claims of a verified access token
+type Claims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	TenantID  string
+	Email     string
+	ClientID  string
+	SessionID string
+	Scopes    []string
+	Roles     []string
+	IssuedAt  time.Time
+	NotBefore time.Time
+	ExpiresAt time.Time
+
+	// Raw holds every claim, including ones without a typed field
+	Raw map[string]interface{}
+}
+
+// HasScope reports whether the token was granted scope
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole reports whether the token's user holds role
+func (c *Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Option configures a Verifier
+type Option func(*Verifier)
+
+// WithIssuer overrides the expected issuer, e.g. for a regional or staging deployment
+func WithIssuer(issuer string) Option {
+	return func(v *Verifier) {
+		v.issuer = issuer
+	}
+}
+
+// WithJWKSURL overrides where signing keys are fetched from
+func WithJWKSURL(u string) Option {
+	return func(v *Verifier) {
+		v.jwksURL = u
+	}
+}
+
+// WithTenant rejects tokens issued for any other tenant
+func WithTenant(tenantID string) Option {
+	return func(v *Verifier) {
+		v.tenantID = tenantID
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to fetch signing keys
+func WithHTTPClient(c *http.Client) Option {
+	return func(v *Verifier) {
+		v.httpClient = c
+	}
+}
+
+// WithCacheTTL sets how long fetched signing keys are trusted
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(v *Verifier) {
+		v.cacheTTL = ttl
+	}
+}
+
+// WithLeeway sets the clock skew tolerated on exp and nbf
+func WithLeeway(d time.Duration) Option {
+	return func(v *Verifier) {
+		v.leeway = d
+	}
+}
+
+// Verifier validates access tokens. It is safe for concurrent use and should
+// be shared, so signing keys are fetched once rather than per request.
+type Verifier struct {
+	audience   string
+	issuer     string
+	jwksURL    string
+	tenantID   string
+	httpClient *http.Client
+	cacheTTL   time.Duration
+	leeway     time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]signingKey
+	fetchedAt time.Time
+	// nextFetch is the earliest time another fetch may start, and fetchErr
+	// the error of the last fetch if it failed
+	nextFetch time.Time
+	fetchErr  error
+	// fetching is closed when the fetch in flight, if any, completes
+	fetching chan struct{}
+}
+
+// signingKey is a public key from the JWKS with the algorithm it is
+// published for, if any
+type signingKey struct {
+	pub crypto.PublicKey
+	alg string
+}
+
+// New creates a Verifier accepting tokens whose aud claim contains audience
+func New(audience string, opts ...Option) *Verifier {
+	v := &Verifier{
+		audience:   audience,
+		issuer:     DefaultIssuer,
+		jwksURL:    DefaultJWKSURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		cacheTTL:   DefaultCacheTTL,
+		leeway:     DefaultLeeway,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Verify checks the token's signature and standard claims and returns its claims
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: expected 3 segments, got %d", ErrMalformed, len(parts))
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrMalformed, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrMalformed, err)
+	}
+
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrMalformed, err)
+	}
+	claims := parseClaims(raw)
+
+	now := v.now()
+	if claims.ExpiresAt.IsZero() || now.After(claims.ExpiresAt.Add(v.leeway)) {
+		return nil, fmt.Errorf("%w at %s", ErrExpired, claims.ExpiresAt.Format(time.RFC3339))
+	}
+	if !claims.NotBefore.IsZero() && now.Add(v.leeway).Before(claims.NotBefore) {
+		return nil, fmt.Errorf("%w until %s", ErrNotYetValid, claims.NotBefore.Format(time.RFC3339))
+	}
+	if claims.Issuer != v.issuer {
+		return nil, fmt.Errorf("%w %q", ErrIssuer, claims.Issuer)
+	}
+	if !contains(claims.Audience, v.audience) {
+		return nil, fmt.Errorf("%w %q", ErrAudience, claims.Audience)
+	}
+	if v.tenantID != "" && claims.TenantID != v.tenantID {
+		return nil, fmt.Errorf("%w %q", ErrTenant, claims.TenantID)
+	}
+
+	return claims, nil
+}
+
+type claimsKey struct{}
+
+// Middleware verifies the bearer token of every request and stores its claims
+// on the request context, retrievable with FromContext. Requests without a
+// valid token are rejected with 401.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			unauthorized(w, ErrMissingBearer)
+			return
+		}
+		claims, err := v.Verify(r.Context(), token)
+		if err != nil {
+			unauthorized(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), claimsKey{}, claims)))
+	})
+}
+
+// FromContext returns the claims stored by Middleware
+func FromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*Claims)
+	return c, ok
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get("Authorization")
+	if len(h) < 7 || !strings.EqualFold(h[:7], "bearer ") {
+		return "", false
+	}
+	token := strings.TrimSpace(h[7:])
+	return token, token != ""
+}
+
+func unauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	http.Error(w, err.Error(), http.StatusUnauthorized)
+}
+
+// key returns the signing key for kid. Keys are fetched when the cache is
+// stale or, at most once per minRefreshInterval, when kid is not in it
+// because keys were rotated. Only one fetch runs at a time and it runs
+// outside the lock: a known key is served from the cache meanwhile, even if
+// stale, and requests for an unknown key wait for the fetch. When fetches
+// fail the cached keys keep being used, and fetches are retried every
+// fetchRetryInterval.
+func (v *Verifier) key(ctx context.Context, kid string) (signingKey, error) {
+	v.mu.Lock()
+	now := v.now()
+	key, known := v.keys[kid]
+	stale := v.keys == nil || now.Sub(v.fetchedAt) > v.cacheTTL
+	if known && !stale {
+		v.mu.Unlock()
+		return key, nil
+	}
+
+	wait := v.fetching
+	if wait == nil && !now.Before(v.nextFetch) {
+		wait = v.startFetch(ctx)
+	}
+	fetchErr := v.fetchErr
+	v.mu.Unlock()
+
+	if known {
+		return key, nil
+	}
+	if wait == nil {
+		if fetchErr != nil {
+			return signingKey{}, fetchErr
+		}
+		return signingKey{}, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+
+	select {
+	case <-wait:
+	case <-ctx.Done():
+		return signingKey{}, ctx.Err()
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if v.fetchErr != nil {
+		return signingKey{}, v.fetchErr
+	}
+	return signingKey{}, fmt.Errorf("%w %q", ErrUnknownKey, kid)
+}
+
+// startFetch fetches the JWKS in the background and returns a channel
+// closed when it completes. v.mu must be held.
+func (v *Verifier) startFetch(ctx context.Context) chan struct{} {
+	done := make(chan struct{})
+	v.fetching = done
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		keys, err := v.fetchKeys(ctx)
+		cancel()
+
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		now := v.now()
+		if err != nil {
+			v.fetchErr = err
+			v.nextFetch = now.Add(fetchRetryInterval)
+		} else {
+			v.keys, v.fetchedAt, v.fetchErr = keys, now, nil
+			v.nextFetch = now.Add(minRefreshInterval)
+		}
+		v.fetching = nil
+		close(done)
+	}()
+	return done
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context) (map[string]signingKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.jwksURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tokenverify: fetching signing keys: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tokenverify: fetching signing keys: %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("tokenverify: decoding signing keys: %w", err)
+	}
+
+	keys := make(map[string]signingKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// Skip keys of types this package does not support rather than
+			// failing verification with every other key
+			continue
+		}
+		keys[k.Kid] = signingKey{pub: key, alg: k.Alg}
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var pub ecdsa.PublicKey
+		switch k.Crv {
+		case "P-256":
+			pub.Curve = elliptic.P256()
+		case "P-384":
+			pub.Curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		pub.X, pub.Y = x, y
+		return &pub, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// verifySignature checks sig with key. The key must be of the type and, for
+// EC keys, on the curve alg requires, and be published for alg if the JWKS
+// names an algorithm, so a key cannot be used with an algorithm it was not
+// meant for.
+func verifySignature(alg string, key signingKey, signed string, sig []byte) error {
+	var (
+		h      hash.Hash
+		hashID crypto.Hash
+		curve  elliptic.Curve
+	)
+	switch alg {
+	case "RS256":
+		h, hashID = sha256.New(), crypto.SHA256
+	case "RS384":
+		h, hashID = sha512.New384(), crypto.SHA384
+	case "RS512":
+		h, hashID = sha512.New(), crypto.SHA512
+	case "ES256":
+		h, curve = sha256.New(), elliptic.P256()
+	case "ES384":
+		h, curve = sha512.New384(), elliptic.P384()
+	default:
+		return fmt.Errorf("%w %q", ErrAlgorithm, alg)
+	}
+	if key.alg != "" && key.alg != alg {
+		return fmt.Errorf("%w: %s token signed with a key for %s", ErrAlgorithm, alg, key.alg)
+	}
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS":
+		pub, ok := key.pub.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s token signed with a non-RSA key", ErrAlgorithm, alg)
+		}
+		if err := rsa.VerifyPKCS1v15(pub, hashID, digest, sig); err != nil {
+			return ErrSignature
+		}
+	case "ES":
+		pub, ok := key.pub.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s token signed with a non-EC key", ErrAlgorithm, alg)
+		}
+		if pub.Curve != curve {
+			return fmt.Errorf("%w: %s token signed with a %s key", ErrAlgorithm, alg, pub.Curve.Params().Name)
+		}
+		// JWS ECDSA signatures are the fixed-size concatenation r || s
+		size := (curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return ErrSignature
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return ErrSignature
+		}
+	}
+	return nil
+}
+
+func parseClaims(raw map[string]interface{}) *Claims {
+	c := &Claims{
+		Issuer:    stringClaim(raw, "iss"),
+		Subject:   stringClaim(raw, "sub"),
+		Audience:  stringsClaim(raw, "aud"),
+		TenantID:  stringClaim(raw, "tenant_id"),
+		Email:     stringClaim(raw, "email"),
+		ClientID:  stringClaim(raw, "client_id"),
+		SessionID: stringClaim(raw, "sid"),
+		Roles:     stringsClaim(raw, "roles"),
+		IssuedAt:  timeClaim(raw, "iat"),
+		NotBefore: timeClaim(raw, "nbf"),
+		ExpiresAt: timeClaim(raw, "exp"),
+		Raw:       raw,
+	}
+	if scope := stringClaim(raw, "scope"); scope != "" {
+		c.Scopes = strings.Fields(scope)
+	}
+	return c
+}
+
+func stringClaim(raw map[string]interface{}, name string) string {
+	s, _ := raw[name].(string)
+	return s
+}
+
+// stringsClaim reads a claim that may be a single string or an array of
+// strings, as aud is allowed to be
+func stringsClaim(raw map[string]interface{}, name string) []string {
+	switch v := raw[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func timeClaim(raw map[string]interface{}, name string) time.Time {
+	f, ok := raw[name].(float64)
+	if !ok {
+		return time.Time{}
+	}
+	return time.Unix(int64(f), 0)
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
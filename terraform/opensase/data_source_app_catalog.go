@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ============ App Catalog Data Source ============
+
+func dataSourceAppCatalog() *schema.Resource {
+	return &schema.Resource{
+		Description: "Application catalog and its categories. opensase_app categories are validated against it.",
+		ReadContext: dataSourceAppCatalogRead,
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return applications in this category",
+			},
+			"categories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          {Type: schema.TypeString, Computed: true},
+						"name":        {Type: schema.TypeString, Computed: true},
+						"category":    {Type: schema.TypeString, Computed: true},
+						"subcategory": {Type: schema.TypeString, Computed: true},
+						"risk_level":  {Type: schema.TypeInt, Computed: true},
+						"custom":      {Type: schema.TypeBool, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppCatalogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	apps, err := client.API.Catalog.Applications(ctx)
+	if err != nil {
+		return apiDiagnostics(err, "Error reading application catalog")
+	}
+
+	filter := d.Get("category").(string)
+	seen := map[string]bool{}
+	categories := []string{}
+	applications := []interface{}{}
+	for _, app := range apps {
+		if !seen[app.Category] {
+			seen[app.Category] = true
+			categories = append(categories, app.Category)
+		}
+		if filter != "" && app.Category != filter {
+			continue
+		}
+		applications = append(applications, map[string]interface{}{
+			"id":          app.ID,
+			"name":        app.Name,
+			"category":    app.Category,
+			"subcategory": app.Subcategory,
+			"risk_level":  app.RiskLevel,
+			"custom":      app.Custom,
+		})
+	}
+	sort.Strings(categories)
+
+	d.SetId(client.TenantID)
+	d.Set("categories", categories)
+	d.Set("applications", applications)
+	return nil
+}
+
+// appCategories returns the categories of the application catalog, fetched
+// once per provider run
+func (c *Client) appCategories(ctx context.Context) (map[string]bool, error) {
+	c.catalogMu.Lock()
+	defer c.catalogMu.Unlock()
+
+	if c.categories != nil {
+		return c.categories, nil
+	}
+
+	apps, err := c.API.Catalog.Applications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories := map[string]bool{}
+	for _, app := range apps {
+		categories[app.Category] = true
+	}
+	c.categories = categories
+	return categories, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ============ Quota Data Source ============
+
+func dataSourceQuota() *schema.Resource {
+	return &schema.Resource{
+		Description: "Tenant object limits and current consumption, for plan-time preconditions " +
+			"such as checking available[\"sites\"] before creating more sites",
+		ReadContext: dataSourceQuotaRead,
+		Schema: map[string]*schema.Schema{
+			"limits": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Maximum object count by resource type; 0 means unlimited",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"used": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Current object count by resource type",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"available": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Objects that can still be created by resource type; -1 means unlimited",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"rate_limits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope":          {Type: schema.TypeString, Computed: true},
+						"limit":          {Type: schema.TypeInt, Computed: true},
+						"remaining":      {Type: schema.TypeInt, Computed: true},
+						"window_seconds": {Type: schema.TypeInt, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceQuotaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	quotas, err := client.API.Quotas.Get(ctx)
+	if err != nil {
+		return apiDiagnostics(err, "Error reading quotas")
+	}
+
+	limits := map[string]interface{}{}
+	used := map[string]interface{}{}
+	available := map[string]interface{}{}
+	for i := range quotas.Objects {
+		q := &quotas.Objects[i]
+		limit := q.Limit
+		if q.Unlimited() {
+			limit = 0
+		}
+		limits[q.Resource] = limit
+		used[q.Resource] = q.Used
+		available[q.Resource] = q.Available()
+	}
+
+	rateLimits := make([]interface{}, 0, len(quotas.RateLimits))
+	for _, r := range quotas.RateLimits {
+		rateLimits = append(rateLimits, map[string]interface{}{
+			"scope":          r.Scope,
+			"limit":          r.Limit,
+			"remaining":      r.Remaining,
+			"window_seconds": r.WindowSeconds,
+		})
+	}
+
+	d.SetId(client.TenantID)
+	d.Set("limits", limits)
+	d.Set("used", used)
+	d.Set("available", available)
+	d.Set("rate_limits", rateLimits)
+	return nil
+}
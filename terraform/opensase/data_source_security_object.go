@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Security Object Data Source ============
+
+// securityObjectTypes maps the type argument to the object kind it looks up
+var securityObjectTypes = map[string]string{
+	"address":       objectAddress,
+	"service":       objectService,
+	"service_group": objectServiceGroup,
+	"schedule":      objectSchedule,
+}
+
+func dataSourceSecurityObject() *schema.Resource {
+	types := make([]string, 0, len(securityObjectTypes))
+	for t := range securityObjectTypes {
+		types = append(types, t)
+	}
+
+	return &schema.Resource{
+		Description: "Looks up an address, service, service group or schedule object by name, " +
+			"for rules that reference objects managed outside this configuration",
+		ReadContext: dataSourceSecurityObjectRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(types, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reference_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	objects := client.API.Security.Objects
+	kind := securityObjectTypes[d.Get("type").(string)]
+	name := d.Get("name").(string)
+
+	type match struct {
+		id, description string
+		references      int
+	}
+	var found []match
+	var err error
+	switch kind {
+	case objectAddress:
+		list, e := objects.Addresses.List(ctx)
+		for _, o := range list {
+			if o.Name == name {
+				found = append(found, match{o.ID, o.Description, o.ReferenceCount})
+			}
+		}
+		err = e
+	case objectService:
+		list, e := objects.Services.List(ctx)
+		for _, o := range list {
+			if o.Name == name {
+				found = append(found, match{o.ID, o.Description, o.ReferenceCount})
+			}
+		}
+		err = e
+	case objectServiceGroup:
+		list, e := objects.ServiceGroups.List(ctx)
+		for _, o := range list {
+			if o.Name == name {
+				found = append(found, match{o.ID, o.Description, o.ReferenceCount})
+			}
+		}
+		err = e
+	case objectSchedule:
+		list, e := objects.Schedules.List(ctx)
+		for _, o := range list {
+			if o.Name == name {
+				found = append(found, match{o.ID, o.Description, o.ReferenceCount})
+			}
+		}
+		err = e
+	}
+	if err != nil {
+		return apiDiagnostics(err, "Error listing "+kind+"s")
+	}
+
+	switch len(found) {
+	case 0:
+		return diag.FromErr(fmt.Errorf("no %s named %q", kind, name))
+	case 1:
+	default:
+		return diag.FromErr(fmt.Errorf("%d %ss are named %q", len(found), kind, name))
+	}
+
+	// Later references to the object in this run need not fetch it again
+	client.knownObjects.Store(kind+"/"+found[0].id, true)
+
+	d.SetId(found[0].id)
+	d.Set("description", found[0].description)
+	d.Set("reference_count", found[0].references)
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ============ API Errors ============
+
+// apiDiagnostics converts an API error into diagnostics. Field-level
+// validation errors are attached to the attribute the API reports them on.
+func apiDiagnostics(err error, summary string) diag.Diagnostics {
+	var apiErr *opensase.Error
+	if !errors.As(err, &apiErr) {
+		return diag.Errorf("%s: %s", summary, err)
+	}
+
+	detail := apiErr.Message
+	if hint := apiErr.Hint(); hint != "" {
+		detail += "\n\n" + hint
+	}
+	if apiErr.RequestID != "" {
+		detail += "\n\nRequest ID: " + apiErr.RequestID
+	}
+
+	diags := diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s: %s", summary, apiErr.Code),
+		Detail:   detail,
+	}}
+	for _, d := range apiErr.Details {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       d.Message,
+			Detail:        d.Code,
+			AttributePath: attributePath(d.Field),
+		})
+	}
+	return diags
+}
+
+// attributePath maps an API field such as "wan_links.0.name" to a schema path
+func attributePath(field string) cty.Path {
+	if field == "" {
+		return nil
+	}
+
+	var path cty.Path
+	for _, step := range strings.Split(field, ".") {
+		if i, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(i)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
+
+// isNotFound reports whether err is an API 404, meaning the resource was
+// removed outside Terraform
+func isNotFound(err error) bool {
+	var apiErr *opensase.Error
+	return errors.As(err, &apiErr) && apiErr.IsNotFoundError()
+}
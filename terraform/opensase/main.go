@@ -1,16 +1,22 @@
 // OpenSASE Terraform Provider
 //
-// This is the foundation for a Terraform provider in Go.
-// Full implementation requires the terraform-plugin-sdk.
+// Resources are backed by the OpenSASE Go SDK.
 
 package main
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/enum"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
@@ -22,7 +28,7 @@ func main() {
 
 // Provider returns the OpenSASE provider schema
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_key": {
 				Type:        schema.TypeString,
@@ -42,19 +48,86 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("OPENSASE_TENANT_ID", nil),
 				Description: "Tenant ID",
 			},
+			"rule_usage_stats": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OPENSASE_RULE_USAGE_STATS", false),
+				Description: "Record hit_count and last_matched_at of policies and rules in state. " +
+					"Off by default because the values change on every refresh.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"opensase_site":   resourceSite(),
 			"opensase_policy": resourcePolicy(),
 			"opensase_user":   resourceUser(),
 			"opensase_app":    resourceApp(),
+
+			"opensase_log_retention":             resourceLogRetention(),
+			"opensase_byok_key":                  resourceBYOKKey(),
+			"opensase_ipsec_tunnel":              resourceIPsecTunnel(),
+			"opensase_firewall_rule":             resourceFirewallRule(),
+			"opensase_ztna_application":          resourceZTNAApplication(),
+			"opensase_ztna_access_policy":        resourceZTNAAccessPolicy(),
+			"opensase_wan_link":                  resourceWANLink(),
+			"opensase_sdwan_traffic_policy":      resourceSDWANTrafficPolicy(),
+			"opensase_url_filtering_profile":     resourceURLFilteringProfile(),
+			"opensase_dlp_profile":               resourceDLPProfile(),
+			"opensase_dns_security_profile":      resourceDNSSecurityProfile(),
+			"opensase_threat_prevention_profile": resourceThreatPreventionProfile(),
+			"opensase_ssl_inspection_profile":    resourceSSLInspectionProfile(),
+			"opensase_casb_policy":               resourceCASBPolicy(),
+			"opensase_address_object":            resourceAddressObject(),
+			"opensase_service_object":            resourceServiceObject(),
+			"opensase_export_job":                resourceExportJob(),
+			"opensase_nat_rule":                  resourceNATRule(),
+			"opensase_static_route":              resourceStaticRoute(),
+			"opensase_bgp_peer":                  resourceBGPPeer(),
+			"opensase_qos_profile":               resourceQoSProfile(),
+			"opensase_certificate":               resourceCertificate(),
+			"opensase_edge_device":               resourceEdgeDevice(),
+			"opensase_ha_pair":                   resourceHAPair(),
+			"opensase_admin_role":                resourceAdminRole(),
+			"opensase_role_assignment":           resourceRoleAssignment(),
+			"opensase_api_key":                   resourceAPIKey(),
+			"opensase_idp_integration":           resourceIdPIntegration(),
+			"opensase_scim_provisioning":         resourceSCIMProvisioning(),
+			"opensase_group":                     resourceGroup(),
+			"opensase_group_membership":          resourceGroupMembership(),
+			"opensase_client_vpn_profile":        resourceClientVPNProfile(),
+			"opensase_network_segment":           resourceNetworkSegment(),
+			"opensase_segment_policy":            resourceSegmentPolicy(),
+			"opensase_notification_channel":      resourceNotificationChannel(),
+			"opensase_alert_rule":                resourceAlertRule(),
+			"opensase_log_export":                resourceLogExport(),
+			"opensase_custom_application":        resourceCustomApplication(),
+			"opensase_tenant":                    resourceTenant(),
+			"opensase_maintenance_window":        resourceMaintenanceWindow(),
+			"opensase_schedule_object":           resourceScheduleObject(),
+			"opensase_service_group":             resourceServiceGroup(),
+			"opensase_site_template":             resourceSiteTemplate(),
+			"opensase_vlan":                      resourceVLAN(),
+			"opensase_dhcp_server":               resourceDHCPServer(),
+			"opensase_radius_server":             resourceRADIUSServer(),
+			"opensase_ldap_server":               resourceLDAPServer(),
+			"opensase_snmp":                      resourceSNMP(),
+			"opensase_syslog_destination":        resourceSyslogDestination(),
+			"opensase_geo_restriction_policy":    resourceGeoRestrictionPolicy(),
+			"opensase_browser_isolation_policy":  resourceBrowserIsolationPolicy(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"opensase_sites":    dataSourceSites(),
-			"opensase_policies": dataSourcePolicies(),
+			"opensase_sites":           dataSourceSites(),
+			"opensase_policies":        dataSourcePolicies(),
+			"opensase_quota":           dataSourceQuota(),
+			"opensase_app_catalog":     dataSourceAppCatalog(),
+			"opensase_security_object": dataSourceSecurityObject(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for _, r := range p.ResourcesMap {
+		addTenantOverride(r)
+	}
+	return p
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -63,9 +136,14 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	tenantID := d.Get("tenant_id").(string)
 
 	return &Client{
-		APIKey:   apiKey,
-		APIURL:   apiURL,
-		TenantID: tenantID,
+		APIKey:         apiKey,
+		APIURL:         apiURL,
+		TenantID:       tenantID,
+		RuleUsageStats: d.Get("rule_usage_stats").(bool),
+		API: opensase.NewClient(apiKey,
+			opensase.WithBaseURL(apiURL),
+			opensase.WithTenant(tenantID),
+		),
 	}, nil
 }
 
@@ -74,6 +152,68 @@ type Client struct {
 	APIKey   string
 	APIURL   string
 	TenantID string
+	API      *opensase.Client
+
+	// RuleUsageStats enables the hit_count and last_matched_at attributes
+	RuleUsageStats bool
+
+	catalogMu  sync.Mutex
+	categories map[string]bool
+
+	// knownObjects caches security objects found by objectExists
+	knownObjects sync.Map
+}
+
+// setRuleUsage records the usage counters of a policy or rule when the
+// provider's rule_usage_stats is enabled. Otherwise the attributes stay
+// empty, so refreshes don't report drift every time a rule matches.
+func setRuleUsage(d *schema.ResourceData, client *Client, usage opensase.RuleUsage) {
+	if !client.RuleUsageStats {
+		return
+	}
+	d.Set("hit_count", usage.HitCount)
+	if usage.LastMatchedAt != nil {
+		d.Set("last_matched_at", usage.LastMatchedAt.Format(time.RFC3339))
+	} else {
+		d.Set("last_matched_at", "")
+	}
+}
+
+// addTenantOverride adds a tenant_id argument to a resource, so MSPs can
+// manage objects in child tenants with the parent tenant's credentials.
+// Every API call of the resource is made in that tenant.
+func addTenantOverride(r *schema.Resource) {
+	r.Schema["tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Child tenant to manage the resource in, instead of the provider's tenant_id",
+	}
+
+	r.CreateContext = inTenant(r.CreateContext)
+	r.ReadContext = inTenant(r.ReadContext)
+	r.UpdateContext = inTenant(r.UpdateContext)
+	r.DeleteContext = inTenant(r.DeleteContext)
+	if fn := r.CustomizeDiff; fn != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if tenantID, ok := d.Get("tenant_id").(string); ok && tenantID != "" {
+				ctx = opensase.WithTargetTenant(ctx, tenantID)
+			}
+			return fn(ctx, d, m)
+		}
+	}
+}
+
+func inTenant(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if tenantID := d.Get("tenant_id").(string); tenantID != "" {
+			ctx = opensase.WithTargetTenant(ctx, tenantID)
+		}
+		return fn(ctx, d, m)
+	}
 }
 
 // ============ Site Resource ============
@@ -87,6 +227,9 @@ func resourceSite() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -103,9 +246,16 @@ func resourceSite() *schema.Resource {
 				Computed:    true,
 				Description: "Site status",
 			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of an opensase_site_template supplying the site's security and QoS profiles, and its WAN links when wan_links is omitted",
+			},
 			"wan_links": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Inline WAN links. Omit when the site's links are managed with opensase_wan_link.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {Type: schema.TypeString, Required: true},
@@ -113,28 +263,147 @@ func resourceSite() *schema.Resource {
 					},
 				},
 			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Delete the site immediately on destroy instead of decommissioning it first. " +
+					"Must be applied before the destroy to take effect.",
+			},
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(0, 3600),
+				Description:  "Seconds existing sessions may keep using the site's tunnels while it is decommissioned",
+			},
 		},
 	}
 }
 
+func expandWANLinks(raw []interface{}) []opensase.WANLink {
+	links := make([]opensase.WANLink, 0, len(raw))
+	for _, r := range raw {
+		l := r.(map[string]interface{})
+		links = append(links, opensase.WANLink{
+			Name: l["name"].(string),
+			Type: enum.WANLinkType(l["type"].(string)),
+		})
+	}
+	return links
+}
+
+func flattenWANLinks(links []opensase.WANLink) []interface{} {
+	out := make([]interface{}, 0, len(links))
+	for _, l := range links {
+		out = append(out, map[string]interface{}{
+			"name": l.Name,
+			"type": string(l.Type),
+		})
+	}
+	return out
+}
+
 func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Implementation: call API to create site
-	d.SetId("site_" + d.Get("name").(string))
+	client := m.(*Client)
+
+	site, err := client.API.Network.Sites.Create(ctx, &opensase.CreateSiteParams{
+		Name:       d.Get("name").(string),
+		Location:   d.Get("location").(string),
+		TemplateID: d.Get("template_id").(string),
+		WANLinks:   expandWANLinks(d.Get("wan_links").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating site")
+	}
+
+	d.SetId(site.ID)
 	return resourceSiteRead(ctx, d, m)
 }
 
 func resourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Implementation: call API to read site
+	client := m.(*Client)
+
+	site, err := client.API.Network.Sites.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading site")
+	}
+
+	d.Set("name", site.Name)
+	d.Set("location", site.Location)
+	d.Set("status", string(site.Status))
+	d.Set("template_id", site.TemplateID)
+	d.Set("wan_links", flattenWANLinks(site.WANLinks))
 	return nil
 }
 
 func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Implementation: call API to update site
+	client := m.(*Client)
+
+	// force_destroy and drain_timeout only affect destroy
+	if !d.HasChanges("name", "location", "template_id", "wan_links") {
+		return resourceSiteRead(ctx, d, m)
+	}
+
+	params := &opensase.UpdateSiteParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("location") {
+		params.Location = opensase.String(d.Get("location").(string))
+	}
+	if d.HasChange("template_id") {
+		params.TemplateID = opensase.String(d.Get("template_id").(string))
+	}
+	if d.HasChange("wan_links") {
+		params.WANLinks = expandWANLinks(d.Get("wan_links").([]interface{}))
+	}
+
+	if _, err := client.API.Network.Sites.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating site")
+	}
+
 	return resourceSiteRead(ctx, d, m)
 }
 
+// resourceSiteDelete decommissions a site before deleting it, so removing a
+// module block drains an active branch rather than cutting it off. With
+// force_destroy the site is deleted straight away.
 func resourceSiteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Implementation: call API to delete site
+	client := m.(*Client)
+
+	if !d.Get("force_destroy").(bool) {
+		site, err := client.API.Network.Sites.Get(ctx, d.Id())
+		if err != nil {
+			if isNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return apiDiagnostics(err, "Error reading site")
+		}
+
+		if site.Status != enum.SiteStatusDecommissioned {
+			job, err := client.API.Network.Sites.Decommission(ctx, d.Id(), &opensase.DecommissionSiteParams{
+				DrainTimeoutSeconds: opensase.Int(d.Get("drain_timeout").(int)),
+				Reason:              "terraform destroy",
+			})
+			if err != nil {
+				return apiDiagnostics(err, "Error decommissioning site")
+			}
+			if _, err := client.API.Jobs.Wait(ctx, job.ID, 10*time.Second); err != nil {
+				return diag.Errorf("Error decommissioning site %s: %s. Set force_destroy to delete it anyway.", d.Id(), err)
+			}
+		}
+	}
+
+	if err := client.API.Network.Sites.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting site")
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -147,6 +416,9 @@ func resourcePolicy() *schema.Resource {
 		ReadContext:   resourcePolicyRead,
 		UpdateContext: resourcePolicyUpdate,
 		DeleteContext: resourcePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -162,8 +434,9 @@ func resourcePolicy() *schema.Resource {
 				Default:  100,
 			},
 			"action": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(enum.Strings(enum.PolicyActions()), false),
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
@@ -181,24 +454,124 @@ func resourcePolicy() *schema.Resource {
 					},
 				},
 			},
+			"hit_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Times the rule has matched traffic. Only populated with the provider's rule_usage_stats enabled.",
+			},
+			"last_matched_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the rule last matched traffic. Only populated with the provider's rule_usage_stats enabled.",
+			},
 		},
 	}
 }
 
+func expandPolicyConditions(raw []interface{}) []opensase.PolicyCondition {
+	conditions := make([]opensase.PolicyCondition, 0, len(raw))
+	for _, r := range raw {
+		c := r.(map[string]interface{})
+		conditions = append(conditions, opensase.PolicyCondition{
+			Field:    c["field"].(string),
+			Operator: c["operator"].(string),
+			Value:    c["value"].(string),
+		})
+	}
+	return conditions
+}
+
+func flattenPolicyConditions(conditions []opensase.PolicyCondition) []interface{} {
+	out := make([]interface{}, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, map[string]interface{}{
+			"field":    c.Field,
+			"operator": c.Operator,
+			"value":    c.Value,
+		})
+	}
+	return out
+}
+
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	d.SetId("policy_" + d.Get("name").(string))
-	return nil
+	client := m.(*Client)
+
+	policy, err := client.API.Security.Policies.Create(ctx, &opensase.CreatePolicyParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Priority:    d.Get("priority").(int),
+		Action:      enum.PolicyAction(d.Get("action").(string)),
+		Enabled:     opensase.Bool(d.Get("enabled").(bool)),
+		Conditions:  expandPolicyConditions(d.Get("conditions").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourcePolicyRead(ctx, d, m)
 }
 
 func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.Policies.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("priority", policy.Priority)
+	d.Set("action", string(policy.Action))
+	d.Set("enabled", policy.Enabled)
+	d.Set("conditions", flattenPolicyConditions(policy.Conditions))
+	setRuleUsage(d, client, policy.RuleUsage)
 	return nil
 }
 
 func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return nil
+	client := m.(*Client)
+
+	params := &opensase.UpdatePolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("action") {
+		params.Action = opensase.Ptr(enum.PolicyAction(d.Get("action").(string)))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("conditions") {
+		conditions := expandPolicyConditions(d.Get("conditions").([]interface{}))
+		params.Conditions = &conditions
+	}
+
+	if _, err := client.API.Security.Policies.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating policy")
+	}
+
+	return resourcePolicyRead(ctx, d, m)
 }
 
 func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.Policies.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting policy")
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -207,48 +580,367 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			d.SetId("user_" + d.Get("email").(string))
-			return nil
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
 		},
-		ReadContext:   func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { return nil },
-		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { return nil },
-		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { d.SetId(""); return nil },
 		Schema: map[string]*schema.Schema{
-			"email": {Type: schema.TypeString, Required: true},
+			"email": {Type: schema.TypeString, Required: true, ForceNew: true},
 			"name":  {Type: schema.TypeString, Required: true},
 			"role":  {Type: schema.TypeString, Optional: true, Default: "viewer"},
 		},
 	}
 }
 
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	user, err := client.API.Identity.Users.Create(ctx, &opensase.CreateUserParams{
+		Email:   d.Get("email").(string),
+		Profile: &opensase.UserProfile{DisplayName: d.Get("name").(string)},
+		Roles:   []string{d.Get("role").(string)},
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating user")
+	}
+
+	d.SetId(user.ID)
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	user, err := client.API.Identity.Users.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading user")
+	}
+
+	d.Set("email", user.Email)
+	if user.Profile != nil {
+		d.Set("name", user.Profile.DisplayName)
+	}
+	if len(user.Roles) > 0 {
+		d.Set("role", user.Roles[0])
+	}
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateUserParams{}
+	if d.HasChange("name") {
+		params.Profile = &opensase.UserProfile{DisplayName: d.Get("name").(string)}
+	}
+	if d.HasChange("role") {
+		params.Roles = []string{d.Get("role").(string)}
+	}
+
+	if _, err := client.API.Identity.Users.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating user")
+	}
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.Users.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting user")
+	}
+
+	d.SetId("")
+	return nil
+}
+
 // ============ App Resource ============
 
 func resourceApp() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			d.SetId("app_" + d.Get("name").(string))
-			return nil
+		CreateContext: resourceAppCreate,
+		ReadContext:   resourceAppRead,
+		UpdateContext: resourceAppUpdate,
+		DeleteContext: resourceAppDelete,
+		CustomizeDiff: validateApp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
 		},
-		ReadContext:   func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { return nil },
-		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { return nil },
-		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { d.SetId(""); return nil },
 		Schema: map[string]*schema.Schema{
-			"name":     {Type: schema.TypeString, Required: true},
-			"category": {Type: schema.TypeString, Required: true},
-			"action":   {Type: schema.TypeString, Required: true},
+			"name": {Type: schema.TypeString, Required: true},
+			"category": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Category from the opensase_app_catalog data source",
+			},
+			"action": {Type: schema.TypeString, Required: true},
+			"risk_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "1 (minimal) to 5 (critical). Defaults to the catalog risk; required for custom apps.",
+				ValidateFunc: validation.IntBetween(opensase.AppRiskMinimal, opensase.AppRiskCritical),
+			},
+			"default_qos_class": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "QoS class, by name, for the app's traffic when no traffic policy classifies it",
+			},
+			"signature": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Traffic signatures of a custom application",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tls_server_names": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "TLS SNI values, optionally with a leading *. wildcard",
+						},
+						"port": appPortSchema(),
+					},
+				},
+			},
+			"custom": {Type: schema.TypeBool, Computed: true},
 		},
 	}
 }
 
+func validateApp(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if sig := expandAppSignatures(d.Get("signature").([]interface{})); sig != nil {
+		if len(sig.TLSServerNames) == 0 && len(sig.Ports) == 0 {
+			return fmt.Errorf("signature: at least one tls_server_names entry or port is required")
+		}
+		if err := validateAppDomains(sig.TLSServerNames); err != nil {
+			return fmt.Errorf("signature: %w", err)
+		}
+		if err := validateAppPorts(sig.Ports); err != nil {
+			return fmt.Errorf("signature: %w", err)
+		}
+		if cfg := d.GetRawConfig(); cfg.IsKnown() && !cfg.IsNull() && cfg.GetAttr("risk_level").IsNull() {
+			return fmt.Errorf("risk_level: required for custom apps")
+		}
+	}
+
+	return validateAppCategory(ctx, d, m.(*Client))
+}
+
+// appPortSchema is a custom application port or port range
+func appPortSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"end_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Last port of a range; omit for a single port",
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+		},
+	}
+}
+
+func validateAppDomains(names []string) error {
+	for _, name := range names {
+		if strings.Contains(strings.TrimPrefix(name, "*."), "*") {
+			return fmt.Errorf("%q may only use a leading *. wildcard", name)
+		}
+	}
+	return nil
+}
+
+func validateAppPorts(ports []opensase.AppPort) error {
+	for _, p := range ports {
+		if p.EndPort != 0 && p.EndPort < p.Port {
+			return fmt.Errorf("end_port %d is below port %d", p.EndPort, p.Port)
+		}
+	}
+	return nil
+}
+
+// validateAppCategory checks a changed category against the application catalog
+func validateAppCategory(ctx context.Context, d *schema.ResourceDiff, client *Client) error {
+	if !d.NewValueKnown("category") || !d.HasChange("category") {
+		return nil
+	}
+	categories, err := client.appCategories(ctx)
+	if err != nil {
+		return fmt.Errorf("reading application catalog: %w", err)
+	}
+	category := d.Get("category").(string)
+	if !categories[category] {
+		valid := make([]string, 0, len(categories))
+		for c := range categories {
+			valid = append(valid, c)
+		}
+		sort.Strings(valid)
+		return fmt.Errorf("category: %q is not in the application catalog; expected one of %s", category, strings.Join(valid, ", "))
+	}
+	return nil
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	app, err := client.API.Security.Apps.Create(ctx, &opensase.CreateAppParams{
+		Name:       d.Get("name").(string),
+		Category:   d.Get("category").(string),
+		Action:     d.Get("action").(string),
+		RiskLevel:  d.Get("risk_level").(int),
+		QoSClass:   d.Get("default_qos_class").(string),
+		Signatures: expandAppSignatures(d.Get("signature").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating app")
+	}
+
+	d.SetId(app.ID)
+	return resourceAppRead(ctx, d, m)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	app, err := client.API.Security.Apps.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading app")
+	}
+
+	d.Set("name", app.Name)
+	d.Set("category", app.Category)
+	d.Set("action", app.Action)
+	d.Set("risk_level", app.RiskLevel)
+	d.Set("default_qos_class", app.QoSClass)
+	d.Set("signature", flattenAppSignatures(app.Signatures))
+	d.Set("custom", app.Custom)
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateAppParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("category") {
+		params.Category = opensase.String(d.Get("category").(string))
+	}
+	if d.HasChange("action") {
+		params.Action = opensase.String(d.Get("action").(string))
+	}
+	if d.HasChange("risk_level") {
+		params.RiskLevel = opensase.Int(d.Get("risk_level").(int))
+	}
+	if d.HasChange("default_qos_class") {
+		params.QoSClass = opensase.String(d.Get("default_qos_class").(string))
+	}
+	if d.HasChange("signature") {
+		params.Signatures = expandAppSignatures(d.Get("signature").([]interface{}))
+		if params.Signatures == nil {
+			params.Signatures = &opensase.AppSignatures{}
+		}
+	}
+
+	if _, err := client.API.Security.Apps.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating app")
+	}
+
+	return resourceAppRead(ctx, d, m)
+}
+
+func expandAppSignatures(raw []interface{}) *opensase.AppSignatures {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+
+	sig := &opensase.AppSignatures{
+		TLSServerNames: expandStringSet(m["tls_server_names"].(*schema.Set)),
+		Ports:          expandAppPorts(m["port"].([]interface{})),
+	}
+	return sig
+}
+
+func expandAppPorts(raw []interface{}) []opensase.AppPort {
+	var ports []opensase.AppPort
+	for _, p := range raw {
+		pm := p.(map[string]interface{})
+		ports = append(ports, opensase.AppPort{
+			Protocol: pm["protocol"].(string),
+			Port:     pm["port"].(int),
+			EndPort:  pm["end_port"].(int),
+		})
+	}
+	return ports
+}
+
+func flattenAppSignatures(sig *opensase.AppSignatures) []interface{} {
+	if sig == nil || (len(sig.TLSServerNames) == 0 && len(sig.Ports) == 0) {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"tls_server_names": sig.TLSServerNames,
+		"port":             flattenAppPorts(sig.Ports),
+	}}
+}
+
+func flattenAppPorts(ports []opensase.AppPort) []interface{} {
+	out := make([]interface{}, 0, len(ports))
+	for _, p := range ports {
+		out = append(out, map[string]interface{}{
+			"protocol": p.Protocol,
+			"port":     p.Port,
+			"end_port": p.EndPort,
+		})
+	}
+	return out
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.Apps.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting app")
+	}
+
+	d.SetId("")
+	return nil
+}
+
 // ============ Data Sources ============
 
 func dataSourceSites() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			d.SetId("sites")
-			return nil
-		},
+		ReadContext: dataSourceSitesRead,
 		Schema: map[string]*schema.Schema{
 			"sites": {
 				Type:     schema.TypeList,
@@ -266,12 +958,36 @@ func dataSourceSites() *schema.Resource {
 	}
 }
 
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	var sites []interface{}
+	for page := 1; ; page++ {
+		resp, err := client.API.Network.Sites.List(ctx, &opensase.ListSitesParams{Page: page, PerPage: 100})
+		if err != nil {
+			return apiDiagnostics(err, "Error listing sites")
+		}
+		for _, s := range resp.Data {
+			sites = append(sites, map[string]interface{}{
+				"id":       s.ID,
+				"name":     s.Name,
+				"location": s.Location,
+				"status":   s.Status,
+			})
+		}
+		if page >= resp.Pagination.TotalPages {
+			break
+		}
+	}
+
+	d.SetId("sites")
+	d.Set("sites", sites)
+	return nil
+}
+
 func dataSourcePolicies() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			d.SetId("policies")
-			return nil
-		},
+		ReadContext: dataSourcePoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"policies": {
 				Type:     schema.TypeList,
@@ -288,3 +1004,30 @@ func dataSourcePolicies() *schema.Resource {
 		},
 	}
 }
+
+func dataSourcePoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	var policies []interface{}
+	for page := 1; ; page++ {
+		resp, err := client.API.Security.Policies.List(ctx, &opensase.ListPoliciesParams{Page: page, PerPage: 100})
+		if err != nil {
+			return apiDiagnostics(err, "Error listing policies")
+		}
+		for _, p := range resp.Data {
+			policies = append(policies, map[string]interface{}{
+				"id":       p.ID,
+				"name":     p.Name,
+				"enabled":  p.Enabled,
+				"priority": p.Priority,
+			})
+		}
+		if page >= resp.Pagination.TotalPages {
+			break
+		}
+	}
+
+	d.SetId("policies")
+	d.Set("policies", policies)
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Kinds of security objects that rules, policies and groups reference by ID
+const (
+	objectAddress      = "address object"
+	objectService      = "service object"
+	objectServiceGroup = "service group"
+	objectSchedule     = "schedule object"
+)
+
+// validateObjectReferences fails the plan when the object ID, or set of
+// IDs, at key names an object that does not exist, for example because it
+// was deleted outside Terraform or the ID was mistyped. IDs of objects
+// created in the same apply are unknown at plan time and skipped; IDs from
+// data sources and existing resources are known and checked.
+func validateObjectReferences(ctx context.Context, d *schema.ResourceDiff, client *Client, key, kind string) error {
+	if !d.NewValueKnown(key) {
+		return nil
+	}
+	var ids []string
+	switch v := d.Get(key).(type) {
+	case *schema.Set:
+		ids = expandStringSet(v)
+	case string:
+		if v != "" {
+			ids = []string{v}
+		}
+	}
+
+	for _, id := range ids {
+		if err := client.objectExists(ctx, kind, id); err != nil {
+			if isNotFound(err) {
+				return fmt.Errorf("%s: %s %q does not exist", key, kind, id)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// objectExists looks up an object of the given kind. Objects found are
+// remembered for the rest of the provider run, so an object referenced by
+// many rules is fetched once per plan.
+func (c *Client) objectExists(ctx context.Context, kind, id string) error {
+	key := kind + "/" + id
+	if _, ok := c.knownObjects.Load(key); ok {
+		return nil
+	}
+
+	objects := c.API.Security.Objects
+	var err error
+	switch kind {
+	case objectAddress:
+		_, err = objects.Addresses.Get(ctx, id)
+	case objectService:
+		_, err = objects.Services.Get(ctx, id)
+	case objectServiceGroup:
+		_, err = objects.ServiceGroups.Get(ctx, id)
+	case objectSchedule:
+		_, err = objects.Schedules.Get(ctx, id)
+	default:
+		return fmt.Errorf("unknown object kind %q", kind)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.knownObjects.Store(key, true)
+	return nil
+}
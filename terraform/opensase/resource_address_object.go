@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Address Object Resource ============
+
+func resourceAddressObject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Named address (CIDR, FQDN, IP range or group) that firewall rules " +
+			"reference by ID. The API refuses to delete objects that are still referenced.",
+		CreateContext: resourceAddressObjectCreate,
+		ReadContext:   resourceAddressObjectRead,
+		UpdateContext: resourceAddressObjectUpdate,
+		DeleteContext: resourceAddressObjectDelete,
+		CustomizeDiff: validateAddressObject,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.AddressObjectCIDR,
+					opensase.AddressObjectFQDN,
+					opensase.AddressObjectRange,
+					opensase.AddressObjectGroup,
+				}, false),
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CIDR, FQDN, or IP range as first-last. Not used by groups.",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the address objects in a group",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"reference_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rules and groups referencing the object",
+			},
+		},
+	}
+}
+
+// validateAddressObject checks that value or members is set to match the
+// type, and that group members exist
+func validateAddressObject(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	objectType := d.Get("type").(string)
+	value := d.Get("value").(string)
+	members := d.Get("members").(*schema.Set).Len()
+
+	if objectType == opensase.AddressObjectGroup {
+		if value != "" {
+			return fmt.Errorf("value cannot be set on a group; use members")
+		}
+		if members == 0 && d.NewValueKnown("members") {
+			return fmt.Errorf("a group needs at least one member")
+		}
+		return validateObjectReferences(ctx, d, m.(*Client), "members", objectAddress)
+	}
+
+	if members > 0 {
+		return fmt.Errorf("members can only be set on a group")
+	}
+	if !d.NewValueKnown("value") {
+		return nil
+	}
+	switch objectType {
+	case opensase.AddressObjectCIDR:
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return fmt.Errorf("value %q is not a valid CIDR", value)
+		}
+	case opensase.AddressObjectRange:
+		first, last, ok := strings.Cut(value, "-")
+		if !ok || net.ParseIP(strings.TrimSpace(first)) == nil || net.ParseIP(strings.TrimSpace(last)) == nil {
+			return fmt.Errorf("value %q must be an IP range such as 10.0.0.10-10.0.0.20", value)
+		}
+	case opensase.AddressObjectFQDN:
+		if value == "" || strings.ContainsAny(value, "/: ") {
+			return fmt.Errorf("value %q is not a valid FQDN", value)
+		}
+	}
+	return nil
+}
+
+func resourceAddressObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	object, err := client.API.Security.AddressObjects.Create(ctx, &opensase.CreateAddressObjectParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		Value:       d.Get("value").(string),
+		Members:     expandStringSet(d.Get("members").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating address object")
+	}
+
+	d.SetId(object.ID)
+	return resourceAddressObjectRead(ctx, d, m)
+}
+
+func resourceAddressObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	object, err := client.API.Security.AddressObjects.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading address object")
+	}
+
+	d.Set("name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("type", object.Type)
+	d.Set("value", object.Value)
+	d.Set("members", object.Members)
+	d.Set("reference_count", object.ReferenceCount)
+	return nil
+}
+
+func resourceAddressObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateAddressObjectParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("value") {
+		params.Value = opensase.String(d.Get("value").(string))
+	}
+	if d.HasChange("members") {
+		members := expandStringSet(d.Get("members").(*schema.Set))
+		params.Members = &members
+	}
+
+	if _, err := client.API.Security.AddressObjects.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating address object")
+	}
+
+	return resourceAddressObjectRead(ctx, d, m)
+}
+
+func resourceAddressObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.AddressObjects.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting address object")
+	}
+
+	d.SetId("")
+	return nil
+}
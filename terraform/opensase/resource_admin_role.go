@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Admin Role Resource ============
+
+var permissionPattern = regexp.MustCompile(`^[a-z_]+:([a-z_]+|\*)$`)
+
+func resourceAdminRole() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Custom tenant administrator role. Assign it with opensase_role_assignment.",
+		CreateContext: resourceAdminRoleCreate,
+		ReadContext:   resourceAdminRoleRead,
+		UpdateContext: resourceAdminRoleUpdate,
+		DeleteContext: resourceAdminRoleDelete,
+		CustomizeDiff: validateAdminRole,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(permissionPattern, "must be module:action, e.g. network:write or security:*"),
+				},
+				Description: "Permissions as module:action, e.g. network:read, security:write, identity:*",
+			},
+			"modules": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Console modules the role may open. Empty allows all modules.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restrict the role to read permissions",
+			},
+			"built_in": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateAdminRole(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("permissions") || !d.NewValueKnown("modules") {
+		return nil
+	}
+
+	modules := map[string]bool{}
+	for _, mod := range expandStringSet(d.Get("modules").(*schema.Set)) {
+		modules[mod] = true
+	}
+	readOnly := d.Get("read_only").(bool)
+
+	for _, perm := range expandStringSet(d.Get("permissions").(*schema.Set)) {
+		module, action, _ := strings.Cut(perm, ":")
+		if len(modules) > 0 && !modules[module] {
+			return fmt.Errorf("permissions: %q is for module %q, which is not in modules", perm, module)
+		}
+		if readOnly && action != "read" {
+			return fmt.Errorf("permissions: %q is not a read permission and read_only is set", perm)
+		}
+	}
+	return nil
+}
+
+func resourceAdminRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	role, err := client.API.Identity.Roles.Create(ctx, &opensase.CreateRoleParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Modules:     expandStringSet(d.Get("modules").(*schema.Set)),
+		ReadOnly:    d.Get("read_only").(bool),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating admin role")
+	}
+
+	d.SetId(role.ID)
+	return resourceAdminRoleRead(ctx, d, m)
+}
+
+func resourceAdminRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	role, err := client.API.Identity.Roles.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading admin role")
+	}
+
+	d.Set("name", role.Name)
+	d.Set("description", role.Description)
+	d.Set("permissions", role.Permissions)
+	d.Set("modules", role.Modules)
+	d.Set("read_only", role.ReadOnly)
+	d.Set("built_in", role.BuiltIn)
+	return nil
+}
+
+func resourceAdminRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.Get("built_in").(bool) {
+		return diag.Errorf("Built-in role %s cannot be modified", d.Id())
+	}
+
+	params := &opensase.UpdateRoleParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("permissions") {
+		permissions := expandStringSet(d.Get("permissions").(*schema.Set))
+		params.Permissions = &permissions
+	}
+	if d.HasChange("modules") {
+		modules := expandStringSet(d.Get("modules").(*schema.Set))
+		params.Modules = &modules
+	}
+	if d.HasChange("read_only") {
+		params.ReadOnly = opensase.Bool(d.Get("read_only").(bool))
+	}
+
+	if _, err := client.API.Identity.Roles.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating admin role")
+	}
+
+	return resourceAdminRoleRead(ctx, d, m)
+}
+
+func resourceAdminRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.Get("built_in").(bool) {
+		d.SetId("")
+		return nil
+	}
+
+	if err := client.API.Identity.Roles.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting admin role")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Alert Rule Resource ============
+
+func resourceAlertRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Metric or event alert rule delivering to opensase_notification_channel resources",
+		CreateContext: resourceAlertRuleCreate,
+		ReadContext:   resourceAlertRuleRead,
+		UpdateContext: resourceAlertRuleUpdate,
+		DeleteContext: resourceAlertRuleDelete,
+		CustomizeDiff: validateAlertRule,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{opensase.AlertRuleMetric, opensase.AlertRuleEvent}, false),
+			},
+			"metric": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Metric to evaluate, e.g. tunnel.packet_loss. Required for metric rules.",
+			},
+			"event_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Event type to count, e.g. network.tunnel.down. Required for event rules.",
+			},
+			"filters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Narrows the metric or events by label, e.g. site_id",
+			},
+			"condition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "avg",
+							ValidateFunc: validation.StringInSlice([]string{"avg", "min", "max", "sum", "count", "p95"}, false),
+							Description:  "Event rules must use count",
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								opensase.AlertOpGreaterThan, opensase.AlertOpGreaterThanOrEqual,
+								opensase.AlertOpLessThan, opensase.AlertOpLessThanOrEqual, opensase.AlertOpEqual,
+							}, false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"duration_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+							Description:  "How long the condition must hold, or the counting window of event rules",
+						},
+					},
+				},
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.AlertSeverityCritical, opensase.AlertSeverityWarning, opensase.AlertSeverityInfo,
+				}, false),
+			},
+			"channel_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notify_on_resolve": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"repeat_interval_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Re-notify while the alert stays open. 0 notifies once.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateAlertRule checks the fields each rule type requires
+func validateAlertRule(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	switch d.Get("type").(string) {
+	case opensase.AlertRuleMetric:
+		if d.NewValueKnown("metric") && d.Get("metric").(string) == "" {
+			return fmt.Errorf("metric: required for metric rules")
+		}
+		if d.Get("event_type").(string) != "" {
+			return fmt.Errorf("event_type: only allowed for event rules")
+		}
+	case opensase.AlertRuleEvent:
+		if d.NewValueKnown("event_type") && d.Get("event_type").(string) == "" {
+			return fmt.Errorf("event_type: required for event rules")
+		}
+		if d.Get("metric").(string) != "" {
+			return fmt.Errorf("metric: only allowed for metric rules")
+		}
+		if agg := d.Get("condition.0.aggregation").(string); agg != "count" {
+			return fmt.Errorf("condition.0.aggregation: event rules must use count, got %q", agg)
+		}
+	}
+	return nil
+}
+
+func resourceAlertRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	rule, err := client.API.Monitoring.Alerts.CreateRule(ctx, &opensase.CreateAlertRuleParams{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Type:                  d.Get("type").(string),
+		Metric:                d.Get("metric").(string),
+		EventType:             d.Get("event_type").(string),
+		Filters:               expandStringMap(d.Get("filters").(map[string]interface{})),
+		Condition:             expandAlertCondition(d.Get("condition").([]interface{})),
+		Severity:              d.Get("severity").(string),
+		ChannelIDs:            expandStringSet(d.Get("channel_ids").(*schema.Set)),
+		NotifyOnResolve:       d.Get("notify_on_resolve").(bool),
+		RepeatIntervalMinutes: d.Get("repeat_interval_minutes").(int),
+		Enabled:               opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating alert rule")
+	}
+
+	d.SetId(rule.ID)
+	return resourceAlertRuleRead(ctx, d, m)
+}
+
+func resourceAlertRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	rule, err := client.API.Monitoring.Alerts.GetRule(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading alert rule")
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("description", rule.Description)
+	d.Set("type", rule.Type)
+	d.Set("metric", rule.Metric)
+	d.Set("event_type", rule.EventType)
+	d.Set("filters", rule.Filters)
+	d.Set("condition", []interface{}{map[string]interface{}{
+		"aggregation":      rule.Condition.Aggregation,
+		"operator":         rule.Condition.Operator,
+		"threshold":        rule.Condition.Threshold,
+		"duration_seconds": rule.Condition.DurationSeconds,
+	}})
+	d.Set("severity", rule.Severity)
+	d.Set("channel_ids", rule.ChannelIDs)
+	d.Set("notify_on_resolve", rule.NotifyOnResolve)
+	d.Set("repeat_interval_minutes", rule.RepeatIntervalMinutes)
+	d.Set("enabled", rule.Enabled)
+	d.Set("state", rule.State)
+	return nil
+}
+
+func resourceAlertRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateAlertRuleParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("filters") {
+		filters := expandStringMap(d.Get("filters").(map[string]interface{}))
+		params.Filters = &filters
+	}
+	if d.HasChange("condition") {
+		condition := expandAlertCondition(d.Get("condition").([]interface{}))
+		params.Condition = &condition
+	}
+	if d.HasChange("severity") {
+		params.Severity = opensase.String(d.Get("severity").(string))
+	}
+	if d.HasChange("channel_ids") {
+		channelIDs := expandStringSet(d.Get("channel_ids").(*schema.Set))
+		params.ChannelIDs = &channelIDs
+	}
+	if d.HasChange("notify_on_resolve") {
+		params.NotifyOnResolve = opensase.Bool(d.Get("notify_on_resolve").(bool))
+	}
+	if d.HasChange("repeat_interval_minutes") {
+		params.RepeatIntervalMinutes = opensase.Int(d.Get("repeat_interval_minutes").(int))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Monitoring.Alerts.UpdateRule(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating alert rule")
+	}
+
+	return resourceAlertRuleRead(ctx, d, m)
+}
+
+func resourceAlertRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Monitoring.Alerts.DeleteRule(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting alert rule")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandAlertCondition(raw []interface{}) opensase.AlertCondition {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.AlertCondition{}
+	}
+	c := raw[0].(map[string]interface{})
+	return opensase.AlertCondition{
+		Aggregation:     c["aggregation"].(string),
+		Operator:        c["operator"].(string),
+		Threshold:       c["threshold"].(float64),
+		DurationSeconds: c["duration_seconds"].(int),
+	}
+}
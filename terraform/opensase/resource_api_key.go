@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ API Key Resource ============
+
+func resourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Scoped API key. The secret is only known to Terraform when the key is created or rotated.",
+		CreateContext: resourceAPIKeyCreate,
+		ReadContext:   resourceAPIKeyRead,
+		UpdateContext: resourceAPIKeyUpdate,
+		DeleteContext: resourceAPIKeyDelete,
+		CustomizeDiff: validateAPIKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(permissionPattern, "must be module:action, e.g. network:write or security:*"),
+				},
+				Description: "Permissions as module:action, e.g. network:read, security:write, identity:*",
+			},
+			"allowed_ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Source addresses or CIDR ranges the key may be used from. Empty allows any source.",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "RFC 3339 expiry time. Omit for a key that does not expire. Removing it replaces the key.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"rotate_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value; changing it rotates the secret, e.g. the id of a time_rotating resource",
+			},
+			"rotation_grace_period_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "How long the previous secret stays valid after a rotation",
+				ValidateFunc: validation.IntBetween(0, 7*24*3600),
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Non-secret leading part of the key, shown in the console",
+			},
+			"rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateAPIKey(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("allowed_ips") {
+		for _, ip := range expandStringSet(d.Get("allowed_ips").(*schema.Set)) {
+			if net.ParseIP(ip) != nil {
+				continue
+			}
+			if _, _, err := net.ParseCIDR(ip); err != nil {
+				return fmt.Errorf("allowed_ips: %q is not an IP address or CIDR range", ip)
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	if o, n := d.GetChange("expires_at"); o.(string) != "" && n.(string) == "" {
+		if err := d.ForceNew("expires_at"); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("rotate_trigger") {
+		if err := d.SetNewComputed("secret"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("rotated_at")
+	}
+	return nil
+}
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.CreateAPIKeyParams{
+		Name:        d.Get("name").(string),
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		AllowedIPs:  expandStringSet(d.Get("allowed_ips").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, _ := time.Parse(time.RFC3339, v.(string))
+		params.ExpiresAt = &expiresAt
+	}
+
+	key, err := client.API.Identity.APIKeys.Create(ctx, params)
+	if err != nil {
+		return apiDiagnostics(err, "Error creating API key")
+	}
+
+	d.SetId(key.ID)
+	d.Set("secret", key.Secret)
+	return resourceAPIKeyRead(ctx, d, m)
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	key, err := client.API.Identity.APIKeys.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading API key")
+	}
+
+	d.Set("name", key.Name)
+	d.Set("permissions", key.Permissions)
+	d.Set("allowed_ips", key.AllowedIPs)
+	if key.ExpiresAt != nil {
+		d.Set("expires_at", key.ExpiresAt.Format(time.RFC3339))
+	} else {
+		d.Set("expires_at", "")
+	}
+	d.Set("prefix", key.Prefix)
+	if key.RotatedAt != nil {
+		d.Set("rotated_at", key.RotatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateAPIKeyParams{}
+	changed := false
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+		changed = true
+	}
+	if d.HasChange("permissions") {
+		permissions := expandStringSet(d.Get("permissions").(*schema.Set))
+		params.Permissions = &permissions
+		changed = true
+	}
+	if d.HasChange("allowed_ips") {
+		allowedIPs := expandStringSet(d.Get("allowed_ips").(*schema.Set))
+		params.AllowedIPs = &allowedIPs
+		changed = true
+	}
+	if v, ok := d.GetOk("expires_at"); ok && d.HasChange("expires_at") {
+		expiresAt, _ := time.Parse(time.RFC3339, v.(string))
+		params.ExpiresAt = &expiresAt
+		changed = true
+	}
+
+	// On failure keep the prior state, so a rotation is retried and the
+	// current secret, which the plan marked as unknown, is not lost
+	if changed {
+		if _, err := client.API.Identity.APIKeys.Update(ctx, d.Id(), params); err != nil {
+			d.Partial(true)
+			return apiDiagnostics(err, "Error updating API key")
+		}
+	}
+
+	if d.HasChange("rotate_trigger") {
+		key, err := client.API.Identity.APIKeys.Rotate(ctx, d.Id(), &opensase.RotateAPIKeyParams{
+			GracePeriodSeconds: d.Get("rotation_grace_period_seconds").(int),
+		})
+		if err != nil {
+			d.Partial(true)
+			return apiDiagnostics(err, "Error rotating API key")
+		}
+		d.Set("secret", key.Secret)
+	}
+
+	return resourceAPIKeyRead(ctx, d, m)
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.APIKeys.Revoke(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error revoking API key")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// suppressEquivalentTime ignores differences between RFC 3339 times that
+// denote the same instant, e.g. in different time zones
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	o, err1 := time.Parse(time.RFC3339, old)
+	n, err2 := time.Parse(time.RFC3339, new)
+	return err1 == nil && err2 == nil && o.Equal(n)
+}
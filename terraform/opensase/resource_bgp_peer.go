@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ BGP Peer Resource ============
+
+func resourceBGPPeer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "BGP session between a site and a neighbor",
+		CreateContext: resourceBGPPeerCreate,
+		ReadContext:   resourceBGPPeerRead,
+		UpdateContext: resourceBGPPeerUpdate,
+		DeleteContext: resourceBGPPeerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("BGP peer"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"peer_asn": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"peer_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"local_asn": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Local ASN for this session; defaults to the site's ASN",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"auth_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "TCP MD5 password. Write-only: changes made outside Terraform are not detected.",
+			},
+			"route_map_in": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Route map applied to routes received from the peer",
+			},
+			"route_map_out": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Route map applied to routes advertised to the peer",
+			},
+			"keepalive_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"hold_time_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      180,
+				ValidateFunc: validation.IntAtLeast(3),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auth_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBGPPeerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	peer, err := client.API.Network.BGP.Create(ctx, siteID, &opensase.CreateBGPPeerParams{
+		Name:             d.Get("name").(string),
+		PeerASN:          int64(d.Get("peer_asn").(int)),
+		PeerAddress:      d.Get("peer_address").(string),
+		LocalASN:         int64(d.Get("local_asn").(int)),
+		AuthPassword:     d.Get("auth_password").(string),
+		RouteMapIn:       d.Get("route_map_in").(string),
+		RouteMapOut:      d.Get("route_map_out").(string),
+		KeepaliveSeconds: d.Get("keepalive_seconds").(int),
+		HoldTimeSeconds:  d.Get("hold_time_seconds").(int),
+		Enabled:          opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating BGP peer")
+	}
+
+	d.SetId(siteID + "/" + peer.ID)
+	return resourceBGPPeerRead(ctx, d, m)
+}
+
+func resourceBGPPeerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, peerID, err := parseSiteScopedID(d.Id(), "BGP peer")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	peer, err := client.API.Network.BGP.Get(ctx, siteID, peerID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading BGP peer")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("name", peer.Name)
+	d.Set("peer_asn", int(peer.PeerASN))
+	d.Set("peer_address", peer.PeerAddress)
+	d.Set("local_asn", int(peer.LocalASN))
+	d.Set("route_map_in", peer.RouteMapIn)
+	d.Set("route_map_out", peer.RouteMapOut)
+	d.Set("keepalive_seconds", peer.KeepaliveSeconds)
+	d.Set("hold_time_seconds", peer.HoldTimeSeconds)
+	d.Set("enabled", peer.Enabled)
+	d.Set("auth_enabled", peer.AuthEnabled)
+	d.Set("state", peer.State)
+	return nil
+}
+
+func resourceBGPPeerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, peerID, err := parseSiteScopedID(d.Id(), "BGP peer")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateBGPPeerParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("peer_asn") {
+		asn := int64(d.Get("peer_asn").(int))
+		params.PeerASN = &asn
+	}
+	if d.HasChange("peer_address") {
+		params.PeerAddress = opensase.String(d.Get("peer_address").(string))
+	}
+	if d.HasChange("local_asn") {
+		asn := int64(d.Get("local_asn").(int))
+		params.LocalASN = &asn
+	}
+	if d.HasChange("auth_password") {
+		params.AuthPassword = opensase.String(d.Get("auth_password").(string))
+	}
+	if d.HasChange("route_map_in") {
+		params.RouteMapIn = opensase.String(d.Get("route_map_in").(string))
+	}
+	if d.HasChange("route_map_out") {
+		params.RouteMapOut = opensase.String(d.Get("route_map_out").(string))
+	}
+	if d.HasChange("keepalive_seconds") {
+		params.KeepaliveSeconds = opensase.Int(d.Get("keepalive_seconds").(int))
+	}
+	if d.HasChange("hold_time_seconds") {
+		params.HoldTimeSeconds = opensase.Int(d.Get("hold_time_seconds").(int))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Network.BGP.Update(ctx, siteID, peerID, params); err != nil {
+		return apiDiagnostics(err, "Error updating BGP peer")
+	}
+
+	return resourceBGPPeerRead(ctx, d, m)
+}
+
+func resourceBGPPeerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, peerID, err := parseSiteScopedID(d.Id(), "BGP peer")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.BGP.Delete(ctx, siteID, peerID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting BGP peer")
+	}
+
+	d.SetId("")
+	return nil
+}
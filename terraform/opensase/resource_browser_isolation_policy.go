@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Browser Isolation Policy Resource ============
+
+func resourceBrowserIsolationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Remote browser isolation policy: matching web traffic is opened in a remote browser " +
+			"and only a rendering of the page reaches the user's device.",
+		CreateContext: resourceBrowserIsolationPolicyCreate,
+		ReadContext:   resourceBrowserIsolationPolicyRead,
+		UpdateContext: resourceBrowserIsolationPolicyUpdate,
+		DeleteContext: resourceBrowserIsolationPolicyDelete,
+		CustomizeDiff: validateBrowserIsolationPolicy,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Evaluation order; lower values are evaluated first and the first match applies",
+			},
+			"url_categories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "URL category IDs from the catalog whose sites are isolated",
+			},
+			"domains": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Domains isolated regardless of category, including their subdomains",
+			},
+			"user_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Groups whose users the policy applies to. Empty applies it to every user.",
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  opensase.IsolationModeFull,
+				Description: "full keeps pages interactive; read_only blocks keyboard input, forms and uploads; " +
+					"no_upload blocks file uploads only",
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.IsolationModeFull, opensase.IsolationModeReadOnly, opensase.IsolationModeNoUpload,
+				}, false),
+			},
+			"clipboard": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     opensase.IsolationClipboardDeny,
+				Description: "copy_only allows copying out of isolated pages, paste_only pasting into them",
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.IsolationClipboardAllow, opensase.IsolationClipboardDeny,
+					opensase.IsolationClipboardCopyOnly, opensase.IsolationClipboardPasteOnly,
+				}, false),
+			},
+			"allow_printing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_downloads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Let files downloaded in isolated pages reach the user's device",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func validateBrowserIsolationPolicy(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("url_categories") && d.NewValueKnown("domains") &&
+		d.Get("url_categories").(*schema.Set).Len() == 0 && d.Get("domains").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("at least one of url_categories or domains is required")
+	}
+	if d.Get("mode").(string) == opensase.IsolationModeReadOnly {
+		switch clipboard := d.Get("clipboard").(string); clipboard {
+		case opensase.IsolationClipboardAllow, opensase.IsolationClipboardPasteOnly:
+			return fmt.Errorf("clipboard: %s is not allowed with mode read_only, which blocks input", clipboard)
+		}
+	}
+	return nil
+}
+
+func expandIsolationControls(d *schema.ResourceData) *opensase.IsolationControls {
+	return &opensase.IsolationControls{
+		Clipboard: d.Get("clipboard").(string),
+		Printing:  d.Get("allow_printing").(bool),
+		Downloads: d.Get("allow_downloads").(bool),
+	}
+}
+
+func resourceBrowserIsolationPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.BrowserIsolation.Create(ctx, &opensase.CreateBrowserIsolationPolicyParams{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Enabled:       opensase.Bool(d.Get("enabled").(bool)),
+		Priority:      d.Get("priority").(int),
+		URLCategories: expandStringSet(d.Get("url_categories").(*schema.Set)),
+		Domains:       expandStringSet(d.Get("domains").(*schema.Set)),
+		UserGroups:    expandStringSet(d.Get("user_groups").(*schema.Set)),
+		Mode:          d.Get("mode").(string),
+		Controls:      expandIsolationControls(d),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating browser isolation policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourceBrowserIsolationPolicyRead(ctx, d, m)
+}
+
+func resourceBrowserIsolationPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.BrowserIsolation.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading browser isolation policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.Enabled)
+	d.Set("priority", policy.Priority)
+	d.Set("url_categories", policy.URLCategories)
+	d.Set("domains", policy.Domains)
+	d.Set("user_groups", policy.UserGroups)
+	d.Set("mode", policy.Mode)
+	d.Set("clipboard", policy.Controls.Clipboard)
+	d.Set("allow_printing", policy.Controls.Printing)
+	d.Set("allow_downloads", policy.Controls.Downloads)
+	return nil
+}
+
+func resourceBrowserIsolationPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateBrowserIsolationPolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("url_categories") {
+		categories := expandStringSet(d.Get("url_categories").(*schema.Set))
+		params.URLCategories = &categories
+	}
+	if d.HasChange("domains") {
+		domains := expandStringSet(d.Get("domains").(*schema.Set))
+		params.Domains = &domains
+	}
+	if d.HasChange("user_groups") {
+		groups := expandStringSet(d.Get("user_groups").(*schema.Set))
+		params.UserGroups = &groups
+	}
+	if d.HasChange("mode") {
+		params.Mode = opensase.String(d.Get("mode").(string))
+	}
+	if d.HasChanges("clipboard", "allow_printing", "allow_downloads") {
+		params.Controls = expandIsolationControls(d)
+	}
+
+	if _, err := client.API.Security.BrowserIsolation.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating browser isolation policy")
+	}
+
+	return resourceBrowserIsolationPolicyRead(ctx, d, m)
+}
+
+func resourceBrowserIsolationPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.BrowserIsolation.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting browser isolation policy")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ============ BYOK Key Resource ============
+
+func resourceBYOKKey() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Customer-managed encryption key and the data classes it encrypts",
+		CreateContext: resourceBYOKKeyCreate,
+		ReadContext:   resourceBYOKKeyRead,
+		UpdateContext: resourceBYOKKeyUpdate,
+		DeleteContext: resourceBYOKKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "External KMS provider, e.g. aws_kms, azure_key_vault, gcp_kms",
+			},
+			"key_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Role assumed by the platform to use the key",
+			},
+			"data_classes": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Data classes encrypted under this key",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBYOKKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	key, err := client.API.Security.KMS.Register(ctx, &opensase.RegisterKeyParams{
+		Name:        d.Get("name").(string),
+		Provider:    d.Get("provider_type").(string),
+		KeyURI:      d.Get("key_uri").(string),
+		Region:      d.Get("region").(string),
+		RoleARN:     d.Get("role_arn").(string),
+		DataClasses: expandStringSet(d.Get("data_classes").(*schema.Set)),
+	}, nil)
+	if err != nil {
+		return apiDiagnostics(err, "Error registering key")
+	}
+
+	d.SetId(key.ID)
+	return resourceBYOKKeyRead(ctx, d, m)
+}
+
+func resourceBYOKKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	key, err := client.API.Security.KMS.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading key")
+	}
+	if key.Status == opensase.KeyStatusDisabled {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", key.Name)
+	d.Set("provider_type", key.Provider)
+	d.Set("key_uri", key.KeyURI)
+	d.Set("region", key.Region)
+	d.Set("data_classes", key.DataClasses)
+	d.Set("status", string(key.Status))
+	d.Set("version", key.Version)
+	return nil
+}
+
+func resourceBYOKKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChange("data_classes") {
+		classes := expandStringSet(d.Get("data_classes").(*schema.Set))
+		if _, err := client.API.Security.KMS.UpdateDataClasses(ctx, d.Id(), classes); err != nil {
+			return apiDiagnostics(err, "Error updating key data classes")
+		}
+	}
+
+	return resourceBYOKKeyRead(ctx, d, m)
+}
+
+func resourceBYOKKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if _, err := client.API.Security.KMS.Disable(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error disabling key")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandStringSet(s *schema.Set) []string {
+	out := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, v.(string))
+	}
+	return out
+}
+
+func expandStringList(raw []interface{}) []string {
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		out = append(out, v.(string))
+	}
+	return out
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ CASB Policy Resource ============
+
+var casbActivities = []string{
+	opensase.CASBActivityUpload,
+	opensase.CASBActivityDownload,
+	opensase.CASBActivityShare,
+	opensase.CASBActivityDelete,
+	opensase.CASBActivityLogin,
+}
+
+func resourceCASBPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "CASB policy with sanctioned apps, tenant restrictions, activity controls and shadow IT discovery",
+		CreateContext: resourceCASBPolicyCreate,
+		ReadContext:   resourceCASBPolicyRead,
+		UpdateContext: resourceCASBPolicyUpdate,
+		DeleteContext: resourceCASBPolicyDelete,
+		CustomizeDiff: validateCASBPolicy,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sanctioned_apps": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Application IDs from the catalog that are approved for use",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"unsanctioned_apps": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Application IDs from the catalog that are blocked",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenant_restriction": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"allowed_tenants": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Description: "Tenant IDs or domains users may sign in to",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"activity_control": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"activity": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(casbActivities, false),
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"allow", "alert", "block"}, false),
+						},
+						"user_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"shadow_it": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"min_users": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Report an app once this many users have used it",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_upload_mb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Report an app once this much data has been uploaded to it",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"risk_score_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Report apps with a risk score at or above this value (0-100)",
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"block_risky": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Block discovered apps at or above risk_score_threshold",
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// validateCASBPolicy rejects apps listed as both sanctioned and unsanctioned
+func validateCASBPolicy(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	sanctioned := d.Get("sanctioned_apps").(*schema.Set)
+	for _, app := range d.Get("unsanctioned_apps").(*schema.Set).List() {
+		if sanctioned.Contains(app) {
+			return fmt.Errorf("app %q is both sanctioned and unsanctioned", app)
+		}
+	}
+	return nil
+}
+
+func expandCASBTenantRestrictions(s *schema.Set) []opensase.CASBTenantRestriction {
+	restrictions := make([]opensase.CASBTenantRestriction, 0, s.Len())
+	for _, r := range s.List() {
+		t := r.(map[string]interface{})
+		restrictions = append(restrictions, opensase.CASBTenantRestriction{
+			App:            t["app"].(string),
+			AllowedTenants: expandStringSet(t["allowed_tenants"].(*schema.Set)),
+		})
+	}
+	return restrictions
+}
+
+func flattenCASBTenantRestrictions(restrictions []opensase.CASBTenantRestriction) []interface{} {
+	out := make([]interface{}, 0, len(restrictions))
+	for _, t := range restrictions {
+		out = append(out, map[string]interface{}{
+			"app":             t.App,
+			"allowed_tenants": t.AllowedTenants,
+		})
+	}
+	return out
+}
+
+func expandCASBActivityControls(s *schema.Set) []opensase.CASBActivityControl {
+	controls := make([]opensase.CASBActivityControl, 0, s.Len())
+	for _, r := range s.List() {
+		c := r.(map[string]interface{})
+		controls = append(controls, opensase.CASBActivityControl{
+			App:        c["app"].(string),
+			Activity:   c["activity"].(string),
+			Action:     c["action"].(string),
+			UserGroups: expandStringSet(c["user_groups"].(*schema.Set)),
+		})
+	}
+	return controls
+}
+
+func flattenCASBActivityControls(controls []opensase.CASBActivityControl) []interface{} {
+	out := make([]interface{}, 0, len(controls))
+	for _, c := range controls {
+		out = append(out, map[string]interface{}{
+			"app":         c.App,
+			"activity":    c.Activity,
+			"action":      c.Action,
+			"user_groups": c.UserGroups,
+		})
+	}
+	return out
+}
+
+func expandCASBShadowIT(v []interface{}) *opensase.CASBShadowITSettings {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	s := v[0].(map[string]interface{})
+	return &opensase.CASBShadowITSettings{
+		Enabled:            s["enabled"].(bool),
+		MinUsers:           s["min_users"].(int),
+		MinUploadMB:        s["min_upload_mb"].(int),
+		RiskScoreThreshold: s["risk_score_threshold"].(int),
+		BlockRisky:         s["block_risky"].(bool),
+	}
+}
+
+func flattenCASBShadowIT(s opensase.CASBShadowITSettings) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"enabled":              s.Enabled,
+		"min_users":            s.MinUsers,
+		"min_upload_mb":        s.MinUploadMB,
+		"risk_score_threshold": s.RiskScoreThreshold,
+		"block_risky":          s.BlockRisky,
+	}}
+}
+
+func resourceCASBPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.CASB.Create(ctx, &opensase.CreateCASBPolicyParams{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		SanctionedApps:     expandStringSet(d.Get("sanctioned_apps").(*schema.Set)),
+		UnsanctionedApps:   expandStringSet(d.Get("unsanctioned_apps").(*schema.Set)),
+		TenantRestrictions: expandCASBTenantRestrictions(d.Get("tenant_restriction").(*schema.Set)),
+		ActivityControls:   expandCASBActivityControls(d.Get("activity_control").(*schema.Set)),
+		ShadowIT:           expandCASBShadowIT(d.Get("shadow_it").([]interface{})),
+		Enabled:            opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating CASB policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourceCASBPolicyRead(ctx, d, m)
+}
+
+func resourceCASBPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.CASB.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading CASB policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("sanctioned_apps", policy.SanctionedApps)
+	d.Set("unsanctioned_apps", policy.UnsanctionedApps)
+	d.Set("tenant_restriction", flattenCASBTenantRestrictions(policy.TenantRestrictions))
+	d.Set("activity_control", flattenCASBActivityControls(policy.ActivityControls))
+	d.Set("shadow_it", flattenCASBShadowIT(policy.ShadowIT))
+	d.Set("enabled", policy.Enabled)
+	return nil
+}
+
+func resourceCASBPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateCASBPolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("sanctioned_apps") {
+		apps := expandStringSet(d.Get("sanctioned_apps").(*schema.Set))
+		params.SanctionedApps = &apps
+	}
+	if d.HasChange("unsanctioned_apps") {
+		apps := expandStringSet(d.Get("unsanctioned_apps").(*schema.Set))
+		params.UnsanctionedApps = &apps
+	}
+	if d.HasChange("tenant_restriction") {
+		restrictions := expandCASBTenantRestrictions(d.Get("tenant_restriction").(*schema.Set))
+		params.TenantRestrictions = &restrictions
+	}
+	if d.HasChange("activity_control") {
+		controls := expandCASBActivityControls(d.Get("activity_control").(*schema.Set))
+		params.ActivityControls = &controls
+	}
+	if d.HasChange("shadow_it") {
+		params.ShadowIT = expandCASBShadowIT(d.Get("shadow_it").([]interface{}))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Security.CASB.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating CASB policy")
+	}
+
+	return resourceCASBPolicyRead(ctx, d, m)
+}
+
+func resourceCASBPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.CASB.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting CASB policy")
+	}
+
+	d.SetId("")
+	return nil
+}
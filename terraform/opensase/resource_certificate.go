@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Certificate Resource ============
+
+func resourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		Description: "X.509 certificate for SSL inspection and IPsec tunnels, either uploaded " +
+			"with its private key or generated from a server-side CSR. Certificate material " +
+			"is immutable, so rotation replaces the resource; set create_before_destroy so " +
+			"dependent resources are repointed before the old certificate is deleted.",
+		CreateContext: resourceCertificateCreate,
+		ReadContext:   resourceCertificateRead,
+		UpdateContext: resourceCertificateUpdate,
+		DeleteContext: resourceCertificateDelete,
+		CustomizeDiff: validateCertificate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  opensase.CertificateTypeServer,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.CertificateTypeServer,
+					opensase.CertificateTypeCA,
+				}, false),
+			},
+			"certificate_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM chain, leaf first. With csr, set it to the CA-signed chain once issued.",
+				ValidateFunc: validateCertificatePEM,
+			},
+			"private_key_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				Description:  "Private key of an uploaded certificate. Write-only.",
+				ExactlyOneOf: []string{"private_key_pem", "csr"},
+				RequiredWith: []string{"certificate_pem"},
+			},
+			"passphrase": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				Description:  "Passphrase of an encrypted private_key_pem",
+				RequiredWith: []string{"private_key_pem"},
+			},
+			"csr": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Description:  "Generate the key pair on the platform and expose a CSR in csr_pem",
+				ExactlyOneOf: []string{"private_key_pem", "csr"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"common_name":         {Type: schema.TypeString, Required: true, ForceNew: true},
+						"organization":        {Type: schema.TypeString, Optional: true, ForceNew: true},
+						"organizational_unit": {Type: schema.TypeString, Optional: true, ForceNew: true},
+						"country":             {Type: schema.TypeString, Optional: true, ForceNew: true},
+						"state":               {Type: schema.TypeString, Optional: true, ForceNew: true},
+						"locality":            {Type: schema.TypeString, Optional: true, ForceNew: true},
+						"sans": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"key_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  opensase.KeyAlgorithmECDSAP256,
+							ValidateFunc: validation.StringInSlice([]string{
+								opensase.KeyAlgorithmRSA2048,
+								opensase.KeyAlgorithmRSA4096,
+								opensase.KeyAlgorithmECDSAP256,
+								opensase.KeyAlgorithmECDSAP384,
+							}, false),
+						},
+					},
+				},
+			},
+			"csr_pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_common_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time in RFC 3339 format; empty until the chain is supplied",
+			},
+			"days_until_expiry": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateCertificatePEM(v interface{}, k string) ([]string, []error) {
+	block, _ := pem.Decode([]byte(v.(string)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, []error{fmt.Errorf("%s: expected a PEM-encoded CERTIFICATE block", k)}
+	}
+	return nil, nil
+}
+
+// validateCertificate lets a CSR-generated certificate receive its signed
+// chain in place, but treats any later change to certificate_pem as a
+// rotation that replaces the certificate.
+func validateCertificate(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("certificate_pem") {
+		return nil
+	}
+	old, _ := d.GetChange("certificate_pem")
+	if old.(string) != "" {
+		return d.ForceNew("certificate_pem")
+	}
+	if len(d.Get("csr").([]interface{})) == 0 {
+		return fmt.Errorf("certificate_pem: uploaded certificates cannot be completed in place")
+	}
+	return nil
+}
+
+func expandCertificateCSR(d *schema.ResourceData) *opensase.CreateCSRParams {
+	c := d.Get("csr").([]interface{})[0].(map[string]interface{})
+	return &opensase.CreateCSRParams{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+		Subject: opensase.CertificateSubject{
+			CommonName:         c["common_name"].(string),
+			Organization:       c["organization"].(string),
+			OrganizationalUnit: c["organizational_unit"].(string),
+			Country:            c["country"].(string),
+			State:              c["state"].(string),
+			Locality:           c["locality"].(string),
+		},
+		SANs:         expandStringSet(c["sans"].(*schema.Set)),
+		KeyAlgorithm: c["key_algorithm"].(string),
+	}
+}
+
+func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if len(d.Get("csr").([]interface{})) == 0 {
+		cert, err := client.API.Security.Certificates.Upload(ctx, &opensase.UploadCertificateParams{
+			Name:           d.Get("name").(string),
+			Type:           d.Get("type").(string),
+			CertificatePEM: d.Get("certificate_pem").(string),
+			PrivateKeyPEM:  d.Get("private_key_pem").(string),
+			Passphrase:     d.Get("passphrase").(string),
+		})
+		if err != nil {
+			return apiDiagnostics(err, "Error uploading certificate")
+		}
+		d.SetId(cert.ID)
+		return resourceCertificateRead(ctx, d, m)
+	}
+
+	cert, err := client.API.Security.Certificates.CreateCSR(ctx, expandCertificateCSR(d))
+	if err != nil {
+		return apiDiagnostics(err, "Error creating certificate signing request")
+	}
+	d.SetId(cert.ID)
+
+	if chain := d.Get("certificate_pem").(string); chain != "" {
+		if _, err := client.API.Security.Certificates.Complete(ctx, cert.ID, chain); err != nil {
+			return apiDiagnostics(err, "Error completing certificate")
+		}
+	}
+
+	return resourceCertificateRead(ctx, d, m)
+}
+
+func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	cert, err := client.API.Security.Certificates.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading certificate")
+	}
+
+	// certificate_pem is kept as configured; fingerprint_sha256 surfaces
+	// changes made outside Terraform
+	d.Set("name", cert.Name)
+	d.Set("type", cert.Type)
+	d.Set("csr_pem", cert.CSRPEM)
+	d.Set("status", cert.Status)
+	d.Set("subject_common_name", cert.Subject.CommonName)
+	d.Set("issuer", cert.Issuer)
+	d.Set("serial_number", cert.SerialNumber)
+	d.Set("fingerprint_sha256", cert.FingerprintSHA256)
+
+	notBefore, notAfter, days := "", "", 0
+	if cert.NotBefore != nil {
+		notBefore = cert.NotBefore.Format(time.RFC3339)
+	}
+	if cert.NotAfter != nil {
+		notAfter = cert.NotAfter.Format(time.RFC3339)
+		days = int(time.Until(*cert.NotAfter).Hours() / 24)
+	}
+	d.Set("not_before", notBefore)
+	d.Set("not_after", notAfter)
+	d.Set("days_until_expiry", days)
+	return nil
+}
+
+func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChange("name") {
+		params := &opensase.UpdateCertificateParams{Name: opensase.String(d.Get("name").(string))}
+		if _, err := client.API.Security.Certificates.Update(ctx, d.Id(), params); err != nil {
+			return apiDiagnostics(err, "Error updating certificate")
+		}
+	}
+
+	// validateCertificate only allows an in-place change when a pending CSR
+	// receives its signed chain
+	if d.HasChange("certificate_pem") {
+		if _, err := client.API.Security.Certificates.Complete(ctx, d.Id(), d.Get("certificate_pem").(string)); err != nil {
+			return apiDiagnostics(err, "Error completing certificate")
+		}
+	}
+
+	return resourceCertificateRead(ctx, d, m)
+}
+
+func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.Certificates.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting certificate")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Client VPN Profile Resource ============
+
+func resourceClientVPNProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Remote-access VPN client profile. The rendered client configuration is exported as config.",
+		CreateContext: resourceClientVPNProfileCreate,
+		ReadContext:   resourceClientVPNProfileRead,
+		UpdateContext: resourceClientVPNProfileUpdate,
+		DeleteContext: resourceClientVPNProfileDelete,
+		CustomizeDiff: validateClientVPNProfile,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.VPNProtocolWireGuard, opensase.VPNProtocolIPsec, opensase.VPNProtocolSSL,
+				}, false),
+			},
+			"tunnel_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  opensase.VPNTunnelFull,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.VPNTunnelFull, opensase.VPNTunnelSplitInclude, opensase.VPNTunnelSplitExclude,
+				}, false),
+				Description: "full tunnels all traffic; split_include tunnels only split_tunnel_routes; split_exclude tunnels everything else",
+			},
+			"split_tunnel_routes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+				Description: "CIDRs included in or excluded from the tunnel. Required for the split tunnel modes.",
+			},
+			"dns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"servers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 4,
+							Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+						},
+						"search_domains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"device_posture": devicePostureSchema(),
+			"user_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Groups allowed to use the profile. Empty allows all users.",
+			},
+			"config_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"config_filename": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Client configuration to distribute, e.g. with local_sensitive_file",
+			},
+		},
+	}
+}
+
+func validateClientVPNProfile(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("split_tunnel_routes") {
+		return nil
+	}
+
+	routes := d.Get("split_tunnel_routes").(*schema.Set).Len()
+	switch mode := d.Get("tunnel_mode").(string); {
+	case mode == opensase.VPNTunnelFull && routes > 0:
+		return fmt.Errorf("split_tunnel_routes: not allowed with tunnel_mode %q", mode)
+	case mode != opensase.VPNTunnelFull && routes == 0:
+		return fmt.Errorf("split_tunnel_routes: required with tunnel_mode %q", mode)
+	}
+	return nil
+}
+
+func resourceClientVPNProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Network.ClientVPN.Create(ctx, &opensase.CreateClientVPNProfileParams{
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Protocol:          d.Get("protocol").(string),
+		TunnelMode:        d.Get("tunnel_mode").(string),
+		SplitTunnelRoutes: expandStringSet(d.Get("split_tunnel_routes").(*schema.Set)),
+		DNS:               expandClientVPNDNS(d.Get("dns").([]interface{})),
+		DevicePosture:     expandDevicePosture(d.Get("device_posture").([]interface{})),
+		UserGroups:        expandStringSet(d.Get("user_groups").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating client VPN profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceClientVPNProfileRead(ctx, d, m)
+}
+
+func resourceClientVPNProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Network.ClientVPN.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading client VPN profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("protocol", profile.Protocol)
+	d.Set("tunnel_mode", profile.TunnelMode)
+	d.Set("split_tunnel_routes", profile.SplitTunnelRoutes)
+	d.Set("dns", flattenClientVPNDNS(profile.DNS))
+	d.Set("device_posture", flattenDevicePosture(profile.DevicePosture))
+	d.Set("user_groups", profile.UserGroups)
+
+	// The config only changes with its version, so skip the download otherwise
+	if d.Get("config").(string) == "" || d.Get("config_version").(int) != profile.ConfigVersion {
+		config, err := client.API.Network.ClientVPN.DownloadConfig(ctx, d.Id())
+		if err != nil {
+			return apiDiagnostics(err, "Error downloading client VPN config")
+		}
+		d.Set("config", string(config.Content))
+		d.Set("config_filename", config.Filename)
+		d.Set("config_version", config.ConfigVersion)
+	}
+	return nil
+}
+
+func resourceClientVPNProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateClientVPNProfileParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("protocol") {
+		params.Protocol = opensase.String(d.Get("protocol").(string))
+	}
+	if d.HasChange("tunnel_mode") {
+		params.TunnelMode = opensase.String(d.Get("tunnel_mode").(string))
+	}
+	if d.HasChange("split_tunnel_routes") {
+		routes := expandStringSet(d.Get("split_tunnel_routes").(*schema.Set))
+		params.SplitTunnelRoutes = &routes
+	}
+	if d.HasChange("dns") {
+		params.DNS = expandClientVPNDNS(d.Get("dns").([]interface{}))
+		if params.DNS == nil {
+			params.DNS = &opensase.ClientVPNDNS{}
+		}
+	}
+	if d.HasChange("device_posture") {
+		params.DevicePosture = expandDevicePosture(d.Get("device_posture").([]interface{}))
+		if params.DevicePosture == nil {
+			params.DevicePosture = &opensase.ZTNADevicePosture{}
+		}
+	}
+	if d.HasChange("user_groups") {
+		groups := expandStringSet(d.Get("user_groups").(*schema.Set))
+		params.UserGroups = &groups
+	}
+
+	if _, err := client.API.Network.ClientVPN.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating client VPN profile")
+	}
+
+	return resourceClientVPNProfileRead(ctx, d, m)
+}
+
+func resourceClientVPNProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.ClientVPN.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting client VPN profile")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandClientVPNDNS(raw []interface{}) *opensase.ClientVPNDNS {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	dns := raw[0].(map[string]interface{})
+	return &opensase.ClientVPNDNS{
+		Servers:       expandStringList(dns["servers"].([]interface{})),
+		SearchDomains: expandStringList(dns["search_domains"].([]interface{})),
+	}
+}
+
+func flattenClientVPNDNS(dns *opensase.ClientVPNDNS) []interface{} {
+	if dns == nil || (len(dns.Servers) == 0 && len(dns.SearchDomains) == 0) {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"servers":        dns.Servers,
+		"search_domains": dns.SearchDomains,
+	}}
+}
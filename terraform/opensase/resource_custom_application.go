@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Custom Application Resource ============
+
+func resourceCustomApplication() *schema.Resource {
+	return &schema.Resource{
+		Description: "In-house application matched by domains, ports and IP ranges. Custom " +
+			"applications join the application catalog, so policies and opensase_app rules " +
+			"reference them like built-in applications.",
+		CreateContext: resourceCustomApplicationCreate,
+		ReadContext:   resourceCustomApplicationRead,
+		UpdateContext: resourceCustomApplicationUpdate,
+		DeleteContext: resourceCustomApplicationDelete,
+		CustomizeDiff: validateCustomApplication,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Category from the opensase_app_catalog data source",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"risk_level": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "1 (minimal) to 5 (critical)",
+				ValidateFunc: validation.IntBetween(opensase.AppRiskMinimal, opensase.AppRiskCritical),
+			},
+			"match": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Traffic matching any domain, port or IP range is classified as the application",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domains": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Matched against TLS SNI, HTTP Host and DNS queries, optionally with a leading *. wildcard",
+						},
+						"port": appPortSchema(),
+						"ip_ranges": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateCustomApplication(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	match := expandCustomAppMatch(d.Get("match").([]interface{}))
+	if len(match.Domains) == 0 && len(match.Ports) == 0 && len(match.IPRanges) == 0 {
+		return fmt.Errorf("match: at least one domain, port or ip_ranges entry is required")
+	}
+	if err := validateAppDomains(match.Domains); err != nil {
+		return fmt.Errorf("match: %w", err)
+	}
+	if err := validateAppPorts(match.Ports); err != nil {
+		return fmt.Errorf("match: %w", err)
+	}
+
+	return validateAppCategory(ctx, d, m.(*Client))
+}
+
+func resourceCustomApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	app, err := client.API.Security.CustomApps.Create(ctx, &opensase.CreateCustomAppParams{
+		Name:        d.Get("name").(string),
+		Category:    d.Get("category").(string),
+		Description: d.Get("description").(string),
+		RiskLevel:   d.Get("risk_level").(int),
+		Match:       expandCustomAppMatch(d.Get("match").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating custom application")
+	}
+
+	d.SetId(app.ID)
+	return resourceCustomApplicationRead(ctx, d, m)
+}
+
+func resourceCustomApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	app, err := client.API.Security.CustomApps.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading custom application")
+	}
+
+	d.Set("name", app.Name)
+	d.Set("category", app.Category)
+	d.Set("description", app.Description)
+	d.Set("risk_level", app.RiskLevel)
+	d.Set("match", []interface{}{map[string]interface{}{
+		"domains":   app.Match.Domains,
+		"port":      flattenAppPorts(app.Match.Ports),
+		"ip_ranges": app.Match.IPRanges,
+	}})
+	return nil
+}
+
+func resourceCustomApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateCustomAppParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("category") {
+		params.Category = opensase.String(d.Get("category").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("risk_level") {
+		params.RiskLevel = opensase.Int(d.Get("risk_level").(int))
+	}
+	if d.HasChange("match") {
+		match := expandCustomAppMatch(d.Get("match").([]interface{}))
+		params.Match = &match
+	}
+
+	if _, err := client.API.Security.CustomApps.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating custom application")
+	}
+
+	return resourceCustomApplicationRead(ctx, d, m)
+}
+
+func resourceCustomApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.CustomApps.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting custom application")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandCustomAppMatch(raw []interface{}) opensase.CustomAppMatch {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.CustomAppMatch{}
+	}
+	m := raw[0].(map[string]interface{})
+
+	return opensase.CustomAppMatch{
+		Domains:  expandStringSet(m["domains"].(*schema.Set)),
+		Ports:    expandAppPorts(m["port"].([]interface{})),
+		IPRanges: expandStringSet(m["ip_ranges"].(*schema.Set)),
+	}
+}
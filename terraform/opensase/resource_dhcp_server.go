@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ DHCP Server Resource ============
+
+// DHCP options that have their own arguments and may not be set as custom
+// options: subnet mask, router, DNS servers, domain name and lease time
+var dhcpManagedOptions = map[int]string{1: "cidr", 3: "gateway_ip", 6: "dns_servers", 15: "domain_name", 51: "lease_seconds"}
+
+func resourceDHCPServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "DHCP server for one VLAN of a site, run by the site's edge device",
+		CreateContext: resourceDHCPServerCreate,
+		ReadContext:   resourceDHCPServerRead,
+		UpdateContext: resourceDHCPServerUpdate,
+		DeleteContext: resourceDHCPServerDelete,
+		CustomizeDiff: validateDHCPServer,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("DHCP server"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vlan": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Tag of the site VLAN served, e.g. opensase_vlan.users.vlan_id",
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"range": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Inclusive address ranges leased to hosts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {Type: schema.TypeString, Required: true, ValidateFunc: validation.IsIPv4Address},
+						"end":   {Type: schema.TypeString, Required: true, ValidateFunc: validation.IsIPv4Address},
+					},
+				},
+			},
+			"lease_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntBetween(300, 30*86400),
+			},
+			"dns_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "DNS servers offered to hosts. Empty offers the edge device's DNS proxy.",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPv4Address},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"option": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Custom DHCP options, e.g. 66 for the TFTP server of IP phones",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code":  {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntBetween(1, 254)},
+						"value": {Type: schema.TypeString, Required: true},
+					},
+				},
+			},
+			"reservation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": {Type: schema.TypeString, Required: true, ValidateFunc: validation.IsMACAddress},
+						"ip_address":  {Type: schema.TypeString, Required: true, ValidateFunc: validation.IsIPv4Address},
+						"hostname":    {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateDHCPServer checks ranges and reservations, and when the VLAN
+// already exists, that they are inside its subnet
+func validateDHCPServer(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	ranges := expandDHCPRanges(d.Get("range").([]interface{}))
+	reservations := expandDHCPReservations(d.Get("reservation").([]interface{}))
+
+	for i, r := range ranges {
+		start, end := net.ParseIP(r.Start).To4(), net.ParseIP(r.End).To4()
+		if start == nil || end == nil {
+			continue
+		}
+		if bytes.Compare(start, end) > 0 {
+			return fmt.Errorf("range.%d: start %s is after end %s", i, r.Start, r.End)
+		}
+	}
+	for _, o := range expandDHCPOptions(d.Get("option").([]interface{})) {
+		if arg, ok := dhcpManagedOptions[o.Code]; ok {
+			return fmt.Errorf("option: code %d is set with %s", o.Code, arg)
+		}
+	}
+	macs, ips := map[string]bool{}, map[string]bool{}
+	for _, r := range reservations {
+		if mac, err := net.ParseMAC(r.MACAddress); err == nil {
+			if macs[mac.String()] {
+				return fmt.Errorf("reservation: %s is reserved more than once", r.MACAddress)
+			}
+			macs[mac.String()] = true
+		}
+		if ips[r.IPAddress] {
+			return fmt.Errorf("reservation: %s is reserved for more than one host", r.IPAddress)
+		}
+		ips[r.IPAddress] = true
+	}
+
+	if !d.NewValueKnown("site_id") || !d.NewValueKnown("vlan") {
+		return nil
+	}
+	subnet, err := vlanSubnet(ctx, m.(*Client), d.Get("site_id").(string), d.Get("vlan").(int))
+	if err != nil || subnet == nil {
+		// The VLAN is created in this apply; the API checks the addresses
+		return nil
+	}
+	for i, r := range ranges {
+		for _, addr := range []string{r.Start, r.End} {
+			if ip := net.ParseIP(addr); ip != nil && !subnet.Contains(ip) {
+				return fmt.Errorf("range.%d: %s is not in the VLAN's subnet %s", i, addr, subnet)
+			}
+		}
+	}
+	for _, r := range reservations {
+		if ip := net.ParseIP(r.IPAddress); ip != nil && !subnet.Contains(ip) {
+			return fmt.Errorf("reservation: %s is not in the VLAN's subnet %s", r.IPAddress, subnet)
+		}
+	}
+	return nil
+}
+
+// vlanSubnet returns the subnet of the VLAN with the given tag at a site, or
+// nil if the site has no such VLAN
+func vlanSubnet(ctx context.Context, client *Client, siteID string, tag int) (*net.IPNet, error) {
+	vlans, err := client.API.Network.VLANs.List(ctx, siteID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vlans {
+		if v.VLANID == tag {
+			_, subnet, err := net.ParseCIDR(v.CIDR)
+			return subnet, err
+		}
+	}
+	return nil, nil
+}
+
+func resourceDHCPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	server, err := client.API.Network.DHCP.Create(ctx, siteID, &opensase.CreateDHCPServerParams{
+		VLAN:         d.Get("vlan").(int),
+		Enabled:      opensase.Bool(d.Get("enabled").(bool)),
+		Ranges:       expandDHCPRanges(d.Get("range").([]interface{})),
+		LeaseSeconds: d.Get("lease_seconds").(int),
+		DNSServers:   expandStringList(d.Get("dns_servers").([]interface{})),
+		DomainName:   d.Get("domain_name").(string),
+		Options:      expandDHCPOptions(d.Get("option").([]interface{})),
+		Reservations: expandDHCPReservations(d.Get("reservation").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating DHCP server")
+	}
+
+	d.SetId(siteID + "/" + server.ID)
+	return resourceDHCPServerRead(ctx, d, m)
+}
+
+func resourceDHCPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, serverID, err := parseSiteScopedID(d.Id(), "DHCP server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server, err := client.API.Network.DHCP.Get(ctx, siteID, serverID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading DHCP server")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("vlan", server.VLAN)
+	d.Set("enabled", server.Enabled)
+	d.Set("range", flattenDHCPRanges(server.Ranges))
+	d.Set("lease_seconds", server.LeaseSeconds)
+	d.Set("dns_servers", server.DNSServers)
+	d.Set("domain_name", server.DomainName)
+	d.Set("option", flattenDHCPOptions(server.Options))
+	d.Set("reservation", flattenDHCPReservations(server.Reservations))
+	return nil
+}
+
+func resourceDHCPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, serverID, err := parseSiteScopedID(d.Id(), "DHCP server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateDHCPServerParams{}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("range") {
+		ranges := expandDHCPRanges(d.Get("range").([]interface{}))
+		params.Ranges = &ranges
+	}
+	if d.HasChange("lease_seconds") {
+		params.LeaseSeconds = opensase.Int(d.Get("lease_seconds").(int))
+	}
+	if d.HasChange("dns_servers") {
+		servers := expandStringList(d.Get("dns_servers").([]interface{}))
+		params.DNSServers = &servers
+	}
+	if d.HasChange("domain_name") {
+		params.DomainName = opensase.String(d.Get("domain_name").(string))
+	}
+	if d.HasChange("option") {
+		options := expandDHCPOptions(d.Get("option").([]interface{}))
+		params.Options = &options
+	}
+	if d.HasChange("reservation") {
+		reservations := expandDHCPReservations(d.Get("reservation").([]interface{}))
+		params.Reservations = &reservations
+	}
+
+	if _, err := client.API.Network.DHCP.Update(ctx, siteID, serverID, params); err != nil {
+		return apiDiagnostics(err, "Error updating DHCP server")
+	}
+
+	return resourceDHCPServerRead(ctx, d, m)
+}
+
+func resourceDHCPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, serverID, err := parseSiteScopedID(d.Id(), "DHCP server")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.DHCP.Delete(ctx, siteID, serverID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting DHCP server")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandDHCPRanges(raw []interface{}) []opensase.DHCPRange {
+	ranges := make([]opensase.DHCPRange, 0, len(raw))
+	for _, r := range raw {
+		rm := r.(map[string]interface{})
+		ranges = append(ranges, opensase.DHCPRange{
+			Start: rm["start"].(string),
+			End:   rm["end"].(string),
+		})
+	}
+	return ranges
+}
+
+func flattenDHCPRanges(ranges []opensase.DHCPRange) []interface{} {
+	out := make([]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		out = append(out, map[string]interface{}{
+			"start": r.Start,
+			"end":   r.End,
+		})
+	}
+	return out
+}
+
+func expandDHCPOptions(raw []interface{}) []opensase.DHCPOption {
+	options := make([]opensase.DHCPOption, 0, len(raw))
+	for _, r := range raw {
+		om := r.(map[string]interface{})
+		options = append(options, opensase.DHCPOption{
+			Code:  om["code"].(int),
+			Value: om["value"].(string),
+		})
+	}
+	return options
+}
+
+func flattenDHCPOptions(options []opensase.DHCPOption) []interface{} {
+	out := make([]interface{}, 0, len(options))
+	for _, o := range options {
+		out = append(out, map[string]interface{}{
+			"code":  o.Code,
+			"value": o.Value,
+		})
+	}
+	return out
+}
+
+func expandDHCPReservations(raw []interface{}) []opensase.DHCPReservation {
+	reservations := make([]opensase.DHCPReservation, 0, len(raw))
+	for _, r := range raw {
+		rm := r.(map[string]interface{})
+		reservations = append(reservations, opensase.DHCPReservation{
+			MACAddress: rm["mac_address"].(string),
+			IPAddress:  rm["ip_address"].(string),
+			Hostname:   rm["hostname"].(string),
+		})
+	}
+	return reservations
+}
+
+func flattenDHCPReservations(reservations []opensase.DHCPReservation) []interface{} {
+	out := make([]interface{}, 0, len(reservations))
+	for _, r := range reservations {
+		out = append(out, map[string]interface{}{
+			"mac_address": r.MACAddress,
+			"ip_address":  r.IPAddress,
+			"hostname":    r.Hostname,
+		})
+	}
+	return out
+}
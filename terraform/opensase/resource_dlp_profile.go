@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ DLP Profile Resource ============
+
+func resourceDLPProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Data loss prevention profile matching sensitive data patterns in traffic and files",
+		CreateContext: resourceDLPProfileCreate,
+		ReadContext:   resourceDLPProfileRead,
+		UpdateContext: resourceDLPProfileUpdate,
+		DeleteContext: resourceDLPProfileDelete,
+		CustomizeDiff: validateDLPPatterns,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pattern": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{opensase.DLPPatternRegex, opensase.DLPPatternPredefined}, false),
+						},
+						"regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Predefined identifier, e.g. pan, ssn, iban, passport",
+						},
+						"min_matches": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Matches needed in one request or file before the profile triggers",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"file_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "File types to inspect, e.g. pdf, docx, xlsx; all types when empty",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "medium",
+				ValidateFunc: validation.StringInSlice([]string{"low", "medium", "high", "critical"}, false),
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "alert",
+				ValidateFunc: validation.StringInSlice([]string{"alert", "block", "redact", "quarantine"}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// validateDLPPatterns checks that each pattern sets the field its type needs
+func validateDLPPatterns(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, r := range d.Get("pattern").(*schema.Set).List() {
+		p := r.(map[string]interface{})
+		name := p["name"].(string)
+		switch p["type"].(string) {
+		case opensase.DLPPatternRegex:
+			if p["regex"].(string) == "" {
+				return fmt.Errorf("pattern %q: regex is required for regex patterns", name)
+			}
+		case opensase.DLPPatternPredefined:
+			if p["identifier"].(string) == "" {
+				return fmt.Errorf("pattern %q: identifier is required for predefined patterns", name)
+			}
+		}
+	}
+	return nil
+}
+
+func expandDLPPatterns(s *schema.Set) []opensase.DLPPattern {
+	patterns := make([]opensase.DLPPattern, 0, s.Len())
+	for _, r := range s.List() {
+		p := r.(map[string]interface{})
+		patterns = append(patterns, opensase.DLPPattern{
+			Name:       p["name"].(string),
+			Type:       p["type"].(string),
+			Regex:      p["regex"].(string),
+			Identifier: p["identifier"].(string),
+			MinMatches: p["min_matches"].(int),
+		})
+	}
+	return patterns
+}
+
+func flattenDLPPatterns(patterns []opensase.DLPPattern) []interface{} {
+	out := make([]interface{}, 0, len(patterns))
+	for _, p := range patterns {
+		out = append(out, map[string]interface{}{
+			"name":        p.Name,
+			"type":        p.Type,
+			"regex":       p.Regex,
+			"identifier":  p.Identifier,
+			"min_matches": p.MinMatches,
+		})
+	}
+	return out
+}
+
+func resourceDLPProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.DLP.Create(ctx, &opensase.CreateDLPProfileParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Patterns:    expandDLPPatterns(d.Get("pattern").(*schema.Set)),
+		FileTypes:   expandStringSet(d.Get("file_types").(*schema.Set)),
+		Severity:    d.Get("severity").(string),
+		Action:      d.Get("action").(string),
+		Enabled:     opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating DLP profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceDLPProfileRead(ctx, d, m)
+}
+
+func resourceDLPProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.DLP.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading DLP profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("pattern", flattenDLPPatterns(profile.Patterns))
+	d.Set("file_types", profile.FileTypes)
+	d.Set("severity", profile.Severity)
+	d.Set("action", profile.Action)
+	d.Set("enabled", profile.Enabled)
+	return nil
+}
+
+func resourceDLPProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateDLPProfileParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("pattern") {
+		params.Patterns = expandDLPPatterns(d.Get("pattern").(*schema.Set))
+	}
+	if d.HasChange("file_types") {
+		fileTypes := expandStringSet(d.Get("file_types").(*schema.Set))
+		params.FileTypes = &fileTypes
+	}
+	if d.HasChange("severity") {
+		params.Severity = opensase.String(d.Get("severity").(string))
+	}
+	if d.HasChange("action") {
+		params.Action = opensase.String(d.Get("action").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Security.DLP.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating DLP profile")
+	}
+
+	return resourceDLPProfileRead(ctx, d, m)
+}
+
+func resourceDLPProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.DLP.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting DLP profile")
+	}
+
+	d.SetId("")
+	return nil
+}
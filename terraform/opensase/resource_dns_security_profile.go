@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ DNS Security Profile Resource ============
+
+func resourceDNSSecurityProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "DNS-layer security profile with category blocking, sinkholing and DoH handling",
+		CreateContext: resourceDNSSecurityProfileCreate,
+		ReadContext:   resourceDNSSecurityProfileRead,
+		UpdateContext: resourceDNSSecurityProfileUpdate,
+		DeleteContext: resourceDNSSecurityProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"blocked_categories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Threat and content category IDs to block, e.g. malware, phishing, newly-registered",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sinkhole_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Address returned for blocked A queries. Blocked queries get NXDOMAIN when unset.",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"sinkhole_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Address returned for blocked AAAA queries",
+				ValidateFunc: validation.IsIPv6Address,
+			},
+			"doh_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      opensase.DoHBlock,
+				Description:  "Handling of DNS-over-HTTPS to public resolvers: allow, block, or redirect to the OpenSASE resolver",
+				ValidateFunc: validation.StringInSlice([]string{opensase.DoHAllow, opensase.DoHBlock, opensase.DoHRedirect}, false),
+			},
+			"block_domains": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Domains always blocked, including subdomains",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allow_domains": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Domains always resolved, regardless of category",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"logging": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      opensase.DNSLogBlocked,
+							ValidateFunc: validation.StringInSlice([]string{opensase.DNSLogAll, opensase.DNSLogBlocked, opensase.DNSLogNone}, false),
+						},
+						"anonymize_users": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Omit user and device identity from DNS logs",
+						},
+					},
+				},
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Sites the profile is assigned to",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandDNSLogging(v []interface{}) *opensase.DNSLogging {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	l := v[0].(map[string]interface{})
+	return &opensase.DNSLogging{
+		Level:          l["level"].(string),
+		AnonymizeUsers: l["anonymize_users"].(bool),
+	}
+}
+
+func flattenDNSLogging(l opensase.DNSLogging) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"level":           l.Level,
+		"anonymize_users": l.AnonymizeUsers,
+	}}
+}
+
+func resourceDNSSecurityProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.DNS.Create(ctx, &opensase.CreateDNSSecurityProfileParams{
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		BlockedCategories: expandStringSet(d.Get("blocked_categories").(*schema.Set)),
+		SinkholeIPv4:      d.Get("sinkhole_ipv4").(string),
+		SinkholeIPv6:      d.Get("sinkhole_ipv6").(string),
+		DoHMode:           d.Get("doh_mode").(string),
+		BlockDomains:      expandStringSet(d.Get("block_domains").(*schema.Set)),
+		AllowDomains:      expandStringSet(d.Get("allow_domains").(*schema.Set)),
+		Logging:           expandDNSLogging(d.Get("logging").([]interface{})),
+		SiteIDs:           expandStringSet(d.Get("site_ids").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating DNS security profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceDNSSecurityProfileRead(ctx, d, m)
+}
+
+func resourceDNSSecurityProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.DNS.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading DNS security profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("blocked_categories", profile.BlockedCategories)
+	d.Set("sinkhole_ipv4", profile.SinkholeIPv4)
+	d.Set("sinkhole_ipv6", profile.SinkholeIPv6)
+	d.Set("doh_mode", profile.DoHMode)
+	d.Set("block_domains", profile.BlockDomains)
+	d.Set("allow_domains", profile.AllowDomains)
+	d.Set("logging", flattenDNSLogging(profile.Logging))
+	d.Set("site_ids", profile.SiteIDs)
+	return nil
+}
+
+func resourceDNSSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateDNSSecurityProfileParams{}
+	for key, field := range map[string]**string{
+		"name":          &params.Name,
+		"description":   &params.Description,
+		"sinkhole_ipv4": &params.SinkholeIPv4,
+		"sinkhole_ipv6": &params.SinkholeIPv6,
+		"doh_mode":      &params.DoHMode,
+	} {
+		if d.HasChange(key) {
+			*field = opensase.String(d.Get(key).(string))
+		}
+	}
+	for key, field := range map[string]**[]string{
+		"blocked_categories": &params.BlockedCategories,
+		"block_domains":      &params.BlockDomains,
+		"allow_domains":      &params.AllowDomains,
+		"site_ids":           &params.SiteIDs,
+	} {
+		if d.HasChange(key) {
+			list := expandStringSet(d.Get(key).(*schema.Set))
+			*field = &list
+		}
+	}
+	if d.HasChange("logging") {
+		params.Logging = expandDNSLogging(d.Get("logging").([]interface{}))
+	}
+
+	if _, err := client.API.Security.DNS.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating DNS security profile")
+	}
+
+	return resourceDNSSecurityProfileRead(ctx, d, m)
+}
+
+func resourceDNSSecurityProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.DNS.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting DNS security profile")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Edge Device Resource ============
+
+func resourceEdgeDevice() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Edge appliance claimed by the tenant. Destroying the resource releases the claim.",
+		CreateContext: resourceEdgeDeviceCreate,
+		ReadContext:   resourceEdgeDeviceRead,
+		UpdateContext: resourceEdgeDeviceUpdate,
+		DeleteContext: resourceEdgeDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"serial_number": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"claim_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+				Description: "Code printed on the appliance. Only used when claiming.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Site the device serves; unbound devices stay idle",
+			},
+			"firmware_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  opensase.FirmwareChannelStable,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.FirmwareChannelStable,
+					opensase.FirmwareChannelLTS,
+					opensase.FirmwareChannelBeta,
+				}, false),
+			},
+			"ha_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Leave unset for devices in an opensase_ha_pair, which assigns the role",
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.HARoleStandalone,
+					opensase.HARolePrimary,
+					opensase.HARoleSecondary,
+				}, false),
+			},
+			"firmware_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_seen_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last time the device connected, in RFC 3339 format; empty until it first connects",
+			},
+		},
+	}
+}
+
+func resourceEdgeDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	device, err := client.API.Network.Devices.Claim(ctx, &opensase.ClaimDeviceParams{
+		SerialNumber:    d.Get("serial_number").(string),
+		Model:           d.Get("model").(string),
+		ClaimCode:       d.Get("claim_code").(string),
+		Name:            d.Get("name").(string),
+		SiteID:          d.Get("site_id").(string),
+		FirmwareChannel: d.Get("firmware_channel").(string),
+		HARole:          d.Get("ha_role").(string),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error claiming edge device")
+	}
+
+	d.SetId(device.ID)
+	return resourceEdgeDeviceRead(ctx, d, m)
+}
+
+func resourceEdgeDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	device, err := client.API.Network.Devices.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading edge device")
+	}
+
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("model", device.Model)
+	d.Set("name", device.Name)
+	d.Set("site_id", device.SiteID)
+	d.Set("firmware_channel", device.FirmwareChannel)
+	d.Set("ha_role", device.HARole)
+	d.Set("firmware_version", device.FirmwareVersion)
+	d.Set("status", device.Status)
+
+	lastSeen := ""
+	if device.LastSeenAt != nil {
+		lastSeen = device.LastSeenAt.Format(time.RFC3339)
+	}
+	d.Set("last_seen_at", lastSeen)
+	return nil
+}
+
+func resourceEdgeDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateDeviceParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("site_id") {
+		params.SiteID = opensase.String(d.Get("site_id").(string))
+	}
+	if d.HasChange("firmware_channel") {
+		params.FirmwareChannel = opensase.String(d.Get("firmware_channel").(string))
+	}
+	if d.HasChange("ha_role") {
+		params.HARole = opensase.String(d.Get("ha_role").(string))
+	}
+
+	if _, err := client.API.Network.Devices.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating edge device")
+	}
+
+	return resourceEdgeDeviceRead(ctx, d, m)
+}
+
+func resourceEdgeDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.Devices.Release(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error releasing edge device")
+	}
+
+	d.SetId("")
+	return nil
+}
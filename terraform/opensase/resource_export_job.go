@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Export Job Resource ============
+
+func resourceExportJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Recurring export of a dataset to an S3 or GCS bucket for data warehouse ingestion",
+		CreateContext: resourceExportJobCreate,
+		ReadContext:   resourceExportJobRead,
+		UpdateContext: resourceExportJobUpdate,
+		DeleteContext: resourceExportJobDelete,
+		CustomizeDiff: validateExportDestination,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dataset": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.ExportDatasetContacts,
+					opensase.ExportDatasetDeals,
+					opensase.ExportDatasetUsers,
+					opensase.ExportDatasetFlowSummaries,
+					opensase.ExportDatasetEvents,
+				}, false),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "parquet",
+				ValidateFunc: validation.StringInSlice([]string{"parquet"}, false),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{opensase.ExportDestinationS3, opensase.ExportDestinationGCS}, false),
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"role_arn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "IAM role assumed to write to an s3 bucket",
+						},
+						"service_account": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service account impersonated to write to a gcs bucket",
+						},
+					},
+				},
+			},
+			"partition_granularity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "day",
+				ValidateFunc: validation.StringInSlice([]string{"hour", "day", "month", "none"}, false),
+			},
+			"partition_field": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timestamp column used for partitioning; defaults to the dataset's update time",
+			},
+			"schedule": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cron expression for incremental runs. Omit to only run on demand.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"schema_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_run_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateExportDestination checks that the destination names the credential
+// its type is written with
+func validateExportDestination(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	dest := expandExportDestination(d.Get("destination").([]interface{}))
+	switch dest.Type {
+	case opensase.ExportDestinationS3:
+		if dest.ServiceAccount != "" {
+			return fmt.Errorf("destination: service_account is only used with gcs")
+		}
+		if dest.RoleARN == "" && d.NewValueKnown("destination.0.role_arn") {
+			return fmt.Errorf("destination: role_arn is required for s3")
+		}
+	case opensase.ExportDestinationGCS:
+		if dest.RoleARN != "" {
+			return fmt.Errorf("destination: role_arn is only used with s3")
+		}
+		if dest.ServiceAccount == "" && d.NewValueKnown("destination.0.service_account") {
+			return fmt.Errorf("destination: service_account is required for gcs")
+		}
+	}
+	return nil
+}
+
+func expandExportDestination(raw []interface{}) opensase.ExportDestination {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.ExportDestination{}
+	}
+	e := raw[0].(map[string]interface{})
+	return opensase.ExportDestination{
+		Type:           e["type"].(string),
+		Bucket:         e["bucket"].(string),
+		Prefix:         e["prefix"].(string),
+		Region:         e["region"].(string),
+		RoleARN:        e["role_arn"].(string),
+		ServiceAccount: e["service_account"].(string),
+	}
+}
+
+func flattenExportDestination(dest opensase.ExportDestination) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"type":            dest.Type,
+		"bucket":          dest.Bucket,
+		"prefix":          dest.Prefix,
+		"region":          dest.Region,
+		"role_arn":        dest.RoleARN,
+		"service_account": dest.ServiceAccount,
+	}}
+}
+
+func expandExportPartitioning(d *schema.ResourceData) opensase.ExportPartitioning {
+	return opensase.ExportPartitioning{
+		Granularity: d.Get("partition_granularity").(string),
+		Field:       d.Get("partition_field").(string),
+	}
+}
+
+func resourceExportJobCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	job, err := client.API.Exports.CreateJob(ctx, &opensase.CreateExportJobParams{
+		Name:         d.Get("name").(string),
+		Dataset:      d.Get("dataset").(string),
+		Format:       d.Get("format").(string),
+		Destination:  expandExportDestination(d.Get("destination").([]interface{})),
+		Partitioning: expandExportPartitioning(d),
+		Schedule:     d.Get("schedule").(string),
+		Enabled:      opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating export job")
+	}
+
+	d.SetId(job.ID)
+	return resourceExportJobRead(ctx, d, m)
+}
+
+func resourceExportJobRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	job, err := client.API.Exports.GetJob(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading export job")
+	}
+
+	d.Set("name", job.Name)
+	d.Set("dataset", job.Dataset)
+	d.Set("format", job.Format)
+	d.Set("destination", flattenExportDestination(job.Destination))
+	d.Set("partition_granularity", job.Partitioning.Granularity)
+	d.Set("partition_field", job.Partitioning.Field)
+	d.Set("schedule", job.Schedule)
+	d.Set("enabled", job.Enabled)
+	d.Set("schema_version", job.SchemaVersion)
+	if job.LastRunAt != nil {
+		d.Set("last_run_at", job.LastRunAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceExportJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateExportJobParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("destination") {
+		dest := expandExportDestination(d.Get("destination").([]interface{}))
+		params.Destination = &dest
+	}
+	if d.HasChanges("partition_granularity", "partition_field") {
+		partitioning := expandExportPartitioning(d)
+		params.Partitioning = &partitioning
+	}
+	if d.HasChange("schedule") {
+		params.Schedule = opensase.String(d.Get("schedule").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Exports.UpdateJob(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating export job")
+	}
+
+	return resourceExportJobRead(ctx, d, m)
+}
+
+func resourceExportJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Exports.DeleteJob(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting export job")
+	}
+
+	d.SetId("")
+	return nil
+}
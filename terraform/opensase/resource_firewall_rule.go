@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/enum"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Firewall Rule Resource ============
+
+func resourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Firewall rule. Rules are evaluated in ascending priority; the API " +
+			"stores priorities exactly as given and never renumbers other rules, so " +
+			"leaving gaps (10, 20, 30) lets rules be inserted without touching the rest.",
+		CreateContext: resourceFirewallRuleCreate,
+		ReadContext:   resourceFirewallRuleRead,
+		UpdateContext: resourceFirewallRuleUpdate,
+		DeleteContext: resourceFirewallRuleDelete,
+		CustomizeDiff: validateFirewallRuleReferences,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Evaluation position; unique within the rule base",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     ruleEndpointSchema(),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     ruleEndpointSchema(),
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_objects": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of opensase_service_object resources, matched in addition to services",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of opensase_service_group resources, matched in addition to services",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"applications": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of an opensase_schedule_object; the rule only matches while it is active",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(enum.Strings(enum.PolicyActions()), false),
+			},
+			"log_start": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"log_end": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"hit_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Times the rule has matched traffic. Only populated with the provider's rule_usage_stats enabled.",
+			},
+			"last_matched_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the rule last matched traffic. Only populated with the provider's rule_usage_stats enabled.",
+			},
+		},
+	}
+}
+
+func ruleEndpointSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zones":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"addresses":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"address_objects": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"users":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"negate":          {Type: schema.TypeBool, Optional: true, Default: false},
+		},
+	}
+}
+
+func expandRuleEndpoint(raw []interface{}) opensase.RuleEndpoint {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.RuleEndpoint{}
+	}
+	e := raw[0].(map[string]interface{})
+	return opensase.RuleEndpoint{
+		Zones:          expandStringSet(e["zones"].(*schema.Set)),
+		Addresses:      expandStringSet(e["addresses"].(*schema.Set)),
+		AddressObjects: expandStringSet(e["address_objects"].(*schema.Set)),
+		Users:          expandStringSet(e["users"].(*schema.Set)),
+		Negate:         e["negate"].(bool),
+	}
+}
+
+func flattenRuleEndpoint(e opensase.RuleEndpoint) []interface{} {
+	if len(e.Zones) == 0 && len(e.Addresses) == 0 && len(e.AddressObjects) == 0 && len(e.Users) == 0 && !e.Negate {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"zones":           e.Zones,
+		"addresses":       e.Addresses,
+		"address_objects": e.AddressObjects,
+		"users":           e.Users,
+		"negate":          e.Negate,
+	}}
+}
+
+// validateFirewallRuleReferences fails the plan when a rule references an
+// address, service or schedule object, or service group, that does not exist
+func validateFirewallRuleReferences(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client := m.(*Client)
+
+	for _, ref := range []struct{ key, kind string }{
+		{"source.0.address_objects", objectAddress},
+		{"destination.0.address_objects", objectAddress},
+		{"service_objects", objectService},
+		{"service_groups", objectServiceGroup},
+		{"schedule_id", objectSchedule},
+	} {
+		if err := validateObjectReferences(ctx, d, client, ref.key, ref.kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	rule, err := client.API.Security.Firewall.Create(ctx, &opensase.CreateFirewallRuleParams{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Priority:       d.Get("priority").(int),
+		Enabled:        opensase.Bool(d.Get("enabled").(bool)),
+		Source:         expandRuleEndpoint(d.Get("source").([]interface{})),
+		Destination:    expandRuleEndpoint(d.Get("destination").([]interface{})),
+		Services:       expandStringSet(d.Get("services").(*schema.Set)),
+		ServiceObjects: expandStringSet(d.Get("service_objects").(*schema.Set)),
+		ServiceGroups:  expandStringSet(d.Get("service_groups").(*schema.Set)),
+		Applications:   expandStringSet(d.Get("applications").(*schema.Set)),
+		ScheduleID:     d.Get("schedule_id").(string),
+		Action:         enum.PolicyAction(d.Get("action").(string)),
+		LogStart:       d.Get("log_start").(bool),
+		LogEnd:         d.Get("log_end").(bool),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating firewall rule")
+	}
+
+	d.SetId(rule.ID)
+	return resourceFirewallRuleRead(ctx, d, m)
+}
+
+func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	rule, err := client.API.Security.Firewall.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading firewall rule")
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("description", rule.Description)
+	d.Set("priority", rule.Priority)
+	d.Set("enabled", rule.Enabled)
+	d.Set("source", flattenRuleEndpoint(rule.Source))
+	d.Set("destination", flattenRuleEndpoint(rule.Destination))
+	d.Set("services", rule.Services)
+	d.Set("service_objects", rule.ServiceObjects)
+	d.Set("service_groups", rule.ServiceGroups)
+	d.Set("applications", rule.Applications)
+	d.Set("schedule_id", rule.ScheduleID)
+	d.Set("action", string(rule.Action))
+	d.Set("log_start", rule.LogStart)
+	d.Set("log_end", rule.LogEnd)
+	setRuleUsage(d, client, rule.RuleUsage)
+	return nil
+}
+
+func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateFirewallRuleParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("source") {
+		source := expandRuleEndpoint(d.Get("source").([]interface{}))
+		params.Source = &source
+	}
+	if d.HasChange("destination") {
+		destination := expandRuleEndpoint(d.Get("destination").([]interface{}))
+		params.Destination = &destination
+	}
+	if d.HasChange("services") {
+		services := expandStringSet(d.Get("services").(*schema.Set))
+		params.Services = &services
+	}
+	if d.HasChange("service_objects") {
+		objects := expandStringSet(d.Get("service_objects").(*schema.Set))
+		params.ServiceObjects = &objects
+	}
+	if d.HasChange("service_groups") {
+		groups := expandStringSet(d.Get("service_groups").(*schema.Set))
+		params.ServiceGroups = &groups
+	}
+	if d.HasChange("applications") {
+		applications := expandStringSet(d.Get("applications").(*schema.Set))
+		params.Applications = &applications
+	}
+	if d.HasChange("schedule_id") {
+		params.ScheduleID = opensase.String(d.Get("schedule_id").(string))
+	}
+	if d.HasChange("action") {
+		params.Action = opensase.Ptr(enum.PolicyAction(d.Get("action").(string)))
+	}
+	if d.HasChange("log_start") {
+		params.LogStart = opensase.Bool(d.Get("log_start").(bool))
+	}
+	if d.HasChange("log_end") {
+		params.LogEnd = opensase.Bool(d.Get("log_end").(bool))
+	}
+
+	if _, err := client.API.Security.Firewall.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating firewall rule")
+	}
+
+	return resourceFirewallRuleRead(ctx, d, m)
+}
+
+func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.Firewall.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting firewall rule")
+	}
+
+	d.SetId("")
+	return nil
+}
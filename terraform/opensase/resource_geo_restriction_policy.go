@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Geo Restriction Policy Resource ============
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+func geoDirectionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mode": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     opensase.GeoModeBlockList,
+					Description: "block_list restricts the listed countries; allow_list restricts every other country",
+					ValidateFunc: validation.StringInSlice([]string{
+						opensase.GeoModeBlockList, opensase.GeoModeAllowList,
+					}, false),
+				},
+				"countries": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Description: "ISO 3166-1 alpha-2 country codes, e.g. KP",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringMatch(countryCodePattern, "must be an upper-case ISO 3166-1 alpha-2 code"),
+					},
+				},
+				"action": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     opensase.GeoActionBlock,
+					Description: "block, or alert to allow the traffic but log a security event",
+					ValidateFunc: validation.StringInSlice([]string{
+						opensase.GeoActionBlock, opensase.GeoActionAlert,
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceGeoRestrictionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Restricts traffic by the country of the remote address: " +
+			"inbound by the source of connections into sites, outbound by the destination of connections from them.",
+		CreateContext: resourceGeoRestrictionPolicyCreate,
+		ReadContext:   resourceGeoRestrictionPolicyRead,
+		UpdateContext: resourceGeoRestrictionPolicyUpdate,
+		DeleteContext: resourceGeoRestrictionPolicyDelete,
+		CustomizeDiff: validateGeoRestrictionPolicy,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sites the policy applies to. Empty applies it to every site.",
+			},
+			"inbound":  geoDirectionSchema("Restriction of connections into sites, by source country"),
+			"outbound": geoDirectionSchema("Restriction of connections from sites, by destination country"),
+			"exempt_cidrs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Local addresses whose traffic is never restricted",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"exempt_address_object_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "opensase_address_object IDs whose traffic is never restricted",
+			},
+			"exempt_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "opensase_group IDs whose members' traffic is never restricted",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func validateGeoRestrictionPolicy(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Get("inbound").([]interface{})) == 0 && len(d.Get("outbound").([]interface{})) == 0 {
+		return fmt.Errorf("at least one of inbound or outbound is required")
+	}
+	return validateObjectReferences(ctx, d, m.(*Client), "exempt_address_object_ids", objectAddress)
+}
+
+func expandGeoDirection(raw []interface{}) *opensase.GeoDirectionRule {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	r := raw[0].(map[string]interface{})
+	return &opensase.GeoDirectionRule{
+		Mode:      r["mode"].(string),
+		Countries: expandStringSet(r["countries"].(*schema.Set)),
+		Action:    r["action"].(string),
+	}
+}
+
+func flattenGeoDirection(rule *opensase.GeoDirectionRule) []interface{} {
+	if rule == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"mode":      rule.Mode,
+		"countries": rule.Countries,
+		"action":    rule.Action,
+	}}
+}
+
+func expandGeoExemptions(d *schema.ResourceData) *opensase.GeoExemptions {
+	return &opensase.GeoExemptions{
+		CIDRs:            expandStringSet(d.Get("exempt_cidrs").(*schema.Set)),
+		AddressObjectIDs: expandStringSet(d.Get("exempt_address_object_ids").(*schema.Set)),
+		GroupIDs:         expandStringSet(d.Get("exempt_group_ids").(*schema.Set)),
+	}
+}
+
+func resourceGeoRestrictionPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.GeoRestriction.Create(ctx, &opensase.CreateGeoRestrictionPolicyParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     opensase.Bool(d.Get("enabled").(bool)),
+		SiteIDs:     expandStringSet(d.Get("site_ids").(*schema.Set)),
+		Inbound:     expandGeoDirection(d.Get("inbound").([]interface{})),
+		Outbound:    expandGeoDirection(d.Get("outbound").([]interface{})),
+		Exemptions:  expandGeoExemptions(d),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating geo restriction policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourceGeoRestrictionPolicyRead(ctx, d, m)
+}
+
+func resourceGeoRestrictionPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.GeoRestriction.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading geo restriction policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.Enabled)
+	d.Set("site_ids", policy.SiteIDs)
+	d.Set("inbound", flattenGeoDirection(policy.Inbound))
+	d.Set("outbound", flattenGeoDirection(policy.Outbound))
+	d.Set("exempt_cidrs", policy.Exemptions.CIDRs)
+	d.Set("exempt_address_object_ids", policy.Exemptions.AddressObjectIDs)
+	d.Set("exempt_group_ids", policy.Exemptions.GroupIDs)
+	return nil
+}
+
+func resourceGeoRestrictionPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateGeoRestrictionPolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("site_ids") {
+		siteIDs := expandStringSet(d.Get("site_ids").(*schema.Set))
+		params.SiteIDs = &siteIDs
+	}
+	if d.HasChange("inbound") {
+		params.Inbound = expandGeoDirection(d.Get("inbound").([]interface{}))
+		params.ClearInbound = params.Inbound == nil
+	}
+	if d.HasChange("outbound") {
+		params.Outbound = expandGeoDirection(d.Get("outbound").([]interface{}))
+		params.ClearOutbound = params.Outbound == nil
+	}
+	if d.HasChanges("exempt_cidrs", "exempt_address_object_ids", "exempt_group_ids") {
+		params.Exemptions = expandGeoExemptions(d)
+	}
+
+	if _, err := client.API.Security.GeoRestriction.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating geo restriction policy")
+	}
+
+	return resourceGeoRestrictionPolicyRead(ctx, d, m)
+}
+
+func resourceGeoRestrictionPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.GeoRestriction.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting geo restriction policy")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ============ Group Resource ============
+
+func resourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "User group. Manage its members with opensase_group_membership.",
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		UpdateContext: resourceGroupUpdate,
+		DeleteContext: resourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Roles granted to every member of the group",
+			},
+			"member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	group, err := client.API.Identity.Groups.Create(ctx, &opensase.CreateGroupParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Roles:       expandStringSet(d.Get("roles").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating group")
+	}
+
+	d.SetId(group.ID)
+	return resourceGroupRead(ctx, d, m)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	group, err := client.API.Identity.Groups.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading group")
+	}
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("roles", group.Roles)
+	d.Set("member_count", group.MemberCount)
+	return nil
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateGroupParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("roles") {
+		roles := expandStringSet(d.Get("roles").(*schema.Set))
+		params.Roles = &roles
+	}
+
+	if _, err := client.API.Identity.Groups.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating group")
+	}
+
+	return resourceGroupRead(ctx, d, m)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.Groups.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting group")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ============ Group Membership Resource ============
+
+// groupMembersPageSize is the page size used when listing group members
+const groupMembersPageSize = 100
+
+func resourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Adds users to an opensase_group. Only the listed users are managed: " +
+			"members added elsewhere, e.g. by SCIM, are left alone. Use one membership per group.",
+		CreateContext: resourceGroupMembershipCreate,
+		ReadContext:   resourceGroupMembershipRead,
+		UpdateContext: resourceGroupMembershipUpdate,
+		DeleteContext: resourceGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupMembershipImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	groupID := d.Get("group_id").(string)
+	userIDs := expandStringSet(d.Get("user_ids").(*schema.Set))
+	if err := client.API.Identity.Groups.AddMembers(ctx, groupID, userIDs); err != nil {
+		return apiDiagnostics(err, "Error adding group members")
+	}
+
+	d.SetId(groupID)
+	return resourceGroupMembershipRead(ctx, d, m)
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	members, err := client.groupMembers(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading group members")
+	}
+
+	var userIDs []string
+	for _, id := range expandStringSet(d.Get("user_ids").(*schema.Set)) {
+		if members[id] {
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	d.Set("group_id", d.Id())
+	d.Set("user_ids", userIDs)
+	return nil
+}
+
+func resourceGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	o, n := d.GetChange("user_ids")
+	oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+	if add := expandStringSet(newSet.Difference(oldSet)); len(add) > 0 {
+		if err := client.API.Identity.Groups.AddMembers(ctx, d.Id(), add); err != nil {
+			return apiDiagnostics(err, "Error adding group members")
+		}
+	}
+	if remove := expandStringSet(oldSet.Difference(newSet)); len(remove) > 0 {
+		if err := client.API.Identity.Groups.RemoveMembers(ctx, d.Id(), remove); err != nil {
+			return apiDiagnostics(err, "Error removing group members")
+		}
+	}
+
+	return resourceGroupMembershipRead(ctx, d, m)
+}
+
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	userIDs := expandStringSet(d.Get("user_ids").(*schema.Set))
+	if len(userIDs) > 0 {
+		if err := client.API.Identity.Groups.RemoveMembers(ctx, d.Id(), userIDs); err != nil && !isNotFound(err) {
+			return apiDiagnostics(err, "Error removing group members")
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceGroupMembershipImport takes a group ID and adopts all of its
+// current members
+func resourceGroupMembershipImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*Client)
+
+	members, err := client.groupMembers(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(members))
+	for id := range members {
+		userIDs = append(userIDs, id)
+	}
+	d.Set("group_id", d.Id())
+	d.Set("user_ids", userIDs)
+	return []*schema.ResourceData{d}, nil
+}
+
+// groupMembers returns the IDs of all users in a group
+func (c *Client) groupMembers(ctx context.Context, groupID string) (map[string]bool, error) {
+	members := map[string]bool{}
+	for page := 1; ; page++ {
+		resp, err := c.API.Identity.Groups.ListMembers(ctx, groupID, &opensase.ListGroupMembersParams{
+			Page:    page,
+			PerPage: groupMembersPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range resp.Data {
+			members[user.ID] = true
+		}
+		if len(resp.Data) == 0 || page >= resp.Pagination.TotalPages {
+			return members, nil
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ HA Pair Resource ============
+
+func resourceHAPair() *schema.Resource {
+	return &schema.Resource{
+		Description:   "High-availability pair of edge devices at a site. Pairing sets the devices' ha_role.",
+		CreateContext: resourceHAPairCreate,
+		ReadContext:   resourceHAPairRead,
+		UpdateContext: resourceHAPairUpdate,
+		DeleteContext: resourceHAPairDelete,
+		CustomizeDiff: validateHAPair,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("HA pair"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"primary_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"secondary_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"heartbeat_interface": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Interface carrying heartbeats between the devices, e.g. ha0",
+			},
+			"heartbeat_interval_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(100, 10000),
+			},
+			"failover_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				Description:  "Consecutive missed heartbeats before the secondary takes over",
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"preempt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Return traffic to the primary once it recovers",
+			},
+			"preempt_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				Description:  "Time the recovered primary must stay healthy before preempting",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"active_device_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateHAPair(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("primary_device_id") || !d.NewValueKnown("secondary_device_id") {
+		return nil
+	}
+	if d.Get("primary_device_id").(string) == d.Get("secondary_device_id").(string) {
+		return fmt.Errorf("secondary_device_id: must differ from primary_device_id")
+	}
+	return nil
+}
+
+func resourceHAPairCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	pair, err := client.API.Network.HAPairs.Create(ctx, siteID, &opensase.CreateHAPairParams{
+		PrimaryDeviceID:     d.Get("primary_device_id").(string),
+		SecondaryDeviceID:   d.Get("secondary_device_id").(string),
+		HeartbeatInterface:  d.Get("heartbeat_interface").(string),
+		HeartbeatIntervalMs: d.Get("heartbeat_interval_ms").(int),
+		FailoverThreshold:   d.Get("failover_threshold").(int),
+		Preempt:             opensase.Bool(d.Get("preempt").(bool)),
+		PreemptDelaySeconds: d.Get("preempt_delay_seconds").(int),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating HA pair")
+	}
+
+	d.SetId(siteID + "/" + pair.ID)
+	return resourceHAPairRead(ctx, d, m)
+}
+
+func resourceHAPairRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, pairID, err := parseSiteScopedID(d.Id(), "HA pair")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pair, err := client.API.Network.HAPairs.Get(ctx, siteID, pairID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading HA pair")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("primary_device_id", pair.PrimaryDeviceID)
+	d.Set("secondary_device_id", pair.SecondaryDeviceID)
+	d.Set("heartbeat_interface", pair.HeartbeatInterface)
+	d.Set("heartbeat_interval_ms", pair.HeartbeatIntervalMs)
+	d.Set("failover_threshold", pair.FailoverThreshold)
+	d.Set("preempt", pair.Preempt)
+	d.Set("preempt_delay_seconds", pair.PreemptDelaySeconds)
+	d.Set("active_device_id", pair.ActiveDeviceID)
+	d.Set("state", pair.State)
+	return nil
+}
+
+func resourceHAPairUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, pairID, err := parseSiteScopedID(d.Id(), "HA pair")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateHAPairParams{}
+	if d.HasChange("primary_device_id") {
+		params.PrimaryDeviceID = opensase.String(d.Get("primary_device_id").(string))
+	}
+	if d.HasChange("secondary_device_id") {
+		params.SecondaryDeviceID = opensase.String(d.Get("secondary_device_id").(string))
+	}
+	if d.HasChange("heartbeat_interface") {
+		params.HeartbeatInterface = opensase.String(d.Get("heartbeat_interface").(string))
+	}
+	if d.HasChange("heartbeat_interval_ms") {
+		params.HeartbeatIntervalMs = opensase.Int(d.Get("heartbeat_interval_ms").(int))
+	}
+	if d.HasChange("failover_threshold") {
+		params.FailoverThreshold = opensase.Int(d.Get("failover_threshold").(int))
+	}
+	if d.HasChange("preempt") {
+		params.Preempt = opensase.Bool(d.Get("preempt").(bool))
+	}
+	if d.HasChange("preempt_delay_seconds") {
+		params.PreemptDelaySeconds = opensase.Int(d.Get("preempt_delay_seconds").(int))
+	}
+
+	if _, err := client.API.Network.HAPairs.Update(ctx, siteID, pairID, params); err != nil {
+		return apiDiagnostics(err, "Error updating HA pair")
+	}
+
+	return resourceHAPairRead(ctx, d, m)
+}
+
+func resourceHAPairDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, pairID, err := parseSiteScopedID(d.Id(), "HA pair")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.HAPairs.Delete(ctx, siteID, pairID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting HA pair")
+	}
+
+	d.SetId("")
+	return nil
+}
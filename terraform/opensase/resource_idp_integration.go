@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ IdP Integration Resource ============
+
+func resourceIdPIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "SAML or OIDC identity provider used for user sign-in",
+		CreateContext: resourceIdPIntegrationCreate,
+		ReadContext:   resourceIdPIntegrationRead,
+		UpdateContext: resourceIdPIntegrationUpdate,
+		DeleteContext: resourceIdPIntegrationDelete,
+		CustomizeDiff: validateIdPIntegration,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{opensase.IdPTypeSAML, opensase.IdPTypeOIDC}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"domains": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Email domains whose users are sent to this IdP at sign-in",
+			},
+			"saml": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "IdP metadata URL. Alternative to entity_id, sso_url and certificate_pem.",
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"entity_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sso_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"certificate_pem": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "IdP signing certificate",
+							ValidateFunc: validateCertificatePEM,
+						},
+						"sign_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"oidc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Write-only; changes made outside Terraform are not detected",
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"attribute_mapping": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "OpenSASE user attribute (email, first_name, last_name, department, ...) to IdP attribute or claim name",
+			},
+			"jit_provisioning": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create users on their first sign-in",
+			},
+			"group_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Attribute or claim carrying the user's IdP groups",
+			},
+			"group_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"idp_group": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"group_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "OpenSASE group the IdP group is mapped to",
+						},
+					},
+				},
+			},
+			"sp_entity_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"acs_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sp_metadata_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"redirect_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateIdPIntegration(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("saml") || !d.NewValueKnown("oidc") {
+		return nil
+	}
+	samlSet := len(d.Get("saml").([]interface{})) > 0
+	oidcSet := len(d.Get("oidc").([]interface{})) > 0
+
+	switch d.Get("type").(string) {
+	case opensase.IdPTypeSAML:
+		if !samlSet || oidcSet {
+			return fmt.Errorf("type saml requires a saml block and no oidc block")
+		}
+		saml := expandSAMLConfig(d.Get("saml").([]interface{}))
+		manual := saml.EntityID != "" || saml.SSOURL != "" || saml.Certificate != ""
+		if saml.MetadataURL != "" && manual {
+			return fmt.Errorf("saml: set either metadata_url or entity_id, sso_url and certificate_pem, not both")
+		}
+		if saml.MetadataURL == "" && (saml.EntityID == "" || saml.SSOURL == "" || saml.Certificate == "") {
+			return fmt.Errorf("saml: metadata_url, or all of entity_id, sso_url and certificate_pem, is required")
+		}
+	case opensase.IdPTypeOIDC:
+		if !oidcSet || samlSet {
+			return fmt.Errorf("type oidc requires an oidc block and no saml block")
+		}
+	}
+
+	if len(d.Get("group_mapping").([]interface{})) > 0 && d.Get("group_claim").(string) == "" && d.NewValueKnown("group_claim") {
+		return fmt.Errorf("group_mapping: group_claim must be set to map IdP groups")
+	}
+	return nil
+}
+
+func resourceIdPIntegrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	idp, err := client.API.Identity.IdPs.Create(ctx, &opensase.CreateIdentityProviderParams{
+		Name:             d.Get("name").(string),
+		Type:             d.Get("type").(string),
+		Enabled:          opensase.Bool(d.Get("enabled").(bool)),
+		Domains:          expandStringSet(d.Get("domains").(*schema.Set)),
+		SAML:             expandSAMLConfig(d.Get("saml").([]interface{})),
+		OIDC:             expandOIDCConfig(d.Get("oidc").([]interface{})),
+		AttributeMapping: expandStringMap(d.Get("attribute_mapping").(map[string]interface{})),
+		JITProvisioning:  d.Get("jit_provisioning").(bool),
+		GroupClaim:       d.Get("group_claim").(string),
+		GroupMappings:    expandIdPGroupMappings(d.Get("group_mapping").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating identity provider")
+	}
+
+	d.SetId(idp.ID)
+	return resourceIdPIntegrationRead(ctx, d, m)
+}
+
+func resourceIdPIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	idp, err := client.API.Identity.IdPs.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading identity provider")
+	}
+
+	d.Set("name", idp.Name)
+	d.Set("type", idp.Type)
+	d.Set("enabled", idp.Enabled)
+	d.Set("domains", idp.Domains)
+	d.Set("attribute_mapping", idp.AttributeMapping)
+	d.Set("jit_provisioning", idp.JITProvisioning)
+	d.Set("group_claim", idp.GroupClaim)
+	d.Set("group_mapping", flattenIdPGroupMappings(idp.GroupMappings))
+
+	if saml := idp.SAML; saml != nil {
+		// Values loaded from metadata are not part of the configuration
+		if saml.MetadataURL != "" {
+			saml = &opensase.SAMLConfig{MetadataURL: saml.MetadataURL, SignRequests: saml.SignRequests}
+		}
+		d.Set("saml", []interface{}{map[string]interface{}{
+			"metadata_url":    saml.MetadataURL,
+			"entity_id":       saml.EntityID,
+			"sso_url":         saml.SSOURL,
+			"certificate_pem": saml.Certificate,
+			"sign_requests":   saml.SignRequests,
+		}})
+	}
+	if idp.OIDC != nil {
+		// client_secret is write-only, so keep the configured value
+		secret := ""
+		if prior := expandOIDCConfig(d.Get("oidc").([]interface{})); prior != nil {
+			secret = prior.ClientSecret
+		}
+		d.Set("oidc", []interface{}{map[string]interface{}{
+			"issuer_url":    idp.OIDC.IssuerURL,
+			"client_id":     idp.OIDC.ClientID,
+			"client_secret": secret,
+			"scopes":        idp.OIDC.Scopes,
+		}})
+	}
+
+	if sp := idp.ServiceProvider; sp != nil {
+		d.Set("sp_entity_id", sp.EntityID)
+		d.Set("acs_url", sp.ACSURL)
+		d.Set("sp_metadata_url", sp.MetadataURL)
+		d.Set("redirect_uri", sp.RedirectURI)
+	}
+	return nil
+}
+
+func resourceIdPIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateIdentityProviderParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("domains") {
+		domains := expandStringSet(d.Get("domains").(*schema.Set))
+		params.Domains = &domains
+	}
+	if d.HasChange("saml") {
+		params.SAML = expandSAMLConfig(d.Get("saml").([]interface{}))
+	}
+	if d.HasChange("oidc") {
+		params.OIDC = expandOIDCConfig(d.Get("oidc").([]interface{}))
+		if !d.HasChange("oidc.0.client_secret") {
+			params.OIDC.ClientSecret = ""
+		}
+	}
+	if d.HasChange("attribute_mapping") {
+		mapping := expandStringMap(d.Get("attribute_mapping").(map[string]interface{}))
+		params.AttributeMapping = &mapping
+	}
+	if d.HasChange("jit_provisioning") {
+		params.JITProvisioning = opensase.Bool(d.Get("jit_provisioning").(bool))
+	}
+	if d.HasChange("group_claim") {
+		params.GroupClaim = opensase.String(d.Get("group_claim").(string))
+	}
+	if d.HasChange("group_mapping") {
+		mappings := expandIdPGroupMappings(d.Get("group_mapping").([]interface{}))
+		params.GroupMappings = &mappings
+	}
+
+	if _, err := client.API.Identity.IdPs.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating identity provider")
+	}
+
+	return resourceIdPIntegrationRead(ctx, d, m)
+}
+
+func resourceIdPIntegrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.IdPs.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting identity provider")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandSAMLConfig(raw []interface{}) *opensase.SAMLConfig {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &opensase.SAMLConfig{
+		MetadataURL:  m["metadata_url"].(string),
+		EntityID:     m["entity_id"].(string),
+		SSOURL:       m["sso_url"].(string),
+		Certificate:  m["certificate_pem"].(string),
+		SignRequests: m["sign_requests"].(bool),
+	}
+}
+
+func expandOIDCConfig(raw []interface{}) *opensase.OIDCConfig {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &opensase.OIDCConfig{
+		IssuerURL:    m["issuer_url"].(string),
+		ClientID:     m["client_id"].(string),
+		ClientSecret: m["client_secret"].(string),
+		Scopes:       expandStringList(m["scopes"].([]interface{})),
+	}
+}
+
+func expandIdPGroupMappings(raw []interface{}) []opensase.IdPGroupMapping {
+	mappings := make([]opensase.IdPGroupMapping, 0, len(raw))
+	for _, r := range raw {
+		m := r.(map[string]interface{})
+		mappings = append(mappings, opensase.IdPGroupMapping{
+			IdPGroup: m["idp_group"].(string),
+			GroupID:  m["group_id"].(string),
+		})
+	}
+	return mappings
+}
+
+func flattenIdPGroupMappings(mappings []opensase.IdPGroupMapping) []interface{} {
+	out := make([]interface{}, 0, len(mappings))
+	for _, mapping := range mappings {
+		out = append(out, map[string]interface{}{
+			"idp_group": mapping.IdPGroup,
+			"group_id":  mapping.GroupID,
+		})
+	}
+	return out
+}
+
+func expandStringMap(raw map[string]interface{}) map[string]string {
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		out[k] = v.(string)
+	}
+	return out
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ IPsec Tunnel Resource ============
+
+func resourceIPsecTunnel() *schema.Resource {
+	return &schema.Resource{
+		Description:   "IPsec tunnel between a site and a PoP or another site",
+		CreateContext: resourceIPsecTunnelCreate,
+		ReadContext:   resourceIPsecTunnelRead,
+		UpdateContext: resourceIPsecTunnelUpdate,
+		DeleteContext: resourceIPsecTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"local": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Site end of the tunnel",
+				Elem:        tunnelEndpointSchema(false),
+			},
+			"remote": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "PoP or peer site end of the tunnel",
+				Elem:        tunnelEndpointSchema(true),
+			},
+			"ike_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ikev2",
+				ValidateFunc: validation.StringInSlice([]string{"ikev1", "ikev2"}, false),
+			},
+			"ike_proposal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     cryptoProposalSchema(),
+			},
+			"esp_proposal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     cryptoProposalSchema(),
+			},
+			"ike_lifetime_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"esp_lifetime_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"pre_shared_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"pre_shared_key", "certificate_id"},
+			},
+			"certificate_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "ID of the opensase_certificate used for IKE authentication",
+				ExactlyOneOf: []string{"pre_shared_key", "certificate_id"},
+			},
+			"dpd": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval_seconds": {Type: schema.TypeInt, Optional: true, Default: 10},
+						"timeout_seconds":  {Type: schema.TypeInt, Optional: true, Default: 30},
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "restart",
+							ValidateFunc: validation.StringInSlice([]string{"restart", "clear", "hold"}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tunnel status",
+			},
+			"phase1_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phase2_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func tunnelEndpointSchema(remote bool) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"wan_link": {Type: schema.TypeString, Optional: true},
+		"address":  {Type: schema.TypeString, Optional: true, Computed: true},
+		"identity": {Type: schema.TypeString, Optional: true, Computed: true},
+	}
+	if remote {
+		s["site_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"remote.0.site_id", "remote.0.pop_id"},
+		}
+		s["pop_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"remote.0.site_id", "remote.0.pop_id"},
+		}
+	} else {
+		s["site_id"] = &schema.Schema{Type: schema.TypeString, Required: true}
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func cryptoProposalSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"encryption": {Type: schema.TypeString, Required: true},
+			"integrity":  {Type: schema.TypeString, Optional: true},
+			"dh_group":   {Type: schema.TypeString, Optional: true},
+		},
+	}
+}
+
+func expandTunnelEndpoint(raw []interface{}) opensase.TunnelEndpoint {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.TunnelEndpoint{}
+	}
+	e := raw[0].(map[string]interface{})
+	endpoint := opensase.TunnelEndpoint{
+		SiteID:   e["site_id"].(string),
+		WANLink:  e["wan_link"].(string),
+		Address:  e["address"].(string),
+		Identity: e["identity"].(string),
+	}
+	if pop, ok := e["pop_id"]; ok {
+		endpoint.PoPID = pop.(string)
+	}
+	return endpoint
+}
+
+func flattenTunnelEndpoint(e opensase.TunnelEndpoint, remote bool) []interface{} {
+	m := map[string]interface{}{
+		"site_id":  e.SiteID,
+		"wan_link": e.WANLink,
+		"address":  e.Address,
+		"identity": e.Identity,
+	}
+	if remote {
+		m["pop_id"] = e.PoPID
+	}
+	return []interface{}{m}
+}
+
+func expandCryptoProposals(raw []interface{}) []opensase.CryptoProposal {
+	proposals := make([]opensase.CryptoProposal, 0, len(raw))
+	for _, r := range raw {
+		p := r.(map[string]interface{})
+		proposals = append(proposals, opensase.CryptoProposal{
+			Encryption: p["encryption"].(string),
+			Integrity:  p["integrity"].(string),
+			DHGroup:    p["dh_group"].(string),
+		})
+	}
+	return proposals
+}
+
+func flattenCryptoProposals(proposals []opensase.CryptoProposal) []interface{} {
+	out := make([]interface{}, 0, len(proposals))
+	for _, p := range proposals {
+		out = append(out, map[string]interface{}{
+			"encryption": p.Encryption,
+			"integrity":  p.Integrity,
+			"dh_group":   p.DHGroup,
+		})
+	}
+	return out
+}
+
+func expandDPD(raw []interface{}) *opensase.DeadPeerDetection {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	d := raw[0].(map[string]interface{})
+	return &opensase.DeadPeerDetection{
+		IntervalSeconds: d["interval_seconds"].(int),
+		TimeoutSeconds:  d["timeout_seconds"].(int),
+		Action:          d["action"].(string),
+	}
+}
+
+func flattenDPD(dpd *opensase.DeadPeerDetection) []interface{} {
+	if dpd == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"interval_seconds": dpd.IntervalSeconds,
+		"timeout_seconds":  dpd.TimeoutSeconds,
+		"action":           dpd.Action,
+	}}
+}
+
+func resourceIPsecTunnelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	tunnel, err := client.API.Network.Tunnels.Create(ctx, &opensase.CreateTunnelParams{
+		Name:          d.Get("name").(string),
+		Local:         expandTunnelEndpoint(d.Get("local").([]interface{})),
+		Remote:        expandTunnelEndpoint(d.Get("remote").([]interface{})),
+		IKEVersion:    d.Get("ike_version").(string),
+		IKEProposals:  expandCryptoProposals(d.Get("ike_proposal").([]interface{})),
+		ESPProposals:  expandCryptoProposals(d.Get("esp_proposal").([]interface{})),
+		IKELifetime:   d.Get("ike_lifetime_seconds").(int),
+		ESPLifetime:   d.Get("esp_lifetime_seconds").(int),
+		PreSharedKey:  d.Get("pre_shared_key").(string),
+		CertificateID: d.Get("certificate_id").(string),
+		DPD:           expandDPD(d.Get("dpd").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating IPsec tunnel")
+	}
+
+	d.SetId(tunnel.ID)
+	return resourceIPsecTunnelRead(ctx, d, m)
+}
+
+func resourceIPsecTunnelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	tunnel, err := client.API.Network.Tunnels.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading IPsec tunnel")
+	}
+
+	// The pre-shared key is write-only and is kept as configured
+	d.Set("name", tunnel.Name)
+	d.Set("local", flattenTunnelEndpoint(tunnel.Local, false))
+	d.Set("remote", flattenTunnelEndpoint(tunnel.Remote, true))
+	d.Set("ike_version", tunnel.IKEVersion)
+	d.Set("ike_proposal", flattenCryptoProposals(tunnel.IKEProposals))
+	d.Set("esp_proposal", flattenCryptoProposals(tunnel.ESPProposals))
+	d.Set("ike_lifetime_seconds", tunnel.IKELifetime)
+	d.Set("esp_lifetime_seconds", tunnel.ESPLifetime)
+	d.Set("certificate_id", tunnel.CertificateID)
+	d.Set("dpd", flattenDPD(tunnel.DPD))
+	d.Set("status", tunnel.Status)
+	d.Set("phase1_status", tunnel.Phase1Status)
+	d.Set("phase2_status", tunnel.Phase2Status)
+	return nil
+}
+
+func resourceIPsecTunnelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateTunnelParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("ike_version") {
+		params.IKEVersion = opensase.String(d.Get("ike_version").(string))
+	}
+	if d.HasChange("ike_proposal") {
+		params.IKEProposals = expandCryptoProposals(d.Get("ike_proposal").([]interface{}))
+	}
+	if d.HasChange("esp_proposal") {
+		params.ESPProposals = expandCryptoProposals(d.Get("esp_proposal").([]interface{}))
+	}
+	if d.HasChange("ike_lifetime_seconds") {
+		params.IKELifetime = opensase.Int(d.Get("ike_lifetime_seconds").(int))
+	}
+	if d.HasChange("esp_lifetime_seconds") {
+		params.ESPLifetime = opensase.Int(d.Get("esp_lifetime_seconds").(int))
+	}
+	if d.HasChange("pre_shared_key") {
+		params.PreSharedKey = opensase.String(d.Get("pre_shared_key").(string))
+	}
+	if d.HasChange("certificate_id") {
+		params.CertificateID = opensase.String(d.Get("certificate_id").(string))
+	}
+	if d.HasChange("dpd") {
+		params.DPD = expandDPD(d.Get("dpd").([]interface{}))
+	}
+
+	if _, err := client.API.Network.Tunnels.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating IPsec tunnel")
+	}
+
+	return resourceIPsecTunnelRead(ctx, d, m)
+}
+
+func resourceIPsecTunnelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.Tunnels.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting IPsec tunnel")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ LDAP Server Resource ============
+
+func resourceLDAPServer() *schema.Resource {
+	return &schema.Resource{
+		Description: "External LDAP directory. Servers with the same use are tried in ascending priority; " +
+			"the next one is used when a server does not answer.",
+		CreateContext: resourceLDAPServerCreate,
+		ReadContext:   resourceLDAPServerRead,
+		UpdateContext: resourceLDAPServerUpdate,
+		DeleteContext: resourceLDAPServerDelete,
+		CustomizeDiff: validateLDAPServer,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname or IP address",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Defaults to 636 with ldaps and 389 otherwise",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"security": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  opensase.LDAPSecurityLDAPS,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.LDAPSecurityNone, opensase.LDAPSecurityStartTLS, opensase.LDAPSecurityLDAPS,
+				}, false),
+			},
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM CA certificate the server's certificate is verified against, if not publicly trusted",
+			},
+			"bind_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "DN of the service account used to search for users",
+			},
+			"bind_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Write-only: changes made outside Terraform are not detected.",
+			},
+			"base_dn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Search filter for users, in which {username} is replaced by the name signing in",
+			},
+			"group_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User attribute listing group memberships, e.g. memberOf",
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Failover order among servers with the same use; lower is tried first",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"uses": aaaUsesSchema(),
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func validateLDAPServer(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if filter := d.Get("user_filter").(string); filter != "" && d.NewValueKnown("user_filter") && !strings.Contains(filter, "{username}") {
+		return fmt.Errorf("user_filter: must contain {username}")
+	}
+	if d.Get("ca_certificate").(string) != "" && d.Get("security").(string) == opensase.LDAPSecurityNone {
+		return fmt.Errorf("ca_certificate: requires security starttls or ldaps")
+	}
+	return nil
+}
+
+func resourceLDAPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	server, err := client.API.Identity.LDAP.Create(ctx, &opensase.CreateLDAPServerParams{
+		Name:           d.Get("name").(string),
+		Host:           d.Get("host").(string),
+		Port:           d.Get("port").(int),
+		Security:       d.Get("security").(string),
+		CACertificate:  d.Get("ca_certificate").(string),
+		BindDN:         d.Get("bind_dn").(string),
+		BindPassword:   d.Get("bind_password").(string),
+		BaseDN:         d.Get("base_dn").(string),
+		UserFilter:     d.Get("user_filter").(string),
+		GroupAttribute: d.Get("group_attribute").(string),
+		TimeoutSeconds: d.Get("timeout_seconds").(int),
+		Priority:       d.Get("priority").(int),
+		Uses:           expandStringSet(d.Get("uses").(*schema.Set)),
+		Enabled:        opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating LDAP server")
+	}
+
+	d.SetId(server.ID)
+	return resourceLDAPServerRead(ctx, d, m)
+}
+
+func resourceLDAPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	server, err := client.API.Identity.LDAP.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading LDAP server")
+	}
+
+	d.Set("name", server.Name)
+	d.Set("host", server.Host)
+	d.Set("port", server.Port)
+	d.Set("security", server.Security)
+	d.Set("ca_certificate", server.CACertificate)
+	d.Set("bind_dn", server.BindDN)
+	d.Set("base_dn", server.BaseDN)
+	d.Set("user_filter", server.UserFilter)
+	d.Set("group_attribute", server.GroupAttribute)
+	d.Set("timeout_seconds", server.TimeoutSeconds)
+	d.Set("priority", server.Priority)
+	d.Set("uses", server.Uses)
+	d.Set("enabled", server.Enabled)
+	return nil
+}
+
+func resourceLDAPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateLDAPServerParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("host") {
+		params.Host = opensase.String(d.Get("host").(string))
+	}
+	if d.HasChange("port") {
+		params.Port = opensase.Int(d.Get("port").(int))
+	}
+	if d.HasChange("security") {
+		params.Security = opensase.String(d.Get("security").(string))
+	}
+	if d.HasChange("ca_certificate") {
+		params.CACertificate = opensase.String(d.Get("ca_certificate").(string))
+	}
+	if d.HasChange("bind_dn") {
+		params.BindDN = opensase.String(d.Get("bind_dn").(string))
+	}
+	if d.HasChange("bind_password") {
+		params.BindPassword = opensase.String(d.Get("bind_password").(string))
+	}
+	if d.HasChange("base_dn") {
+		params.BaseDN = opensase.String(d.Get("base_dn").(string))
+	}
+	if d.HasChange("user_filter") {
+		params.UserFilter = opensase.String(d.Get("user_filter").(string))
+	}
+	if d.HasChange("group_attribute") {
+		params.GroupAttribute = opensase.String(d.Get("group_attribute").(string))
+	}
+	if d.HasChange("timeout_seconds") {
+		params.TimeoutSeconds = opensase.Int(d.Get("timeout_seconds").(int))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("uses") {
+		uses := expandStringSet(d.Get("uses").(*schema.Set))
+		params.Uses = &uses
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Identity.LDAP.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating LDAP server")
+	}
+
+	return resourceLDAPServerRead(ctx, d, m)
+}
+
+func resourceLDAPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.LDAP.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting LDAP server")
+	}
+
+	d.SetId("")
+	return nil
+}
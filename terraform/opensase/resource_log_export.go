@@ -0,0 +1,380 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Log Export Resource ============
+
+var logExportDestinations = []string{"syslog", "s3", "splunk_hec", "https"}
+
+func resourceLogExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Forwards logs to a SIEM or archive. Tokens and auth headers are write-only, " +
+			"so changes made outside Terraform are not detected.",
+		CreateContext: resourceLogExportCreate,
+		ReadContext:   resourceLogExportRead,
+		UpdateContext: resourceLogExportUpdate,
+		DeleteContext: resourceLogExportDelete,
+		CustomizeDiff: validateLogExport,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"syslog": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: logExportDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6514,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "tls",
+							ValidateFunc: validation.StringInSlice([]string{"tls", "tcp", "udp"}, false),
+						},
+						"facility": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "local0",
+						},
+						"ca_certificate": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateCertificatePEM,
+							Description:  "PEM CA certificate the collector's TLS certificate must chain to",
+						},
+					},
+				},
+			},
+			"s3": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: logExportDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IAM role the platform assumes to write to the bucket",
+						},
+					},
+				},
+			},
+			"splunk_hec": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: logExportDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"token": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"index": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"https": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: logExportDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"auth_header": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Value of the Authorization header, e.g. \"Bearer ...\"",
+						},
+						"headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  opensase.LogFormatJSON,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.LogFormatJSON, opensase.LogFormatCEF, opensase.LogFormatLEEF,
+				}, false),
+			},
+			"log_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						opensase.LogTypeTraffic, opensase.LogTypeSecurity, opensase.LogTypeDNS,
+						opensase.LogTypeZTNA, opensase.LogTypeAudit,
+					}, false),
+				},
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sites to export logs from. Empty exports logs from every site.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateLogExport rejects formats the destination cannot carry, as Splunk
+// HEC takes JSON events only, and replaces the export when the destination
+// type changes
+func validateLogExport(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	format := d.Get("format").(string)
+	if len(d.Get("splunk_hec").([]interface{})) > 0 && format != opensase.LogFormatJSON {
+		return fmt.Errorf("format: splunk_hec only accepts %q, got %q", opensase.LogFormatJSON, format)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	for _, dest := range logExportDestinations {
+		o, n := d.GetChange(dest)
+		if len(o.([]interface{})) != len(n.([]interface{})) {
+			return d.ForceNew(dest)
+		}
+	}
+	return nil
+}
+
+func resourceLogExportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.CreateLogExportParams{
+		Name:     d.Get("name").(string),
+		Format:   d.Get("format").(string),
+		LogTypes: expandStringSet(d.Get("log_types").(*schema.Set)),
+		SiteIDs:  expandStringSet(d.Get("site_ids").(*schema.Set)),
+		Enabled:  opensase.Bool(d.Get("enabled").(bool)),
+	}
+	params.Syslog, params.S3, params.SplunkHEC, params.HTTPS = expandLogExportDestination(d)
+	for _, dest := range logExportDestinations {
+		if len(d.Get(dest).([]interface{})) > 0 {
+			params.DestinationType = dest
+		}
+	}
+
+	export, err := client.API.Monitoring.LogExports.Create(ctx, params)
+	if err != nil {
+		return apiDiagnostics(err, "Error creating log export")
+	}
+
+	d.SetId(export.ID)
+	return resourceLogExportRead(ctx, d, m)
+}
+
+func resourceLogExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	export, err := client.API.Monitoring.LogExports.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading log export")
+	}
+
+	d.Set("name", export.Name)
+	d.Set("format", export.Format)
+	d.Set("log_types", export.LogTypes)
+	d.Set("site_ids", export.SiteIDs)
+	d.Set("enabled", export.Enabled)
+	d.Set("status", export.Status)
+
+	// Tokens and auth headers are never returned, so they are kept from state
+	if export.Syslog != nil {
+		d.Set("syslog", []interface{}{map[string]interface{}{
+			"host":           export.Syslog.Host,
+			"port":           export.Syslog.Port,
+			"protocol":       export.Syslog.Protocol,
+			"facility":       export.Syslog.Facility,
+			"ca_certificate": export.Syslog.CACertificate,
+		}})
+	}
+	if export.S3 != nil {
+		d.Set("s3", []interface{}{map[string]interface{}{
+			"bucket":   export.S3.Bucket,
+			"prefix":   export.S3.Prefix,
+			"region":   export.S3.Region,
+			"role_arn": export.S3.RoleARN,
+		}})
+	}
+	if export.SplunkHEC != nil {
+		d.Set("splunk_hec", []interface{}{map[string]interface{}{
+			"url":         export.SplunkHEC.URL,
+			"token":       d.Get("splunk_hec.0.token").(string),
+			"index":       export.SplunkHEC.Index,
+			"source_type": export.SplunkHEC.SourceType,
+		}})
+	}
+	if export.HTTPS != nil {
+		d.Set("https", []interface{}{map[string]interface{}{
+			"url":         export.HTTPS.URL,
+			"auth_header": d.Get("https.0.auth_header").(string),
+			"headers":     export.HTTPS.Headers,
+		}})
+	}
+	return nil
+}
+
+func resourceLogExportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateLogExportParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChanges(logExportDestinations...) {
+		params.Syslog, params.S3, params.SplunkHEC, params.HTTPS = expandLogExportDestination(d)
+	}
+	if d.HasChange("format") {
+		params.Format = opensase.String(d.Get("format").(string))
+	}
+	if d.HasChange("log_types") {
+		logTypes := expandStringSet(d.Get("log_types").(*schema.Set))
+		params.LogTypes = &logTypes
+	}
+	if d.HasChange("site_ids") {
+		siteIDs := expandStringSet(d.Get("site_ids").(*schema.Set))
+		params.SiteIDs = &siteIDs
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Monitoring.LogExports.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating log export")
+	}
+
+	return resourceLogExportRead(ctx, d, m)
+}
+
+func resourceLogExportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Monitoring.LogExports.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting log export")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandLogExportDestination(d *schema.ResourceData) (*opensase.SyslogDestination, *opensase.S3LogDestination, *opensase.SplunkHECDestination, *opensase.HTTPSLogDestination) {
+	var (
+		syslog    *opensase.SyslogDestination
+		s3        *opensase.S3LogDestination
+		splunkHEC *opensase.SplunkHECDestination
+		https     *opensase.HTTPSLogDestination
+	)
+	if raw := d.Get("syslog").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		s := raw[0].(map[string]interface{})
+		syslog = &opensase.SyslogDestination{
+			Host:          s["host"].(string),
+			Port:          s["port"].(int),
+			Protocol:      s["protocol"].(string),
+			Facility:      s["facility"].(string),
+			CACertificate: s["ca_certificate"].(string),
+		}
+	}
+	if raw := d.Get("s3").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		s := raw[0].(map[string]interface{})
+		s3 = &opensase.S3LogDestination{
+			Bucket:  s["bucket"].(string),
+			Prefix:  s["prefix"].(string),
+			Region:  s["region"].(string),
+			RoleARN: s["role_arn"].(string),
+		}
+	}
+	if raw := d.Get("splunk_hec").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		s := raw[0].(map[string]interface{})
+		splunkHEC = &opensase.SplunkHECDestination{
+			URL:        s["url"].(string),
+			Token:      s["token"].(string),
+			Index:      s["index"].(string),
+			SourceType: s["source_type"].(string),
+		}
+	}
+	if raw := d.Get("https").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		h := raw[0].(map[string]interface{})
+		https = &opensase.HTTPSLogDestination{
+			URL:        h["url"].(string),
+			AuthHeader: h["auth_header"].(string),
+			Headers:    expandStringMap(h["headers"].(map[string]interface{})),
+		}
+	}
+	return syslog, s3, splunkHEC, https
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Log Retention Resource ============
+
+func resourceLogRetention() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retention period for one data type. Every data type always has a " +
+			"retention setting, so destroying this resource only stops managing it.",
+		CreateContext: resourceLogRetentionApply,
+		ReadContext:   resourceLogRetentionRead,
+		UpdateContext: resourceLogRetentionApply,
+		DeleteContext: resourceLogRetentionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.DataTypeTrafficLogs,
+					opensase.DataTypeFlowRecords,
+					opensase.DataTypeSecurityLogs,
+					opensase.DataTypeAuditLogs,
+					opensase.DataTypeDNSLogs,
+					opensase.DataTypePacketCaptures,
+				}, false),
+				Description: "Data type the retention applies to",
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Days records are kept in hot storage",
+			},
+			"archive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Archive records after the retention period instead of deleting them",
+			},
+			"archive_days": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Days archived records are kept",
+			},
+			"min_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_days": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLogRetentionApply(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	dataType := d.Get("data_type").(string)
+
+	params := &opensase.UpdateRetentionParams{
+		RetentionDays: opensase.Int(d.Get("retention_days").(int)),
+		Archive:       opensase.Bool(d.Get("archive").(bool)),
+	}
+	if v, ok := d.GetOk("archive_days"); ok {
+		params.ArchiveDays = opensase.Int(v.(int))
+	}
+
+	if _, err := client.API.Compliance.Retention.Update(ctx, dataType, params); err != nil {
+		return apiDiagnostics(err, "Error updating retention")
+	}
+
+	d.SetId(dataType)
+	return resourceLogRetentionRead(ctx, d, m)
+}
+
+func resourceLogRetentionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Compliance.Retention.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading retention")
+	}
+
+	d.Set("data_type", policy.DataType)
+	d.Set("retention_days", policy.RetentionDays)
+	d.Set("archive", policy.Archive)
+	d.Set("archive_days", policy.ArchiveDays)
+	d.Set("min_days", policy.MinDays)
+	d.Set("max_days", policy.MaxDays)
+	return nil
+}
+
+func resourceLogRetentionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
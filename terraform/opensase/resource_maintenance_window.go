@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Maintenance Window Resource ============
+
+var maintenanceWeekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		Description: "Scheduled maintenance window. While it is active, alerts of the " +
+			"suppressed types are not raised for its sites and device upgrades may run.",
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		CustomizeDiff: validateMaintenanceWindow,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "RFC 3339 start of the first occurrence. Later occurrences keep its local time in timezone.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"duration_minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(15, 7*24*60),
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "UTC",
+				Description: "IANA time zone, e.g. Europe/London",
+			},
+			"recurrence": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Omit for a one-off window",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								opensase.RecurDaily, opensase.RecurWeekly, opensase.RecurMonthly,
+							}, false),
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Repeat every interval days, weeks or months",
+						},
+						"days_of_week": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(maintenanceWeekdays, false)},
+							Description: "Days of weekly windows, mon through sun",
+						},
+						"day_of_month": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.Any(validation.IntBetween(1, 31), validation.IntInSlice([]int{-1})),
+							Description:  "Day of monthly windows; -1 is the last day of the month",
+						},
+						"until": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressEquivalentTime,
+							Description:      "RFC 3339 time after which the window stops recurring",
+						},
+					},
+				},
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sites the window covers. Empty covers every site.",
+			},
+			"suppressed_alert_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Metrics and event types of alert rules silenced during the window. Empty silences every alert for its sites.",
+			},
+			"active":        {Type: schema.TypeBool, Computed: true},
+			"next_start_at": {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+func validateMaintenanceWindow(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("timezone") {
+		if _, err := time.LoadLocation(d.Get("timezone").(string)); err != nil {
+			return fmt.Errorf("timezone: %w", err)
+		}
+	}
+
+	r := expandMaintenanceRecurrence(d.Get("recurrence").([]interface{}))
+	if r == nil {
+		return nil
+	}
+	switch r.Frequency {
+	case opensase.RecurWeekly:
+		if len(r.DaysOfWeek) == 0 {
+			return fmt.Errorf("recurrence: days_of_week is required for weekly windows")
+		}
+	case opensase.RecurMonthly:
+		if r.DayOfMonth == 0 {
+			return fmt.Errorf("recurrence: day_of_month is required for monthly windows")
+		}
+	}
+	if len(r.DaysOfWeek) > 0 && r.Frequency != opensase.RecurWeekly {
+		return fmt.Errorf("recurrence: days_of_week is only valid for weekly windows")
+	}
+	if r.DayOfMonth != 0 && r.Frequency != opensase.RecurMonthly {
+		return fmt.Errorf("recurrence: day_of_month is only valid for monthly windows")
+	}
+
+	// Occurrences of a window must not overlap
+	if r.Frequency == opensase.RecurDaily && d.Get("duration_minutes").(int) >= r.Interval*24*60 {
+		return fmt.Errorf("duration_minutes: must be shorter than the %d day recurrence", r.Interval)
+	}
+	return nil
+}
+
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	startsAt, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	window, err := client.API.Monitoring.MaintenanceWindows.Create(ctx, &opensase.CreateMaintenanceWindowParams{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		StartsAt:             startsAt,
+		DurationMinutes:      d.Get("duration_minutes").(int),
+		Timezone:             d.Get("timezone").(string),
+		Recurrence:           expandMaintenanceRecurrence(d.Get("recurrence").([]interface{})),
+		SiteIDs:              expandStringSet(d.Get("site_ids").(*schema.Set)),
+		SuppressedAlertTypes: expandStringSet(d.Get("suppressed_alert_types").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating maintenance window")
+	}
+
+	d.SetId(window.ID)
+	return resourceMaintenanceWindowRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	window, err := client.API.Monitoring.MaintenanceWindows.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading maintenance window")
+	}
+
+	d.Set("name", window.Name)
+	d.Set("description", window.Description)
+	d.Set("start_time", window.StartsAt.Format(time.RFC3339))
+	d.Set("duration_minutes", window.DurationMinutes)
+	d.Set("timezone", window.Timezone)
+	d.Set("recurrence", flattenMaintenanceRecurrence(window.Recurrence))
+	d.Set("site_ids", window.SiteIDs)
+	d.Set("suppressed_alert_types", window.SuppressedAlertTypes)
+	d.Set("active", window.Active)
+	if window.NextStartAt != nil {
+		d.Set("next_start_at", window.NextStartAt.Format(time.RFC3339))
+	} else {
+		d.Set("next_start_at", "")
+	}
+	return nil
+}
+
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateMaintenanceWindowParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("start_time") {
+		startsAt, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+		params.StartsAt = &startsAt
+	}
+	if d.HasChange("duration_minutes") {
+		params.DurationMinutes = opensase.Int(d.Get("duration_minutes").(int))
+	}
+	if d.HasChange("timezone") {
+		params.Timezone = opensase.String(d.Get("timezone").(string))
+	}
+	if d.HasChange("recurrence") {
+		params.Recurrence = expandMaintenanceRecurrence(d.Get("recurrence").([]interface{}))
+		params.ClearRecurrence = params.Recurrence == nil
+	}
+	if d.HasChange("site_ids") {
+		ids := expandStringSet(d.Get("site_ids").(*schema.Set))
+		params.SiteIDs = &ids
+	}
+	if d.HasChange("suppressed_alert_types") {
+		types := expandStringSet(d.Get("suppressed_alert_types").(*schema.Set))
+		params.SuppressedAlertTypes = &types
+	}
+
+	if _, err := client.API.Monitoring.MaintenanceWindows.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating maintenance window")
+	}
+
+	return resourceMaintenanceWindowRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Monitoring.MaintenanceWindows.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting maintenance window")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandMaintenanceRecurrence(raw []interface{}) *opensase.MaintenanceRecurrence {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+
+	r := &opensase.MaintenanceRecurrence{
+		Frequency:  m["frequency"].(string),
+		Interval:   m["interval"].(int),
+		DaysOfWeek: expandStringSet(m["days_of_week"].(*schema.Set)),
+		DayOfMonth: m["day_of_month"].(int),
+	}
+	if v := m["until"].(string); v != "" {
+		until, _ := time.Parse(time.RFC3339, v)
+		r.Until = &until
+	}
+	return r
+}
+
+func flattenMaintenanceRecurrence(r *opensase.MaintenanceRecurrence) []interface{} {
+	if r == nil {
+		return nil
+	}
+
+	until := ""
+	if r.Until != nil {
+		until = r.Until.Format(time.RFC3339)
+	}
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	return []interface{}{map[string]interface{}{
+		"frequency":    r.Frequency,
+		"interval":     interval,
+		"days_of_week": r.DaysOfWeek,
+		"day_of_month": r.DayOfMonth,
+		"until":        until,
+	}}
+}
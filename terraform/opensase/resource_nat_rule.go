@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ NAT Rule Resource ============
+
+func resourceNATRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "NAT rule on a site. Rules are evaluated in ascending priority within " +
+			"the site; as with firewall rules, priorities are stored exactly as given, " +
+			"so leaving gaps lets rules be inserted without touching the rest.",
+		CreateContext: resourceNATRuleCreate,
+		ReadContext:   resourceNATRuleRead,
+		UpdateContext: resourceNATRuleUpdate,
+		DeleteContext: resourceNATRuleDelete,
+		CustomizeDiff: validateNATRule,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("NAT rule"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "source (outbound SNAT/PAT), destination (port forwarding) or static (bidirectional 1:1)",
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.NATTypeSource,
+					opensase.NATTypeDestination,
+					opensase.NATTypeStatic,
+					opensase.NATTypePortForward,
+				}, false),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Evaluation position; unique within the site",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"wan_link_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Restrict the rule to traffic egressing or arriving on this WAN link",
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_addresses":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"destination_addresses": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "any"}, false),
+						},
+						"destination_ports": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					},
+				},
+			},
+			"translation": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "interface (WAN link address), pool (PAT pool) or static (single address)",
+							ValidateFunc: validation.StringInSlice([]string{
+								opensase.NATTranslateInterface,
+								opensase.NATTranslatePool,
+								opensase.NATTranslateStatic,
+							}, false),
+						},
+						"addresses": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "PAT pool addresses or ranges for pool mode, or the single mapped address for static mode",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Translated destination port; destination rules only",
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandNATMatch(raw []interface{}) opensase.NATMatch {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.NATMatch{}
+	}
+	m := raw[0].(map[string]interface{})
+	return opensase.NATMatch{
+		SourceAddresses:      expandStringSet(m["source_addresses"].(*schema.Set)),
+		DestinationAddresses: expandStringSet(m["destination_addresses"].(*schema.Set)),
+		Protocol:             m["protocol"].(string),
+		DestinationPorts:     expandStringSet(m["destination_ports"].(*schema.Set)),
+	}
+}
+
+func flattenNATMatch(m opensase.NATMatch) []interface{} {
+	if len(m.SourceAddresses) == 0 && len(m.DestinationAddresses) == 0 && m.Protocol == "" && len(m.DestinationPorts) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"source_addresses":      m.SourceAddresses,
+		"destination_addresses": m.DestinationAddresses,
+		"protocol":              m.Protocol,
+		"destination_ports":     m.DestinationPorts,
+	}}
+}
+
+func expandNATTranslation(raw []interface{}) opensase.NATTranslation {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.NATTranslation{}
+	}
+	t := raw[0].(map[string]interface{})
+	return opensase.NATTranslation{
+		Mode:      t["mode"].(string),
+		Addresses: expandStringList(t["addresses"].([]interface{})),
+		Port:      t["port"].(int),
+	}
+}
+
+func flattenNATTranslation(t opensase.NATTranslation) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"mode":      t.Mode,
+		"addresses": t.Addresses,
+		"port":      t.Port,
+	}}
+}
+
+// validateNATRule checks that the translation fits the rule type: source
+// rules translate to the interface address or a PAT pool, destination and
+// static rules map to exactly one address, only destination and
+// port-forwarding rules may rewrite the port, and port forwards name their
+// protocol and external ports.
+func validateNATRule(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("translation") {
+		return nil
+	}
+	ruleType := d.Get("type").(string)
+	t := expandNATTranslation(d.Get("translation").([]interface{}))
+
+	switch ruleType {
+	case opensase.NATTypeSource:
+		if t.Mode == opensase.NATTranslateStatic {
+			return fmt.Errorf("translation.mode: source rules use %q or %q; use type %q for 1:1 mappings", opensase.NATTranslateInterface, opensase.NATTranslatePool, opensase.NATTypeStatic)
+		}
+	default:
+		if t.Mode != opensase.NATTranslateStatic {
+			return fmt.Errorf("translation.mode: %s rules require %q", ruleType, opensase.NATTranslateStatic)
+		}
+	}
+
+	switch t.Mode {
+	case opensase.NATTranslateInterface:
+		if len(t.Addresses) > 0 {
+			return fmt.Errorf("translation.addresses: not allowed with mode %q", t.Mode)
+		}
+	case opensase.NATTranslatePool:
+		if len(t.Addresses) == 0 {
+			return fmt.Errorf("translation.addresses: mode %q requires at least one address", t.Mode)
+		}
+	case opensase.NATTranslateStatic:
+		if len(t.Addresses) != 1 {
+			return fmt.Errorf("translation.addresses: mode %q requires exactly one address", t.Mode)
+		}
+	}
+
+	if t.Port != 0 && ruleType != opensase.NATTypeDestination && ruleType != opensase.NATTypePortForward {
+		return fmt.Errorf("translation.port: only supported on %s and %s rules", opensase.NATTypeDestination, opensase.NATTypePortForward)
+	}
+
+	if ruleType == opensase.NATTypePortForward && d.NewValueKnown("match") {
+		match := expandNATMatch(d.Get("match").([]interface{}))
+		if match.Protocol != "tcp" && match.Protocol != "udp" {
+			return fmt.Errorf("match.protocol: %s rules require tcp or udp", ruleType)
+		}
+		if len(match.DestinationPorts) == 0 {
+			return fmt.Errorf("match.destination_ports: %s rules require the external ports", ruleType)
+		}
+	}
+	return nil
+}
+
+func resourceNATRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	rule, err := client.API.Network.NAT.Create(ctx, siteID, &opensase.CreateNATRuleParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		Priority:    d.Get("priority").(int),
+		Enabled:     opensase.Bool(d.Get("enabled").(bool)),
+		WANLinkID:   d.Get("wan_link_id").(string),
+		Match:       expandNATMatch(d.Get("match").([]interface{})),
+		Translation: expandNATTranslation(d.Get("translation").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating NAT rule")
+	}
+
+	d.SetId(siteID + "/" + rule.ID)
+	return resourceNATRuleRead(ctx, d, m)
+}
+
+func resourceNATRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, ruleID, err := parseSiteScopedID(d.Id(), "NAT rule")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := client.API.Network.NAT.Get(ctx, siteID, ruleID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading NAT rule")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("name", rule.Name)
+	d.Set("description", rule.Description)
+	d.Set("type", rule.Type)
+	d.Set("priority", rule.Priority)
+	d.Set("enabled", rule.Enabled)
+	d.Set("wan_link_id", rule.WANLinkID)
+	d.Set("match", flattenNATMatch(rule.Match))
+	d.Set("translation", flattenNATTranslation(rule.Translation))
+	return nil
+}
+
+func resourceNATRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, ruleID, err := parseSiteScopedID(d.Id(), "NAT rule")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateNATRuleParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("wan_link_id") {
+		params.WANLinkID = opensase.String(d.Get("wan_link_id").(string))
+	}
+	if d.HasChange("match") {
+		match := expandNATMatch(d.Get("match").([]interface{}))
+		params.Match = &match
+	}
+	if d.HasChange("translation") {
+		translation := expandNATTranslation(d.Get("translation").([]interface{}))
+		params.Translation = &translation
+	}
+
+	if _, err := client.API.Network.NAT.Update(ctx, siteID, ruleID, params); err != nil {
+		return apiDiagnostics(err, "Error updating NAT rule")
+	}
+
+	return resourceNATRuleRead(ctx, d, m)
+}
+
+func resourceNATRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, ruleID, err := parseSiteScopedID(d.Id(), "NAT rule")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.NAT.Delete(ctx, siteID, ruleID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting NAT rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Network Segment Resource ============
+
+func resourceNetworkSegment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Micro-segmentation segment. Traffic between segments is denied " +
+			"unless an opensase_segment_policy allows it.",
+		CreateContext: resourceNetworkSegmentCreate,
+		ReadContext:   resourceNetworkSegmentRead,
+		UpdateContext: resourceNetworkSegmentUpdate,
+		DeleteContext: resourceNetworkSegmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"vlan_id", "vni"},
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "VLAN carrying the segment at sites",
+			},
+			"vni": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"vlan_id", "vni"},
+				ValidateFunc: validation.IntBetween(1, 16777215),
+				Description:  "VXLAN network identifier carrying the segment across the overlay",
+			},
+			"cidrs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+			},
+			"trust_level": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.SegmentTrustRestricted, opensase.SegmentTrustInternal,
+					opensase.SegmentTrustUntrusted, opensase.SegmentTrustQuarantine,
+				}, false),
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sites the segment extends to. Empty extends it to every site.",
+			},
+		},
+	}
+}
+
+func resourceNetworkSegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	segment, err := client.API.Network.Segments.Create(ctx, &opensase.CreateSegmentParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		VLANID:      d.Get("vlan_id").(int),
+		VNI:         d.Get("vni").(int),
+		CIDRs:       expandStringSet(d.Get("cidrs").(*schema.Set)),
+		TrustLevel:  d.Get("trust_level").(string),
+		SiteIDs:     expandStringSet(d.Get("site_ids").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating network segment")
+	}
+
+	d.SetId(segment.ID)
+	return resourceNetworkSegmentRead(ctx, d, m)
+}
+
+func resourceNetworkSegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	segment, err := client.API.Network.Segments.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading network segment")
+	}
+
+	d.Set("name", segment.Name)
+	d.Set("description", segment.Description)
+	d.Set("vlan_id", segment.VLANID)
+	d.Set("vni", segment.VNI)
+	d.Set("cidrs", segment.CIDRs)
+	d.Set("trust_level", segment.TrustLevel)
+	d.Set("site_ids", segment.SiteIDs)
+	return nil
+}
+
+func resourceNetworkSegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateSegmentParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("vlan_id") {
+		params.VLANID = opensase.Int(d.Get("vlan_id").(int))
+	}
+	if d.HasChange("vni") {
+		params.VNI = opensase.Int(d.Get("vni").(int))
+	}
+	if d.HasChange("cidrs") {
+		cidrs := expandStringSet(d.Get("cidrs").(*schema.Set))
+		params.CIDRs = &cidrs
+	}
+	if d.HasChange("trust_level") {
+		params.TrustLevel = opensase.String(d.Get("trust_level").(string))
+	}
+	if d.HasChange("site_ids") {
+		siteIDs := expandStringSet(d.Get("site_ids").(*schema.Set))
+		params.SiteIDs = &siteIDs
+	}
+
+	if _, err := client.API.Network.Segments.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating network segment")
+	}
+
+	return resourceNetworkSegmentRead(ctx, d, m)
+}
+
+func resourceNetworkSegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.Segments.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting network segment")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Notification Channel Resource ============
+
+var notificationChannelBlocks = []string{"email", "slack", "pagerduty", "webhook"}
+
+func resourceNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		Description: "Destination opensase_alert_rule resources deliver alerts to. " +
+			"Secrets are write-only, so changes made outside Terraform are not detected.",
+		CreateContext: resourceNotificationChannelCreate,
+		ReadContext:   resourceNotificationChannelRead,
+		UpdateContext: resourceNotificationChannelUpdate,
+		DeleteContext: resourceNotificationChannelDelete,
+		CustomizeDiff: validateNotificationChannel,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.ChannelEmail, opensase.ChannelSlack, opensase.ChannelPagerDuty, opensase.ChannelWebhook,
+				}, false),
+			},
+			"email": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: notificationChannelBlocks,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"slack": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: notificationChannelBlocks,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"webhook_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"channel": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"pagerduty": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: notificationChannelBlocks,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routing_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Events API v2 integration key of the PagerDuty service",
+						},
+					},
+				},
+			},
+			"webhook": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: notificationChannelBlocks,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"secret": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Signs deliveries like API webhooks",
+						},
+						"headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// validateNotificationChannel requires the config block matching type
+func validateNotificationChannel(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	channelType := d.Get("type").(string)
+	if channelType == "" {
+		return nil
+	}
+	if len(d.Get(channelType).([]interface{})) == 0 {
+		return fmt.Errorf("%s: required for type %q", channelType, channelType)
+	}
+	return nil
+}
+
+func resourceNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.CreateNotificationChannelParams{
+		Name:    d.Get("name").(string),
+		Type:    d.Get("type").(string),
+		Enabled: opensase.Bool(d.Get("enabled").(bool)),
+	}
+	params.Email, params.Slack, params.PagerDuty, params.Webhook = expandNotificationChannelConfig(d)
+
+	channel, err := client.API.Monitoring.Alerts.CreateChannel(ctx, params)
+	if err != nil {
+		return apiDiagnostics(err, "Error creating notification channel")
+	}
+
+	d.SetId(channel.ID)
+	return resourceNotificationChannelRead(ctx, d, m)
+}
+
+func resourceNotificationChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	channel, err := client.API.Monitoring.Alerts.GetChannel(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading notification channel")
+	}
+
+	d.Set("name", channel.Name)
+	d.Set("type", channel.Type)
+	d.Set("enabled", channel.Enabled)
+
+	// Secrets are never returned, so they are kept from state
+	if channel.Email != nil {
+		d.Set("email", []interface{}{map[string]interface{}{
+			"addresses": channel.Email.Addresses,
+		}})
+	}
+	if channel.Slack != nil {
+		d.Set("slack", []interface{}{map[string]interface{}{
+			"webhook_url": d.Get("slack.0.webhook_url").(string),
+			"channel":     channel.Slack.Channel,
+		}})
+	}
+	if channel.PagerDuty != nil {
+		d.Set("pagerduty", []interface{}{map[string]interface{}{
+			"routing_key": d.Get("pagerduty.0.routing_key").(string),
+		}})
+	}
+	if channel.Webhook != nil {
+		d.Set("webhook", []interface{}{map[string]interface{}{
+			"url":     channel.Webhook.URL,
+			"secret":  d.Get("webhook.0.secret").(string),
+			"headers": channel.Webhook.Headers,
+		}})
+	}
+	return nil
+}
+
+func resourceNotificationChannelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateNotificationChannelParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChanges(notificationChannelBlocks...) {
+		params.Email, params.Slack, params.PagerDuty, params.Webhook = expandNotificationChannelConfig(d)
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Monitoring.Alerts.UpdateChannel(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating notification channel")
+	}
+
+	return resourceNotificationChannelRead(ctx, d, m)
+}
+
+func resourceNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Monitoring.Alerts.DeleteChannel(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting notification channel")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandNotificationChannelConfig(d *schema.ResourceData) (*opensase.EmailChannelConfig, *opensase.SlackChannelConfig, *opensase.PagerDutyChannelConfig, *opensase.WebhookChannelConfig) {
+	var (
+		email     *opensase.EmailChannelConfig
+		slack     *opensase.SlackChannelConfig
+		pagerduty *opensase.PagerDutyChannelConfig
+		webhook   *opensase.WebhookChannelConfig
+	)
+	if raw := d.Get("email").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		e := raw[0].(map[string]interface{})
+		email = &opensase.EmailChannelConfig{Addresses: expandStringSet(e["addresses"].(*schema.Set))}
+	}
+	if raw := d.Get("slack").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		s := raw[0].(map[string]interface{})
+		slack = &opensase.SlackChannelConfig{
+			WebhookURL: s["webhook_url"].(string),
+			Channel:    s["channel"].(string),
+		}
+	}
+	if raw := d.Get("pagerduty").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		p := raw[0].(map[string]interface{})
+		pagerduty = &opensase.PagerDutyChannelConfig{RoutingKey: p["routing_key"].(string)}
+	}
+	if raw := d.Get("webhook").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		w := raw[0].(map[string]interface{})
+		webhook = &opensase.WebhookChannelConfig{
+			URL:     w["url"].(string),
+			Secret:  w["secret"].(string),
+			Headers: expandStringMap(w["headers"].(map[string]interface{})),
+		}
+	}
+	return email, slack, pagerduty, webhook
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ QoS Profile Resource ============
+
+func resourceQoSProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "SD-WAN QoS profile dividing WAN link bandwidth between traffic classes",
+		CreateContext: resourceQoSProfileCreate,
+		ReadContext:   resourceQoSProfileRead,
+		UpdateContext: resourceQoSProfileUpdate,
+		DeleteContext: resourceQoSProfileDelete,
+		CustomizeDiff: validateQoSProfile,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"class": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Traffic classes; a class without match receives all unmatched traffic",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Queue service order; lower values are served first",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"applications": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"match_dscp": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 63),
+							},
+						},
+						"dscp_marking": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							Description:  "DSCP value written to matching packets; -1 leaves the marking unchanged",
+							ValidateFunc: validation.IntBetween(-1, 63),
+						},
+						"guaranteed_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"max_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
+			"link_shaping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "WAN links the profile is applied to and the rates they are shaped to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"wan_link_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"upstream_mbps": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"downstream_mbps": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Ingress shaping rate; ingress is not shaped when unset",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandQoSClasses(raw []interface{}) []opensase.QoSClass {
+	classes := make([]opensase.QoSClass, 0, len(raw))
+	for _, v := range raw {
+		c := v.(map[string]interface{})
+		class := opensase.QoSClass{
+			Name:              c["name"].(string),
+			Priority:          c["priority"].(int),
+			Match:             opensase.TrafficMatch{Applications: expandStringSet(c["applications"].(*schema.Set))},
+			GuaranteedPercent: c["guaranteed_percent"].(int),
+			MaxPercent:        c["max_percent"].(int),
+		}
+		for _, d := range c["match_dscp"].(*schema.Set).List() {
+			class.Match.DSCP = append(class.Match.DSCP, d.(int))
+		}
+		if marking := c["dscp_marking"].(int); marking >= 0 {
+			class.DSCPMarking = opensase.Int(marking)
+		}
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+func flattenQoSClasses(classes []opensase.QoSClass) []interface{} {
+	out := make([]interface{}, 0, len(classes))
+	for _, c := range classes {
+		marking := -1
+		if c.DSCPMarking != nil {
+			marking = *c.DSCPMarking
+		}
+		out = append(out, map[string]interface{}{
+			"name":               c.Name,
+			"priority":           c.Priority,
+			"applications":       c.Match.Applications,
+			"match_dscp":         c.Match.DSCP,
+			"dscp_marking":       marking,
+			"guaranteed_percent": c.GuaranteedPercent,
+			"max_percent":        c.MaxPercent,
+		})
+	}
+	return out
+}
+
+func expandQoSLinkShaping(raw []interface{}) []opensase.QoSLinkShaping {
+	shaping := make([]opensase.QoSLinkShaping, 0, len(raw))
+	for _, v := range raw {
+		s := v.(map[string]interface{})
+		shaping = append(shaping, opensase.QoSLinkShaping{
+			SiteID:         s["site_id"].(string),
+			WANLinkID:      s["wan_link_id"].(string),
+			UpstreamMbps:   s["upstream_mbps"].(int),
+			DownstreamMbps: s["downstream_mbps"].(int),
+		})
+	}
+	return shaping
+}
+
+func flattenQoSLinkShaping(shaping []opensase.QoSLinkShaping) []interface{} {
+	out := make([]interface{}, 0, len(shaping))
+	for _, s := range shaping {
+		out = append(out, map[string]interface{}{
+			"site_id":         s.SiteID,
+			"wan_link_id":     s.WANLinkID,
+			"upstream_mbps":   s.UpstreamMbps,
+			"downstream_mbps": s.DownstreamMbps,
+		})
+	}
+	return out
+}
+
+// validateQoSProfile checks the bandwidth split before the API does:
+// class names are unique, each guarantee fits under the class maximum, the
+// guarantees together do not exceed the link, and a link is shaped once.
+func validateQoSProfile(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("class") {
+		names := map[string]bool{}
+		total := 0
+		for _, c := range expandQoSClasses(d.Get("class").([]interface{})) {
+			if names[c.Name] {
+				return fmt.Errorf("class %q: duplicate class name", c.Name)
+			}
+			names[c.Name] = true
+			if c.GuaranteedPercent > c.MaxPercent {
+				return fmt.Errorf("class %q: guaranteed_percent (%d) exceeds max_percent (%d)", c.Name, c.GuaranteedPercent, c.MaxPercent)
+			}
+			total += c.GuaranteedPercent
+		}
+		if total > 100 {
+			return fmt.Errorf("class: guaranteed_percent across classes adds up to %d, more than 100", total)
+		}
+	}
+
+	if d.NewValueKnown("link_shaping") {
+		links := map[string]bool{}
+		for _, s := range expandQoSLinkShaping(d.Get("link_shaping").([]interface{})) {
+			key := s.SiteID + "/" + s.WANLinkID
+			if links[key] {
+				return fmt.Errorf("link_shaping: WAN link %s is listed more than once", key)
+			}
+			links[key] = true
+		}
+	}
+	return nil
+}
+
+func resourceQoSProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Network.QoS.Create(ctx, &opensase.CreateQoSProfileParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Classes:     expandQoSClasses(d.Get("class").([]interface{})),
+		LinkShaping: expandQoSLinkShaping(d.Get("link_shaping").([]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating QoS profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceQoSProfileRead(ctx, d, m)
+}
+
+func resourceQoSProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Network.QoS.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading QoS profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("class", flattenQoSClasses(profile.Classes))
+	d.Set("link_shaping", flattenQoSLinkShaping(profile.LinkShaping))
+	return nil
+}
+
+func resourceQoSProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateQoSProfileParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("class") {
+		classes := expandQoSClasses(d.Get("class").([]interface{}))
+		params.Classes = &classes
+	}
+	if d.HasChange("link_shaping") {
+		// An empty list removes the profile from every link, so it must still be sent
+		shaping := expandQoSLinkShaping(d.Get("link_shaping").([]interface{}))
+		params.LinkShaping = &shaping
+	}
+
+	if _, err := client.API.Network.QoS.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating QoS profile")
+	}
+
+	return resourceQoSProfileRead(ctx, d, m)
+}
+
+func resourceQoSProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.QoS.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting QoS profile")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ RADIUS Server Resource ============
+
+// aaaUsesSchema is the uses attribute shared by the RADIUS and LDAP server
+// resources
+func aaaUsesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: "What the server authenticates: dot1x (LAN and Wi-Fi clients) and/or admin (administrator sign-in)",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{opensase.AAAUseDot1X, opensase.AAAUseAdmin}, false),
+		},
+	}
+}
+
+func resourceRADIUSServer() *schema.Resource {
+	return &schema.Resource{
+		Description: "External RADIUS server. Servers with the same use are tried in ascending priority; " +
+			"the next one is used when a server does not answer.",
+		CreateContext: resourceRADIUSServerCreate,
+		ReadContext:   resourceRADIUSServerRead,
+		UpdateContext: resourceRADIUSServerUpdate,
+		DeleteContext: resourceRADIUSServerDelete,
+		CustomizeDiff: validateRADIUSServer,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname or IP address",
+			},
+			"shared_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Write-only: changes made outside Terraform are not detected.",
+			},
+			"auth_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1812,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"accounting_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1813,
+				Description:  "0 disables accounting",
+				ValidateFunc: validation.IsPortNumberOrZero,
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "Times an unanswered request is repeated before failing over",
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Failover order among servers with the same use; lower is tried first",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"uses": aaaUsesSchema(),
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func validateRADIUSServer(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("auth_port").(int) == d.Get("accounting_port").(int) {
+		return fmt.Errorf("accounting_port: must differ from auth_port")
+	}
+	return nil
+}
+
+func resourceRADIUSServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	server, err := client.API.Identity.RADIUS.Create(ctx, &opensase.CreateRADIUSServerParams{
+		Name:           d.Get("name").(string),
+		Host:           d.Get("host").(string),
+		SharedSecret:   d.Get("shared_secret").(string),
+		AuthPort:       d.Get("auth_port").(int),
+		AccountingPort: opensase.Int(d.Get("accounting_port").(int)),
+		TimeoutSeconds: d.Get("timeout_seconds").(int),
+		Retries:        opensase.Int(d.Get("retries").(int)),
+		Priority:       d.Get("priority").(int),
+		Uses:           expandStringSet(d.Get("uses").(*schema.Set)),
+		Enabled:        opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating RADIUS server")
+	}
+
+	d.SetId(server.ID)
+	return resourceRADIUSServerRead(ctx, d, m)
+}
+
+func resourceRADIUSServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	server, err := client.API.Identity.RADIUS.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading RADIUS server")
+	}
+
+	d.Set("name", server.Name)
+	d.Set("host", server.Host)
+	d.Set("auth_port", server.AuthPort)
+	d.Set("accounting_port", server.AccountingPort)
+	d.Set("timeout_seconds", server.TimeoutSeconds)
+	d.Set("retries", server.Retries)
+	d.Set("priority", server.Priority)
+	d.Set("uses", server.Uses)
+	d.Set("enabled", server.Enabled)
+	return nil
+}
+
+func resourceRADIUSServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateRADIUSServerParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("host") {
+		params.Host = opensase.String(d.Get("host").(string))
+	}
+	if d.HasChange("shared_secret") {
+		params.SharedSecret = opensase.String(d.Get("shared_secret").(string))
+	}
+	if d.HasChange("auth_port") {
+		params.AuthPort = opensase.Int(d.Get("auth_port").(int))
+	}
+	if d.HasChange("accounting_port") {
+		params.AccountingPort = opensase.Int(d.Get("accounting_port").(int))
+	}
+	if d.HasChange("timeout_seconds") {
+		params.TimeoutSeconds = opensase.Int(d.Get("timeout_seconds").(int))
+	}
+	if d.HasChange("retries") {
+		params.Retries = opensase.Int(d.Get("retries").(int))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("uses") {
+		uses := expandStringSet(d.Get("uses").(*schema.Set))
+		params.Uses = &uses
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Identity.RADIUS.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating RADIUS server")
+	}
+
+	return resourceRADIUSServerRead(ctx, d, m)
+}
+
+func resourceRADIUSServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.RADIUS.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting RADIUS server")
+	}
+
+	d.SetId("")
+	return nil
+}
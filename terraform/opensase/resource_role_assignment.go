@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Role Assignment Resource ============
+
+func resourceRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Grants an admin role to a user or group, optionally scoped to sites. Any change replaces the assignment.",
+		CreateContext: resourceRoleAssignmentCreate,
+		ReadContext:   resourceRoleAssignmentRead,
+		DeleteContext: resourceRoleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of an opensase_admin_role or a built-in role",
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{opensase.PrincipalUser, opensase.PrincipalGroup}, false),
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sites the role applies to. Empty grants the role tenant-wide.",
+			},
+		},
+	}
+}
+
+func resourceRoleAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	assignment, err := client.API.Identity.Roles.Assign(ctx, &opensase.CreateRoleAssignmentParams{
+		RoleID:        d.Get("role_id").(string),
+		PrincipalType: d.Get("principal_type").(string),
+		PrincipalID:   d.Get("principal_id").(string),
+		SiteIDs:       expandStringSet(d.Get("site_ids").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating role assignment")
+	}
+
+	d.SetId(assignment.ID)
+	return resourceRoleAssignmentRead(ctx, d, m)
+}
+
+func resourceRoleAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	assignment, err := client.API.Identity.Roles.GetAssignment(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading role assignment")
+	}
+
+	d.Set("role_id", assignment.RoleID)
+	d.Set("principal_type", assignment.PrincipalType)
+	d.Set("principal_id", assignment.PrincipalID)
+	d.Set("site_ids", assignment.SiteIDs)
+	return nil
+}
+
+func resourceRoleAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.Roles.Unassign(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting role assignment")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Schedule Object Resource ============
+
+var clockTimePattern = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+
+func resourceScheduleObject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Named schedule that limits when firewall rules match, e.g. business hours. " +
+			"The API refuses to delete schedules that are still referenced.",
+		CreateContext: resourceScheduleObjectCreate,
+		ReadContext:   resourceScheduleObjectRead,
+		UpdateContext: resourceScheduleObjectUpdate,
+		DeleteContext: resourceScheduleObjectDelete,
+		CustomizeDiff: validateScheduleObject,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.ScheduleRecurring, opensase.ScheduleOneTime,
+				}, false),
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "UTC",
+				Description: "IANA time zone the schedule's times are in",
+			},
+			"range": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Weekly time ranges of a recurring schedule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(maintenanceWeekdays, false)},
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(clockTimePattern, "must be HH:MM"),
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "HH:MM; a time before start_time continues past midnight",
+							ValidateFunc: validation.StringMatch(clockTimePattern, "must be HH:MM"),
+						},
+					},
+				},
+			},
+			"starts_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "RFC 3339 start of a one-time schedule",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"ends_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "RFC 3339 end of a one-time schedule",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"reference_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rules referencing the schedule",
+			},
+		},
+	}
+}
+
+// validateScheduleObject checks that ranges or a start and end are set to
+// match the type
+func validateScheduleObject(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("timezone") {
+		if _, err := time.LoadLocation(d.Get("timezone").(string)); err != nil {
+			return fmt.Errorf("timezone: %w", err)
+		}
+	}
+
+	ranges := len(d.Get("range").([]interface{}))
+	startsAt := d.Get("starts_at").(string)
+	endsAt := d.Get("ends_at").(string)
+
+	if d.Get("type").(string) == opensase.ScheduleRecurring {
+		if startsAt != "" || endsAt != "" {
+			return fmt.Errorf("starts_at and ends_at can only be set on one_time schedules")
+		}
+		if ranges == 0 && d.NewValueKnown("range") {
+			return fmt.Errorf("a recurring schedule needs at least one range")
+		}
+		return nil
+	}
+
+	if ranges > 0 {
+		return fmt.Errorf("range can only be set on recurring schedules")
+	}
+	if !d.NewValueKnown("starts_at") || !d.NewValueKnown("ends_at") {
+		return nil
+	}
+	start, err1 := time.Parse(time.RFC3339, startsAt)
+	end, err2 := time.Parse(time.RFC3339, endsAt)
+	if err1 != nil || err2 != nil {
+		return fmt.Errorf("a one_time schedule needs starts_at and ends_at")
+	}
+	if !end.After(start) {
+		return fmt.Errorf("ends_at: must be after starts_at")
+	}
+	return nil
+}
+
+func resourceScheduleObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	schedule, err := client.API.Security.Objects.Schedules.Create(ctx, &opensase.CreateScheduleObjectParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		Timezone:    d.Get("timezone").(string),
+		Ranges:      expandScheduleRanges(d.Get("range").([]interface{})),
+		StartsAt:    optionalTime(d.Get("starts_at").(string)),
+		EndsAt:      optionalTime(d.Get("ends_at").(string)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating schedule object")
+	}
+
+	d.SetId(schedule.ID)
+	return resourceScheduleObjectRead(ctx, d, m)
+}
+
+func resourceScheduleObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	schedule, err := client.API.Security.Objects.Schedules.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading schedule object")
+	}
+
+	d.Set("name", schedule.Name)
+	d.Set("description", schedule.Description)
+	d.Set("type", schedule.Type)
+	d.Set("timezone", schedule.Timezone)
+	d.Set("range", flattenScheduleRanges(schedule.Ranges))
+	d.Set("starts_at", formatOptionalTime(schedule.StartsAt))
+	d.Set("ends_at", formatOptionalTime(schedule.EndsAt))
+	d.Set("reference_count", schedule.ReferenceCount)
+	return nil
+}
+
+func resourceScheduleObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateScheduleObjectParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("timezone") {
+		params.Timezone = opensase.String(d.Get("timezone").(string))
+	}
+	if d.HasChange("range") {
+		ranges := expandScheduleRanges(d.Get("range").([]interface{}))
+		params.Ranges = &ranges
+	}
+	if d.HasChange("starts_at") {
+		params.StartsAt = optionalTime(d.Get("starts_at").(string))
+	}
+	if d.HasChange("ends_at") {
+		params.EndsAt = optionalTime(d.Get("ends_at").(string))
+	}
+
+	if _, err := client.API.Security.Objects.Schedules.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating schedule object")
+	}
+
+	return resourceScheduleObjectRead(ctx, d, m)
+}
+
+func resourceScheduleObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.Objects.Schedules.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting schedule object")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandScheduleRanges(raw []interface{}) []opensase.ScheduleRange {
+	ranges := make([]opensase.ScheduleRange, 0, len(raw))
+	for _, r := range raw {
+		rm := r.(map[string]interface{})
+		ranges = append(ranges, opensase.ScheduleRange{
+			Days:      expandStringSet(rm["days"].(*schema.Set)),
+			StartTime: rm["start_time"].(string),
+			EndTime:   rm["end_time"].(string),
+		})
+	}
+	return ranges
+}
+
+func flattenScheduleRanges(ranges []opensase.ScheduleRange) []interface{} {
+	out := make([]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		out = append(out, map[string]interface{}{
+			"days":       r.Days,
+			"start_time": r.StartTime,
+			"end_time":   r.EndTime,
+		})
+	}
+	return out
+}
+
+// optionalTime parses an optional RFC 3339 argument, already validated
+func optionalTime(v string) *time.Time {
+	if v == "" {
+		return nil
+	}
+	t, _ := time.Parse(time.RFC3339, v)
+	return &t
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ SCIM Provisioning Resource ============
+
+func resourceSCIMProvisioning() *schema.Resource {
+	return &schema.Resource{
+		Description: "SCIM provisioning for the tenant. There is one per tenant; wire base_url and " +
+			"token into the identity provider's SCIM app. The token is only known to Terraform " +
+			"when provisioning is enabled or the token is rotated.",
+		CreateContext: resourceSCIMProvisioningCreate,
+		ReadContext:   resourceSCIMProvisioningRead,
+		UpdateContext: resourceSCIMProvisioningUpdate,
+		DeleteContext: resourceSCIMProvisioningDelete,
+		CustomizeDiff: validateSCIMProvisioning,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"idp_integration_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "opensase_idp_integration provisioned users sign in with",
+			},
+			"sync_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Accept group pushes in addition to users",
+			},
+			"deprovision_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      opensase.SCIMDeprovisionSuspend,
+				Description:  "What happens to a user the IdP deprovisions",
+				ValidateFunc: validation.StringInSlice([]string{opensase.SCIMDeprovisionSuspend, opensase.SCIMDeprovisionDelete}, false),
+			},
+			"token_lifetime_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Lifetime of issued tokens; 0 issues tokens that do not expire",
+				ValidateFunc: validation.IntBetween(0, 730),
+			},
+			"token_rotate_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value; changing it rotates the token, e.g. the id of a time_rotating resource",
+			},
+			"base_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateSCIMProvisioning(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("token_rotate_trigger") {
+		return nil
+	}
+	if err := d.SetNewComputed("token"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("token_expires_at")
+}
+
+func resourceSCIMProvisioningCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	config, err := client.API.Identity.SCIM.Enable(ctx, &opensase.EnableSCIMParams{
+		IdPID:             d.Get("idp_integration_id").(string),
+		SyncGroups:        opensase.Bool(d.Get("sync_groups").(bool)),
+		DeprovisionAction: d.Get("deprovision_action").(string),
+		TokenLifetimeDays: d.Get("token_lifetime_days").(int),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error enabling SCIM provisioning")
+	}
+
+	d.SetId(client.TenantID)
+	d.Set("token", config.Token)
+	return resourceSCIMProvisioningRead(ctx, d, m)
+}
+
+func resourceSCIMProvisioningRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	config, err := client.API.Identity.SCIM.Get(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading SCIM provisioning")
+	}
+	if !config.Enabled {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("idp_integration_id", config.IdPID)
+	d.Set("sync_groups", config.SyncGroups)
+	d.Set("deprovision_action", config.DeprovisionAction)
+	d.Set("base_url", config.BaseURL)
+	if config.TokenExpiresAt != nil {
+		d.Set("token_expires_at", config.TokenExpiresAt.Format(time.RFC3339))
+	} else {
+		d.Set("token_expires_at", "")
+	}
+	return nil
+}
+
+func resourceSCIMProvisioningUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	// On failure keep the prior state, so a rotation is retried and the
+	// current token, which the plan marked as unknown, is not lost
+	if d.HasChanges("idp_integration_id", "sync_groups", "deprovision_action") {
+		params := &opensase.UpdateSCIMParams{}
+		if d.HasChange("idp_integration_id") {
+			params.IdPID = opensase.String(d.Get("idp_integration_id").(string))
+		}
+		if d.HasChange("sync_groups") {
+			params.SyncGroups = opensase.Bool(d.Get("sync_groups").(bool))
+		}
+		if d.HasChange("deprovision_action") {
+			params.DeprovisionAction = opensase.String(d.Get("deprovision_action").(string))
+		}
+
+		if _, err := client.API.Identity.SCIM.Update(ctx, params); err != nil {
+			d.Partial(true)
+			return apiDiagnostics(err, "Error updating SCIM provisioning")
+		}
+	}
+
+	if d.HasChange("token_rotate_trigger") {
+		config, err := client.API.Identity.SCIM.RotateToken(ctx, &opensase.RotateSCIMTokenParams{
+			TokenLifetimeDays: d.Get("token_lifetime_days").(int),
+		})
+		if err != nil {
+			d.Partial(true)
+			return apiDiagnostics(err, "Error rotating SCIM token")
+		}
+		d.Set("token", config.Token)
+	}
+
+	return resourceSCIMProvisioningRead(ctx, d, m)
+}
+
+func resourceSCIMProvisioningDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Identity.SCIM.Disable(ctx); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error disabling SCIM provisioning")
+	}
+
+	d.SetId("")
+	return nil
+}
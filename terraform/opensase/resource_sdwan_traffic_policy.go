@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ SD-WAN Traffic Policy Resource ============
+
+func resourceSDWANTrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "SD-WAN path steering policy selecting WAN links for matching traffic",
+		CreateContext: resourceSDWANTrafficPolicyCreate,
+		ReadContext:   resourceSDWANTrafficPolicyRead,
+		UpdateContext: resourceSDWANTrafficPolicyUpdate,
+		DeleteContext: resourceSDWANTrafficPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Evaluation order; lower values are evaluated first",
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Sites the policy applies to; all sites when empty",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"applications": {
+							Type:         schema.TypeSet,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							AtLeastOneOf: []string{"match.0.applications", "match.0.dscp"},
+						},
+						"dscp": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 63),
+							},
+							AtLeastOneOf: []string{"match.0.applications", "match.0.dscp"},
+						},
+					},
+				},
+			},
+			"preferred_links": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "WAN link names or types in order of preference",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sla": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_latency_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_jitter_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_loss_percent": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			"failover": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  opensase.FailoverNextPreferred,
+							ValidateFunc: validation.StringInSlice([]string{
+								opensase.FailoverNextPreferred,
+								opensase.FailoverBestAvailable,
+								opensase.FailoverDrop,
+							}, false),
+						},
+						"hold_down_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Time a link must meet the SLA before traffic returns to it",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"revert": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func expandTrafficMatch(raw []interface{}) opensase.TrafficMatch {
+	if len(raw) == 0 || raw[0] == nil {
+		return opensase.TrafficMatch{}
+	}
+	m := raw[0].(map[string]interface{})
+	match := opensase.TrafficMatch{
+		Applications: expandStringSet(m["applications"].(*schema.Set)),
+	}
+	for _, v := range m["dscp"].(*schema.Set).List() {
+		match.DSCP = append(match.DSCP, v.(int))
+	}
+	return match
+}
+
+func flattenTrafficMatch(match opensase.TrafficMatch) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"applications": match.Applications,
+		"dscp":         match.DSCP,
+	}}
+}
+
+func expandSLAThresholds(raw []interface{}) *opensase.SLAThresholds {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	s := raw[0].(map[string]interface{})
+	return &opensase.SLAThresholds{
+		MaxLatencyMs:   s["max_latency_ms"].(int),
+		MaxJitterMs:    s["max_jitter_ms"].(int),
+		MaxLossPercent: s["max_loss_percent"].(float64),
+	}
+}
+
+func flattenSLAThresholds(sla *opensase.SLAThresholds) []interface{} {
+	if sla == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"max_latency_ms":   sla.MaxLatencyMs,
+		"max_jitter_ms":    sla.MaxJitterMs,
+		"max_loss_percent": sla.MaxLossPercent,
+	}}
+}
+
+func expandFailover(raw []interface{}) *opensase.FailoverBehavior {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	f := raw[0].(map[string]interface{})
+	return &opensase.FailoverBehavior{
+		Action:          f["action"].(string),
+		HoldDownSeconds: f["hold_down_seconds"].(int),
+		Revert:          f["revert"].(bool),
+	}
+}
+
+func flattenFailover(f opensase.FailoverBehavior) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"action":            f.Action,
+		"hold_down_seconds": f.HoldDownSeconds,
+		"revert":            f.Revert,
+	}}
+}
+
+func resourceSDWANTrafficPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Network.Traffic.Create(ctx, &opensase.CreateTrafficPolicyParams{
+		Name:           d.Get("name").(string),
+		Priority:       d.Get("priority").(int),
+		SiteIDs:        expandStringSet(d.Get("site_ids").(*schema.Set)),
+		Match:          expandTrafficMatch(d.Get("match").([]interface{})),
+		PreferredLinks: expandStringList(d.Get("preferred_links").([]interface{})),
+		SLA:            expandSLAThresholds(d.Get("sla").([]interface{})),
+		Failover:       expandFailover(d.Get("failover").([]interface{})),
+		Enabled:        opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating SD-WAN traffic policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourceSDWANTrafficPolicyRead(ctx, d, m)
+}
+
+func resourceSDWANTrafficPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Network.Traffic.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading SD-WAN traffic policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("priority", policy.Priority)
+	d.Set("site_ids", policy.SiteIDs)
+	d.Set("match", flattenTrafficMatch(policy.Match))
+	d.Set("preferred_links", policy.PreferredLinks)
+	d.Set("sla", flattenSLAThresholds(policy.SLA))
+	d.Set("failover", flattenFailover(policy.Failover))
+	d.Set("enabled", policy.Enabled)
+	return nil
+}
+
+func resourceSDWANTrafficPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateTrafficPolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("site_ids") {
+		// An empty list widens the policy to all sites, so it must still be sent
+		siteIDs := expandStringSet(d.Get("site_ids").(*schema.Set))
+		params.SiteIDs = &siteIDs
+	}
+	if d.HasChange("match") {
+		match := expandTrafficMatch(d.Get("match").([]interface{}))
+		params.Match = &match
+	}
+	if d.HasChange("preferred_links") {
+		params.PreferredLinks = expandStringList(d.Get("preferred_links").([]interface{}))
+	}
+	if d.HasChange("sla") {
+		params.SLA = expandSLAThresholds(d.Get("sla").([]interface{}))
+		if params.SLA == nil {
+			params.SLA = &opensase.SLAThresholds{}
+		}
+	}
+	if d.HasChange("failover") {
+		params.Failover = expandFailover(d.Get("failover").([]interface{}))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Network.Traffic.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating SD-WAN traffic policy")
+	}
+
+	return resourceSDWANTrafficPolicyRead(ctx, d, m)
+}
+
+func resourceSDWANTrafficPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.Traffic.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting SD-WAN traffic policy")
+	}
+
+	d.SetId("")
+	return nil
+}
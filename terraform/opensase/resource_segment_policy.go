@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Segment Policy Resource ============
+
+func resourceSegmentPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "East-west policy between two opensase_network_segment resources",
+		CreateContext: resourceSegmentPolicyCreate,
+		ReadContext:   resourceSegmentPolicyRead,
+		UpdateContext: resourceSegmentPolicyUpdate,
+		DeleteContext: resourceSegmentPolicyDelete,
+		CustomizeDiff: validateSegmentPolicy,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_segment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_segment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "allow",
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+			},
+			"service_object_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "opensase_service_object IDs the policy applies to. Empty matches all traffic.",
+			},
+			"bidirectional": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also apply the policy to traffic from the destination to the source segment",
+			},
+			"log": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Evaluation order; lower values are evaluated first",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func validateSegmentPolicy(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("source_segment_id") && d.NewValueKnown("destination_segment_id") &&
+		d.Get("source_segment_id").(string) == d.Get("destination_segment_id").(string) {
+		return fmt.Errorf("destination_segment_id: must differ from source_segment_id; traffic within a segment is not filtered")
+	}
+	return validateObjectReferences(ctx, d, m.(*Client), "service_object_ids", objectService)
+}
+
+func resourceSegmentPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Network.Segments.CreatePolicy(ctx, &opensase.CreateSegmentPolicyParams{
+		Name:                 d.Get("name").(string),
+		SourceSegmentID:      d.Get("source_segment_id").(string),
+		DestinationSegmentID: d.Get("destination_segment_id").(string),
+		Action:               d.Get("action").(string),
+		ServiceObjectIDs:     expandStringSet(d.Get("service_object_ids").(*schema.Set)),
+		Bidirectional:        d.Get("bidirectional").(bool),
+		Log:                  d.Get("log").(bool),
+		Priority:             d.Get("priority").(int),
+		Enabled:              opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating segment policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourceSegmentPolicyRead(ctx, d, m)
+}
+
+func resourceSegmentPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Network.Segments.GetPolicy(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading segment policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("source_segment_id", policy.SourceSegmentID)
+	d.Set("destination_segment_id", policy.DestinationSegmentID)
+	d.Set("action", policy.Action)
+	d.Set("service_object_ids", policy.ServiceObjectIDs)
+	d.Set("bidirectional", policy.Bidirectional)
+	d.Set("log", policy.Log)
+	d.Set("priority", policy.Priority)
+	d.Set("enabled", policy.Enabled)
+	return nil
+}
+
+func resourceSegmentPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateSegmentPolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("action") {
+		params.Action = opensase.String(d.Get("action").(string))
+	}
+	if d.HasChange("service_object_ids") {
+		ids := expandStringSet(d.Get("service_object_ids").(*schema.Set))
+		params.ServiceObjectIDs = &ids
+	}
+	if d.HasChange("bidirectional") {
+		params.Bidirectional = opensase.Bool(d.Get("bidirectional").(bool))
+	}
+	if d.HasChange("log") {
+		params.Log = opensase.Bool(d.Get("log").(bool))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Network.Segments.UpdatePolicy(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating segment policy")
+	}
+
+	return resourceSegmentPolicyRead(ctx, d, m)
+}
+
+func resourceSegmentPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.Segments.DeletePolicy(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting segment policy")
+	}
+
+	d.SetId("")
+	return nil
+}
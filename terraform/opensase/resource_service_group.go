@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ============ Service Group Resource ============
+
+func resourceServiceGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Named set of service objects that rules reference by ID. " +
+			"The API refuses to delete groups that are still referenced.",
+		CreateContext: resourceServiceGroupCreate,
+		ReadContext:   resourceServiceGroupRead,
+		UpdateContext: resourceServiceGroupUpdate,
+		DeleteContext: resourceServiceGroupDelete,
+		CustomizeDiff: validateServiceGroup,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "IDs of opensase_service_object resources in the group",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"reference_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rules referencing the group",
+			},
+		},
+	}
+}
+
+func validateServiceGroup(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateObjectReferences(ctx, d, m.(*Client), "members", objectService)
+}
+
+func resourceServiceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	group, err := client.API.Security.Objects.ServiceGroups.Create(ctx, &opensase.CreateServiceGroupParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Members:     expandStringSet(d.Get("members").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating service group")
+	}
+
+	d.SetId(group.ID)
+	return resourceServiceGroupRead(ctx, d, m)
+}
+
+func resourceServiceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	group, err := client.API.Security.Objects.ServiceGroups.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading service group")
+	}
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("members", group.Members)
+	d.Set("reference_count", group.ReferenceCount)
+	return nil
+}
+
+func resourceServiceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateServiceGroupParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("members") {
+		members := expandStringSet(d.Get("members").(*schema.Set))
+		params.Members = &members
+	}
+
+	if _, err := client.API.Security.Objects.ServiceGroups.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating service group")
+	}
+
+	return resourceServiceGroupRead(ctx, d, m)
+}
+
+func resourceServiceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.Objects.ServiceGroups.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting service group")
+	}
+
+	d.SetId("")
+	return nil
+}
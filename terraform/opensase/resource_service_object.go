@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Service Object Resource ============
+
+func resourceServiceObject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Named set of protocols and ports that firewall rules reference by ID. " +
+			"The API refuses to delete objects that are still referenced.",
+		CreateContext: resourceServiceObjectCreate,
+		ReadContext:   resourceServiceObjectRead,
+		UpdateContext: resourceServiceObjectUpdate,
+		DeleteContext: resourceServiceObjectDelete,
+		CustomizeDiff: validateServiceObject,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "any"}, false),
+						},
+						"ports": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Port or port range, e.g. 443 or 8000-8080. Only for tcp and udp.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{1,5}(-\d{1,5})?$`), "must be a port or port range"),
+						},
+					},
+				},
+			},
+			"reference_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rules referencing the object",
+			},
+		},
+	}
+}
+
+// validateServiceObject rejects ports on protocols that have none
+func validateServiceObject(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, r := range d.Get("entry").(*schema.Set).List() {
+		e := r.(map[string]interface{})
+		protocol := e["protocol"].(string)
+		if (protocol == "icmp" || protocol == "any") && e["ports"].(string) != "" {
+			return fmt.Errorf("entry: ports cannot be set for protocol %q", protocol)
+		}
+	}
+	return nil
+}
+
+func expandServicePorts(s *schema.Set) []opensase.ServicePort {
+	entries := make([]opensase.ServicePort, 0, s.Len())
+	for _, r := range s.List() {
+		e := r.(map[string]interface{})
+		entries = append(entries, opensase.ServicePort{
+			Protocol: e["protocol"].(string),
+			Ports:    e["ports"].(string),
+		})
+	}
+	return entries
+}
+
+func flattenServicePorts(entries []opensase.ServicePort) []interface{} {
+	out := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, map[string]interface{}{
+			"protocol": e.Protocol,
+			"ports":    e.Ports,
+		})
+	}
+	return out
+}
+
+func resourceServiceObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	object, err := client.API.Security.ServiceObjects.Create(ctx, &opensase.CreateServiceObjectParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Entries:     expandServicePorts(d.Get("entry").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating service object")
+	}
+
+	d.SetId(object.ID)
+	return resourceServiceObjectRead(ctx, d, m)
+}
+
+func resourceServiceObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	object, err := client.API.Security.ServiceObjects.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading service object")
+	}
+
+	d.Set("name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("entry", flattenServicePorts(object.Entries))
+	d.Set("reference_count", object.ReferenceCount)
+	return nil
+}
+
+func resourceServiceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateServiceObjectParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("entry") {
+		entries := expandServicePorts(d.Get("entry").(*schema.Set))
+		params.Entries = &entries
+	}
+
+	if _, err := client.API.Security.ServiceObjects.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating service object")
+	}
+
+	return resourceServiceObjectRead(ctx, d, m)
+}
+
+func resourceServiceObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.ServiceObjects.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting service object")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/enum"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Site Template Resource ============
+
+func resourceSiteTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Default WAN link, security and QoS settings for branch sites. Sites reference it " +
+			"with template_id; profile changes are pushed to every site using the template.",
+		CreateContext: resourceSiteTemplateCreate,
+		ReadContext:   resourceSiteTemplateRead,
+		UpdateContext: resourceSiteTemplateUpdate,
+		DeleteContext: resourceSiteTemplateDelete,
+		CustomizeDiff: validateSiteTemplate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"wan_link": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "WAN links given to sites created without links of their own. Changes do not affect existing sites.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Link type: broadband, mpls, lte or satellite",
+							ValidateFunc: validation.StringInSlice(enum.Strings(enum.WANLinkTypes()), false),
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"bandwidth_mbps": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"failover_priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Order in which links take over traffic; lower values are preferred",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"threat_prevention_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url_filtering_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_security_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ssl_inspection_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"qos_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"site_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of sites using the template",
+			},
+		},
+	}
+}
+
+func validateSiteTemplate(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	seen := map[string]bool{}
+	for _, l := range expandTemplateWANLinks(d.Get("wan_link").([]interface{})) {
+		if l.Name == "" {
+			continue
+		}
+		if seen[l.Name] {
+			return fmt.Errorf("wan_link: duplicate link name %q", l.Name)
+		}
+		seen[l.Name] = true
+	}
+	return nil
+}
+
+func expandTemplateWANLinks(raw []interface{}) []opensase.WANLink {
+	links := make([]opensase.WANLink, 0, len(raw))
+	for _, r := range raw {
+		l := r.(map[string]interface{})
+		links = append(links, opensase.WANLink{
+			Name:             l["name"].(string),
+			Type:             enum.WANLinkType(l["type"].(string)),
+			Provider:         l["provider_name"].(string),
+			BandwidthMbps:    l["bandwidth_mbps"].(int),
+			FailoverPriority: l["failover_priority"].(int),
+		})
+	}
+	return links
+}
+
+func flattenTemplateWANLinks(links []opensase.WANLink) []interface{} {
+	out := make([]interface{}, 0, len(links))
+	for _, l := range links {
+		out = append(out, map[string]interface{}{
+			"name":              l.Name,
+			"type":              string(l.Type),
+			"provider_name":     l.Provider,
+			"bandwidth_mbps":    l.BandwidthMbps,
+			"failover_priority": l.FailoverPriority,
+		})
+	}
+	return out
+}
+
+func expandSiteTemplateSecurity(d *schema.ResourceData) opensase.SiteTemplateSecurity {
+	return opensase.SiteTemplateSecurity{
+		ThreatPreventionProfileID: d.Get("threat_prevention_profile_id").(string),
+		URLFilteringProfileID:     d.Get("url_filtering_profile_id").(string),
+		DNSSecurityProfileID:      d.Get("dns_security_profile_id").(string),
+		SSLInspectionProfileID:    d.Get("ssl_inspection_profile_id").(string),
+	}
+}
+
+func resourceSiteTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	template, err := client.API.Network.Templates.Create(ctx, &opensase.CreateSiteTemplateParams{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		WANLinks:     expandTemplateWANLinks(d.Get("wan_link").([]interface{})),
+		Security:     expandSiteTemplateSecurity(d),
+		QoSProfileID: d.Get("qos_profile_id").(string),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating site template")
+	}
+
+	d.SetId(template.ID)
+	return resourceSiteTemplateRead(ctx, d, m)
+}
+
+func resourceSiteTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	template, err := client.API.Network.Templates.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading site template")
+	}
+
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("wan_link", flattenTemplateWANLinks(template.WANLinks))
+	d.Set("threat_prevention_profile_id", template.Security.ThreatPreventionProfileID)
+	d.Set("url_filtering_profile_id", template.Security.URLFilteringProfileID)
+	d.Set("dns_security_profile_id", template.Security.DNSSecurityProfileID)
+	d.Set("ssl_inspection_profile_id", template.Security.SSLInspectionProfileID)
+	d.Set("qos_profile_id", template.QoSProfileID)
+	d.Set("site_count", template.SiteCount)
+	return nil
+}
+
+func resourceSiteTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateSiteTemplateParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("wan_link") {
+		links := expandTemplateWANLinks(d.Get("wan_link").([]interface{}))
+		params.WANLinks = &links
+	}
+	if d.HasChanges("threat_prevention_profile_id", "url_filtering_profile_id", "dns_security_profile_id", "ssl_inspection_profile_id") {
+		security := expandSiteTemplateSecurity(d)
+		params.Security = &security
+	}
+	if d.HasChange("qos_profile_id") {
+		params.QoSProfileID = opensase.String(d.Get("qos_profile_id").(string))
+	}
+
+	if _, err := client.API.Network.Templates.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating site template")
+	}
+
+	return resourceSiteTemplateRead(ctx, d, m)
+}
+
+func resourceSiteTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Network.Templates.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting site template")
+	}
+
+	d.SetId("")
+	return nil
+}
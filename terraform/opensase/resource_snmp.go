@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ SNMP Resource ============
+
+func resourceSNMP() *schema.Resource {
+	return &schema.Resource{
+		Description: "SNMP agent of the edge devices of a site. Every site has one agent, " +
+			"so the resource ID is the site ID; destroying the resource disables SNMP on the site.",
+		CreateContext: resourceSNMPCreate,
+		ReadContext:   resourceSNMPRead,
+		UpdateContext: resourceSNMPUpdate,
+		DeleteContext: resourceSNMPDelete,
+		CustomizeDiff: validateSNMP,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{opensase.SNMPVersion2c, opensase.SNMPVersion3}, false),
+			},
+			"community": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Community string, required with v2c. Write-only: changes made outside Terraform are not detected.",
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"contact": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"allowed_sources": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "CIDRs of the hosts allowed to poll the agent",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"v3_user": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "SNMPv3 users, required with v3",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"auth_protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  opensase.SNMPAuthSHA256,
+							ValidateFunc: validation.StringInSlice([]string{
+								opensase.SNMPAuthSHA, opensase.SNMPAuthSHA256, opensase.SNMPAuthSHA512,
+							}, false),
+						},
+						"auth_password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							Description:  "Write-only: changes made outside Terraform are not detected.",
+							ValidateFunc: validation.StringLenBetween(8, 64),
+						},
+						"priv_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Encrypts the user's traffic; without it the user authenticates only",
+							ValidateFunc: validation.StringInSlice([]string{opensase.SNMPPrivAES128, opensase.SNMPPrivAES256}, false),
+						},
+						"priv_password": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							Description:  "Required with priv_protocol. Write-only: changes made outside Terraform are not detected.",
+							ValidateFunc: validation.StringLenBetween(8, 64),
+						},
+					},
+				},
+			},
+			"trap_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      162,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "v3_user traps are sent as, required with v3",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateSNMP(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	version := d.Get("version").(string)
+	users := d.Get("v3_user").([]interface{})
+	receivers := d.Get("trap_receiver").([]interface{})
+
+	if version == opensase.SNMPVersion2c {
+		if d.NewValueKnown("community") && d.Get("community").(string) == "" {
+			return fmt.Errorf("community: required with version v2c")
+		}
+		if len(users) > 0 {
+			return fmt.Errorf("v3_user: only allowed with version v3")
+		}
+		for i, raw := range receivers {
+			if r, ok := raw.(map[string]interface{}); ok && r["username"].(string) != "" {
+				return fmt.Errorf("trap_receiver.%d.username: only allowed with version v3", i)
+			}
+		}
+		return nil
+	}
+
+	if len(users) == 0 {
+		return fmt.Errorf("v3_user: at least one required with version v3")
+	}
+	usernames := make(map[string]bool, len(users))
+	for i, raw := range users {
+		u, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := u["username"].(string)
+		if usernames[name] {
+			return fmt.Errorf("v3_user.%d.username: %q is declared twice", i, name)
+		}
+		usernames[name] = true
+		if (u["priv_protocol"].(string) == "") != (u["priv_password"].(string) == "") {
+			return fmt.Errorf("v3_user.%d: priv_protocol and priv_password must be set together", i)
+		}
+	}
+	for i, raw := range receivers {
+		r, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name := r["username"].(string); !usernames[name] {
+			return fmt.Errorf("trap_receiver.%d.username: must name a v3_user", i)
+		}
+	}
+	return nil
+}
+
+func expandSNMPUsers(raw []interface{}) []opensase.SNMPUser {
+	users := make([]opensase.SNMPUser, 0, len(raw))
+	for _, r := range raw {
+		u := r.(map[string]interface{})
+		users = append(users, opensase.SNMPUser{
+			Username:     u["username"].(string),
+			AuthProtocol: u["auth_protocol"].(string),
+			AuthPassword: u["auth_password"].(string),
+			PrivProtocol: u["priv_protocol"].(string),
+			PrivPassword: u["priv_password"].(string),
+		})
+	}
+	return users
+}
+
+// flattenSNMPUsers keeps the write-only passwords of users already in
+// state, matched by username
+func flattenSNMPUsers(users []opensase.SNMPUser, prior []interface{}) []interface{} {
+	passwords := make(map[string]map[string]interface{}, len(prior))
+	for _, raw := range prior {
+		if u, ok := raw.(map[string]interface{}); ok {
+			passwords[u["username"].(string)] = u
+		}
+	}
+
+	out := make([]interface{}, 0, len(users))
+	for _, u := range users {
+		user := map[string]interface{}{
+			"username":      u.Username,
+			"auth_protocol": u.AuthProtocol,
+			"priv_protocol": u.PrivProtocol,
+		}
+		if p, ok := passwords[u.Username]; ok {
+			user["auth_password"] = p["auth_password"]
+			user["priv_password"] = p["priv_password"]
+		}
+		out = append(out, user)
+	}
+	return out
+}
+
+func expandTrapReceivers(raw []interface{}) []opensase.SNMPTrapReceiver {
+	receivers := make([]opensase.SNMPTrapReceiver, 0, len(raw))
+	for _, r := range raw {
+		t := r.(map[string]interface{})
+		receivers = append(receivers, opensase.SNMPTrapReceiver{
+			Host:     t["host"].(string),
+			Port:     t["port"].(int),
+			Username: t["username"].(string),
+		})
+	}
+	return receivers
+}
+
+func flattenTrapReceivers(receivers []opensase.SNMPTrapReceiver) []interface{} {
+	out := make([]interface{}, 0, len(receivers))
+	for _, r := range receivers {
+		out = append(out, map[string]interface{}{
+			"host":     r.Host,
+			"port":     r.Port,
+			"username": r.Username,
+		})
+	}
+	return out
+}
+
+func resourceSNMPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	sources := expandStringSet(d.Get("allowed_sources").(*schema.Set))
+	users := expandSNMPUsers(d.Get("v3_user").([]interface{}))
+	receivers := expandTrapReceivers(d.Get("trap_receiver").([]interface{}))
+	params := &opensase.UpdateSNMPParams{
+		Enabled:        opensase.Bool(true),
+		Version:        opensase.String(d.Get("version").(string)),
+		Location:       opensase.String(d.Get("location").(string)),
+		Contact:        opensase.String(d.Get("contact").(string)),
+		AllowedSources: &sources,
+		Users:          &users,
+		TrapReceivers:  &receivers,
+	}
+	if v, ok := d.GetOk("community"); ok {
+		params.Community = opensase.String(v.(string))
+	}
+
+	if _, err := client.API.Network.SNMP.Update(ctx, siteID, params); err != nil {
+		return apiDiagnostics(err, "Error configuring SNMP")
+	}
+
+	d.SetId(siteID)
+	return resourceSNMPRead(ctx, d, m)
+}
+
+func resourceSNMPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	settings, err := client.API.Network.SNMP.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading SNMP")
+	}
+	if !settings.Enabled {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("site_id", d.Id())
+	d.Set("version", settings.Version)
+	d.Set("location", settings.Location)
+	d.Set("contact", settings.Contact)
+	d.Set("allowed_sources", settings.AllowedSources)
+	d.Set("v3_user", flattenSNMPUsers(settings.Users, d.Get("v3_user").([]interface{})))
+	d.Set("trap_receiver", flattenTrapReceivers(settings.TrapReceivers))
+	return nil
+}
+
+func resourceSNMPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateSNMPParams{}
+	if d.HasChange("version") {
+		params.Version = opensase.String(d.Get("version").(string))
+	}
+	if d.HasChange("community") {
+		params.Community = opensase.String(d.Get("community").(string))
+	}
+	if d.HasChange("location") {
+		params.Location = opensase.String(d.Get("location").(string))
+	}
+	if d.HasChange("contact") {
+		params.Contact = opensase.String(d.Get("contact").(string))
+	}
+	if d.HasChange("allowed_sources") {
+		sources := expandStringSet(d.Get("allowed_sources").(*schema.Set))
+		params.AllowedSources = &sources
+	}
+	if d.HasChange("v3_user") {
+		users := expandSNMPUsers(d.Get("v3_user").([]interface{}))
+		params.Users = &users
+	}
+	if d.HasChange("trap_receiver") {
+		receivers := expandTrapReceivers(d.Get("trap_receiver").([]interface{}))
+		params.TrapReceivers = &receivers
+	}
+
+	if _, err := client.API.Network.SNMP.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating SNMP")
+	}
+
+	return resourceSNMPRead(ctx, d, m)
+}
+
+func resourceSNMPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	_, err := client.API.Network.SNMP.Update(ctx, d.Id(), &opensase.UpdateSNMPParams{
+		Enabled: opensase.Bool(false),
+	})
+	if err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error disabling SNMP")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ SSL Inspection Profile Resource ============
+
+func resourceSSLInspectionProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "TLS/SSL inspection profile with ordered decryption rules and exemptions",
+		CreateContext: resourceSSLInspectionProfileCreate,
+		ReadContext:   resourceSSLInspectionProfileRead,
+		UpdateContext: resourceSSLInspectionProfileUpdate,
+		DeleteContext: resourceSSLInspectionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Decryption rules, evaluated in order; the first match applies",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{opensase.SSLActionDecrypt, opensase.SSLActionNoDecrypt, opensase.SSLActionBlock}, false),
+						},
+						"categories": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"domains": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"user_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"exempt_categories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "URL categories never decrypted, e.g. health and financial-services",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"exempt_domains": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Domains never decrypted, such as certificate-pinned applications",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"min_tls_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1.2",
+				Description:  "Sessions negotiating an older TLS version are blocked",
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+			},
+			"ca_certificate_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the opensase_certificate of type ca used to re-sign decrypted sessions",
+			},
+			"block_untrusted_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Block sessions whose server certificate does not validate",
+			},
+		},
+	}
+}
+
+func expandSSLDecryptionRules(raw []interface{}) []opensase.SSLDecryptionRule {
+	rules := make([]opensase.SSLDecryptionRule, 0, len(raw))
+	for _, r := range raw {
+		rule := r.(map[string]interface{})
+		rules = append(rules, opensase.SSLDecryptionRule{
+			Name:       rule["name"].(string),
+			Action:     rule["action"].(string),
+			Categories: expandStringSet(rule["categories"].(*schema.Set)),
+			Domains:    expandStringSet(rule["domains"].(*schema.Set)),
+			UserGroups: expandStringSet(rule["user_groups"].(*schema.Set)),
+		})
+	}
+	return rules
+}
+
+func flattenSSLDecryptionRules(rules []opensase.SSLDecryptionRule) []interface{} {
+	out := make([]interface{}, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, map[string]interface{}{
+			"name":        r.Name,
+			"action":      r.Action,
+			"categories":  r.Categories,
+			"domains":     r.Domains,
+			"user_groups": r.UserGroups,
+		})
+	}
+	return out
+}
+
+func resourceSSLInspectionProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.SSLInspection.Create(ctx, &opensase.CreateSSLInspectionProfileParams{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Rules:            expandSSLDecryptionRules(d.Get("rule").([]interface{})),
+		ExemptCategories: expandStringSet(d.Get("exempt_categories").(*schema.Set)),
+		ExemptDomains:    expandStringSet(d.Get("exempt_domains").(*schema.Set)),
+		MinTLSVersion:    d.Get("min_tls_version").(string),
+		CACertificateID:  d.Get("ca_certificate_id").(string),
+		BlockUntrusted:   d.Get("block_untrusted_certificates").(bool),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating SSL inspection profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceSSLInspectionProfileRead(ctx, d, m)
+}
+
+func resourceSSLInspectionProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.SSLInspection.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading SSL inspection profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("rule", flattenSSLDecryptionRules(profile.Rules))
+	d.Set("exempt_categories", profile.ExemptCategories)
+	d.Set("exempt_domains", profile.ExemptDomains)
+	d.Set("min_tls_version", profile.MinTLSVersion)
+	d.Set("ca_certificate_id", profile.CACertificateID)
+	d.Set("block_untrusted_certificates", profile.BlockUntrusted)
+	return nil
+}
+
+func resourceSSLInspectionProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateSSLInspectionProfileParams{}
+	for key, field := range map[string]**string{
+		"name":              &params.Name,
+		"description":       &params.Description,
+		"min_tls_version":   &params.MinTLSVersion,
+		"ca_certificate_id": &params.CACertificateID,
+	} {
+		if d.HasChange(key) {
+			*field = opensase.String(d.Get(key).(string))
+		}
+	}
+	if d.HasChange("rule") {
+		rules := expandSSLDecryptionRules(d.Get("rule").([]interface{}))
+		params.Rules = &rules
+	}
+	if d.HasChange("exempt_categories") {
+		categories := expandStringSet(d.Get("exempt_categories").(*schema.Set))
+		params.ExemptCategories = &categories
+	}
+	if d.HasChange("exempt_domains") {
+		domains := expandStringSet(d.Get("exempt_domains").(*schema.Set))
+		params.ExemptDomains = &domains
+	}
+	if d.HasChange("block_untrusted_certificates") {
+		params.BlockUntrusted = opensase.Bool(d.Get("block_untrusted_certificates").(bool))
+	}
+
+	if _, err := client.API.Security.SSLInspection.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating SSL inspection profile")
+	}
+
+	return resourceSSLInspectionProfileRead(ctx, d, m)
+}
+
+func resourceSSLInspectionProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.SSLInspection.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting SSL inspection profile")
+	}
+
+	d.SetId("")
+	return nil
+}
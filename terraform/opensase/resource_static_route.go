@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Static Route Resource ============
+
+func resourceStaticRoute() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Static route on a site",
+		CreateContext: resourceStaticRouteCreate,
+		ReadContext:   resourceStaticRouteRead,
+		UpdateContext: resourceStaticRouteUpdate,
+		DeleteContext: resourceStaticRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("route"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Destination prefix in CIDR notation",
+				ValidateFunc: validation.IsCIDR,
+			},
+			"next_hop": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"wan_link_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "WAN link to send traffic out of; defaults to the link the next hop is reachable on",
+			},
+			"metric": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Route preference among routes to the same prefix; lower values are preferred",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceStaticRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	route, err := client.API.Network.Routes.Create(ctx, siteID, &opensase.CreateStaticRouteParams{
+		Prefix:      d.Get("prefix").(string),
+		NextHop:     d.Get("next_hop").(string),
+		WANLinkID:   d.Get("wan_link_id").(string),
+		Metric:      d.Get("metric").(int),
+		Description: d.Get("description").(string),
+		Enabled:     opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating static route")
+	}
+
+	d.SetId(siteID + "/" + route.ID)
+	return resourceStaticRouteRead(ctx, d, m)
+}
+
+func resourceStaticRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, routeID, err := parseSiteScopedID(d.Id(), "route")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	route, err := client.API.Network.Routes.Get(ctx, siteID, routeID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading static route")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("prefix", route.Prefix)
+	d.Set("next_hop", route.NextHop)
+	d.Set("wan_link_id", route.WANLinkID)
+	d.Set("metric", route.Metric)
+	d.Set("description", route.Description)
+	d.Set("enabled", route.Enabled)
+	return nil
+}
+
+func resourceStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, routeID, err := parseSiteScopedID(d.Id(), "route")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateStaticRouteParams{}
+	if d.HasChange("prefix") {
+		params.Prefix = opensase.String(d.Get("prefix").(string))
+	}
+	if d.HasChange("next_hop") {
+		params.NextHop = opensase.String(d.Get("next_hop").(string))
+	}
+	if d.HasChange("wan_link_id") {
+		params.WANLinkID = opensase.String(d.Get("wan_link_id").(string))
+	}
+	if d.HasChange("metric") {
+		params.Metric = opensase.Int(d.Get("metric").(int))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Network.Routes.Update(ctx, siteID, routeID, params); err != nil {
+		return apiDiagnostics(err, "Error updating static route")
+	}
+
+	return resourceStaticRouteRead(ctx, d, m)
+}
+
+func resourceStaticRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, routeID, err := parseSiteScopedID(d.Id(), "route")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.Routes.Delete(ctx, siteID, routeID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting static route")
+	}
+
+	d.SetId("")
+	return nil
+}
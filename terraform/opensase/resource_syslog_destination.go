@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Syslog Destination Resource ============
+
+var syslogFacilities = []string{
+	"kern", "user", "daemon", "auth", "syslog", "local0", "local1", "local2",
+	"local3", "local4", "local5", "local6", "local7",
+}
+
+func resourceSyslogDestination() *schema.Resource {
+	return &schema.Resource{
+		Description: "Syslog server the edge devices of a site send their system logs to. " +
+			"Traffic and security logs are exported with opensase_log_export.",
+		CreateContext: resourceSyslogDestinationCreate,
+		ReadContext:   resourceSyslogDestinationRead,
+		UpdateContext: resourceSyslogDestinationUpdate,
+		DeleteContext: resourceSyslogDestinationDelete,
+		CustomizeDiff: validateSyslogDestination,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("syslog destination"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname or IP address",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Defaults to 6514 with tls and 514 otherwise",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  opensase.SyslogProtocolUDP,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.SyslogProtocolUDP, opensase.SyslogProtocolTCP, opensase.SyslogProtocolTLS,
+				}, false),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      opensase.SyslogFormatRFC5424,
+				ValidateFunc: validation.StringInSlice([]string{opensase.SyslogFormatRFC5424, opensase.SyslogFormatRFC3164}, false),
+			},
+			"facility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "local7",
+				ValidateFunc: validation.StringInSlice(syslogFacilities, false),
+			},
+			"min_severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     opensase.SyslogSeverityInfo,
+				Description: "Least severe messages sent",
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.SyslogSeverityEmergency, opensase.SyslogSeverityAlert, opensase.SyslogSeverityCritical,
+					opensase.SyslogSeverityError, opensase.SyslogSeverityWarning, opensase.SyslogSeverityNotice,
+					opensase.SyslogSeverityInfo, opensase.SyslogSeverityDebug,
+				}, false),
+			},
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM CA certificate the server's certificate is verified against, if not publicly trusted",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func validateSyslogDestination(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("ca_certificate").(string) != "" && d.Get("protocol").(string) != opensase.SyslogProtocolTLS {
+		return fmt.Errorf("ca_certificate: requires protocol tls")
+	}
+	return nil
+}
+
+func resourceSyslogDestinationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	server, err := client.API.Network.Syslog.Create(ctx, siteID, &opensase.CreateSyslogServerParams{
+		Name:          d.Get("name").(string),
+		Host:          d.Get("host").(string),
+		Port:          d.Get("port").(int),
+		Protocol:      d.Get("protocol").(string),
+		Format:        d.Get("format").(string),
+		Facility:      d.Get("facility").(string),
+		MinSeverity:   d.Get("min_severity").(string),
+		CACertificate: d.Get("ca_certificate").(string),
+		Enabled:       opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating syslog destination")
+	}
+
+	d.SetId(siteID + "/" + server.ID)
+	return resourceSyslogDestinationRead(ctx, d, m)
+}
+
+func resourceSyslogDestinationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, serverID, err := parseSiteScopedID(d.Id(), "syslog destination")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server, err := client.API.Network.Syslog.Get(ctx, siteID, serverID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading syslog destination")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("name", server.Name)
+	d.Set("host", server.Host)
+	d.Set("port", server.Port)
+	d.Set("protocol", server.Protocol)
+	d.Set("format", server.Format)
+	d.Set("facility", server.Facility)
+	d.Set("min_severity", server.MinSeverity)
+	d.Set("ca_certificate", server.CACertificate)
+	d.Set("enabled", server.Enabled)
+	return nil
+}
+
+func resourceSyslogDestinationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, serverID, err := parseSiteScopedID(d.Id(), "syslog destination")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateSyslogServerParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("host") {
+		params.Host = opensase.String(d.Get("host").(string))
+	}
+	if d.HasChange("port") {
+		params.Port = opensase.Int(d.Get("port").(int))
+	}
+	if d.HasChange("protocol") {
+		params.Protocol = opensase.String(d.Get("protocol").(string))
+	}
+	if d.HasChange("format") {
+		params.Format = opensase.String(d.Get("format").(string))
+	}
+	if d.HasChange("facility") {
+		params.Facility = opensase.String(d.Get("facility").(string))
+	}
+	if d.HasChange("min_severity") {
+		params.MinSeverity = opensase.String(d.Get("min_severity").(string))
+	}
+	if d.HasChange("ca_certificate") {
+		params.CACertificate = opensase.String(d.Get("ca_certificate").(string))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Network.Syslog.Update(ctx, siteID, serverID, params); err != nil {
+		return apiDiagnostics(err, "Error updating syslog destination")
+	}
+
+	return resourceSyslogDestinationRead(ctx, d, m)
+}
+
+func resourceSyslogDestinationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, serverID, err := parseSiteScopedID(d.Id(), "syslog destination")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.Syslog.Delete(ctx, siteID, serverID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting syslog destination")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Tenant Resource ============
+
+func resourceTenant() *schema.Resource {
+	return &schema.Resource{
+		Description: "Child tenant of a managed service provider, created with the parent " +
+			"tenant's credentials. Other resources are managed inside it by setting their tenant_id.",
+		CreateContext: resourceTenantCreate,
+		ReadContext:   resourceTenantRead,
+		UpdateContext: resourceTenantUpdate,
+		DeleteContext: resourceTenantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plan": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					opensase.TenantPlanEssentials, opensase.TenantPlanProfessional, opensase.TenantPlanEnterprise,
+				}, false),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Region the tenant's data is stored in. Defaults to the parent's region.",
+			},
+			"admin": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "First administrator, invited when the tenant is created. Later changes are ignored.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressAfterCreate,
+						},
+						"first_name": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressAfterCreate,
+						},
+						"last_name": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressAfterCreate,
+						},
+					},
+				},
+				DiffSuppressFunc: suppressAfterCreate,
+			},
+			"licenses": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Licenses allocated from the parent, keyed by quota resource such as sites or users",
+			},
+			"suspended": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Lock the tenant's administrators and API keys out, e.g. for non-payment",
+			},
+			"parent_id": {Type: schema.TypeString, Computed: true},
+			"status":    {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+// suppressAfterCreate ignores changes to arguments only used at creation
+func suppressAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func resourceTenantCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	admin := d.Get("admin").([]interface{})[0].(map[string]interface{})
+	tenant, err := client.API.Tenants.Create(ctx, &opensase.CreateTenantParams{
+		Name:   d.Get("name").(string),
+		Plan:   d.Get("plan").(string),
+		Region: d.Get("region").(string),
+		Admin: opensase.TenantAdmin{
+			Email:     admin["email"].(string),
+			FirstName: admin["first_name"].(string),
+			LastName:  admin["last_name"].(string),
+		},
+		Licenses: expandIntMap(d.Get("licenses").(map[string]interface{})),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating tenant")
+	}
+
+	d.SetId(tenant.ID)
+
+	if d.Get("suspended").(bool) {
+		if _, err := client.API.Tenants.Suspend(ctx, tenant.ID); err != nil {
+			return apiDiagnostics(err, "Error suspending tenant")
+		}
+	}
+
+	return resourceTenantRead(ctx, d, m)
+}
+
+func resourceTenantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	tenant, err := client.API.Tenants.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading tenant")
+	}
+
+	d.Set("name", tenant.Name)
+	d.Set("plan", tenant.Plan)
+	d.Set("region", tenant.Region)
+	d.Set("licenses", tenant.Licenses)
+	d.Set("suspended", tenant.Status == opensase.TenantSuspended)
+	d.Set("parent_id", tenant.ParentID)
+	d.Set("status", tenant.Status)
+	return nil
+}
+
+func resourceTenantUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChanges("name", "plan", "licenses") {
+		params := &opensase.UpdateTenantParams{}
+		if d.HasChange("name") {
+			params.Name = opensase.String(d.Get("name").(string))
+		}
+		if d.HasChange("plan") {
+			params.Plan = opensase.String(d.Get("plan").(string))
+		}
+		if d.HasChange("licenses") {
+			licenses := expandIntMap(d.Get("licenses").(map[string]interface{}))
+			params.Licenses = &licenses
+		}
+		if _, err := client.API.Tenants.Update(ctx, d.Id(), params); err != nil {
+			return apiDiagnostics(err, "Error updating tenant")
+		}
+	}
+
+	if d.HasChange("suspended") {
+		var err error
+		if d.Get("suspended").(bool) {
+			_, err = client.API.Tenants.Suspend(ctx, d.Id())
+		} else {
+			_, err = client.API.Tenants.Resume(ctx, d.Id())
+		}
+		if err != nil {
+			return apiDiagnostics(err, "Error changing tenant suspension")
+		}
+	}
+
+	return resourceTenantRead(ctx, d, m)
+}
+
+func resourceTenantDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Tenants.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting tenant")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandIntMap(raw map[string]interface{}) map[string]int {
+	if len(raw) == 0 {
+		return nil
+	}
+	out := make(map[string]int, len(raw))
+	for k, v := range raw {
+		out[k] = v.(int)
+	}
+	return out
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ Threat Prevention Profile Resource ============
+
+var (
+	threatSeverities = []string{"critical", "high", "medium", "low", "informational"}
+	threatActions    = []string{opensase.ThreatActionAllow, opensase.ThreatActionAlert, opensase.ThreatActionBlock, opensase.ThreatActionReset}
+)
+
+func resourceThreatPreventionProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "IPS and anti-malware profile with per-severity actions and signature exceptions",
+		CreateContext: resourceThreatPreventionProfileCreate,
+		ReadContext:   resourceThreatPreventionProfileRead,
+		UpdateContext: resourceThreatPreventionProfileUpdate,
+		DeleteContext: resourceThreatPreventionProfileDelete,
+		CustomizeDiff: validateThreatPreventionProfile,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      opensase.ThreatModeInline,
+				Description:  "inline blocks matching traffic; detect_only logs matches without enforcing actions",
+				ValidateFunc: validation.StringInSlice([]string{opensase.ThreatModeInline, opensase.ThreatModeDetectOnly}, false),
+			},
+			"signature_sets": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Signature sets to enable, e.g. default, ics, server-protection. Defaults to the platform's recommended sets.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"severity_action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(threatSeverities, false),
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(threatActions, false),
+						},
+					},
+				},
+			},
+			"exception": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cve": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`), "must be a CVE ID such as CVE-2024-3400"),
+						},
+						"signature_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(threatActions, false),
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"anti_malware": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Scan files for malware in addition to IPS signature matching",
+			},
+		},
+	}
+}
+
+// validateThreatPreventionProfile checks that severities are not repeated and
+// that each exception names exactly one of cve and signature_id
+func validateThreatPreventionProfile(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	seen := map[string]bool{}
+	for _, r := range d.Get("severity_action").(*schema.Set).List() {
+		severity := r.(map[string]interface{})["severity"].(string)
+		if seen[severity] {
+			return fmt.Errorf("severity_action: %q is set more than once", severity)
+		}
+		seen[severity] = true
+	}
+
+	for _, r := range d.Get("exception").(*schema.Set).List() {
+		e := r.(map[string]interface{})
+		cve, sig := e["cve"].(string), e["signature_id"].(string)
+		if (cve == "") == (sig == "") {
+			return fmt.Errorf("exception: exactly one of cve and signature_id must be set")
+		}
+	}
+	return nil
+}
+
+func expandThreatSeverityActions(s *schema.Set) []opensase.ThreatSeverityAction {
+	actions := make([]opensase.ThreatSeverityAction, 0, s.Len())
+	for _, r := range s.List() {
+		a := r.(map[string]interface{})
+		actions = append(actions, opensase.ThreatSeverityAction{
+			Severity: a["severity"].(string),
+			Action:   a["action"].(string),
+		})
+	}
+	return actions
+}
+
+func flattenThreatSeverityActions(actions []opensase.ThreatSeverityAction) []interface{} {
+	out := make([]interface{}, 0, len(actions))
+	for _, a := range actions {
+		out = append(out, map[string]interface{}{
+			"severity": a.Severity,
+			"action":   a.Action,
+		})
+	}
+	return out
+}
+
+func expandThreatExceptions(s *schema.Set) []opensase.ThreatException {
+	exceptions := make([]opensase.ThreatException, 0, s.Len())
+	for _, r := range s.List() {
+		e := r.(map[string]interface{})
+		exceptions = append(exceptions, opensase.ThreatException{
+			CVE:         e["cve"].(string),
+			SignatureID: e["signature_id"].(string),
+			Action:      e["action"].(string),
+			Comment:     e["comment"].(string),
+		})
+	}
+	return exceptions
+}
+
+func flattenThreatExceptions(exceptions []opensase.ThreatException) []interface{} {
+	out := make([]interface{}, 0, len(exceptions))
+	for _, e := range exceptions {
+		out = append(out, map[string]interface{}{
+			"cve":          e.CVE,
+			"signature_id": e.SignatureID,
+			"action":       e.Action,
+			"comment":      e.Comment,
+		})
+	}
+	return out
+}
+
+func resourceThreatPreventionProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.ThreatPrevention.Create(ctx, &opensase.CreateThreatPreventionProfileParams{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Mode:            d.Get("mode").(string),
+		SignatureSets:   expandStringSet(d.Get("signature_sets").(*schema.Set)),
+		SeverityActions: expandThreatSeverityActions(d.Get("severity_action").(*schema.Set)),
+		Exceptions:      expandThreatExceptions(d.Get("exception").(*schema.Set)),
+		AntiMalware:     d.Get("anti_malware").(bool),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating threat prevention profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceThreatPreventionProfileRead(ctx, d, m)
+}
+
+func resourceThreatPreventionProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.ThreatPrevention.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading threat prevention profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("mode", profile.Mode)
+	d.Set("signature_sets", profile.SignatureSets)
+	d.Set("severity_action", flattenThreatSeverityActions(profile.SeverityActions))
+	d.Set("exception", flattenThreatExceptions(profile.Exceptions))
+	d.Set("anti_malware", profile.AntiMalware)
+	return nil
+}
+
+func resourceThreatPreventionProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateThreatPreventionProfileParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("mode") {
+		params.Mode = opensase.String(d.Get("mode").(string))
+	}
+	if d.HasChange("signature_sets") {
+		sets := expandStringSet(d.Get("signature_sets").(*schema.Set))
+		params.SignatureSets = &sets
+	}
+	if d.HasChange("severity_action") {
+		actions := expandThreatSeverityActions(d.Get("severity_action").(*schema.Set))
+		params.SeverityActions = &actions
+	}
+	if d.HasChange("exception") {
+		exceptions := expandThreatExceptions(d.Get("exception").(*schema.Set))
+		params.Exceptions = &exceptions
+	}
+	if d.HasChange("anti_malware") {
+		params.AntiMalware = opensase.Bool(d.Get("anti_malware").(bool))
+	}
+
+	if _, err := client.API.Security.ThreatPrevention.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating threat prevention profile")
+	}
+
+	return resourceThreatPreventionProfileRead(ctx, d, m)
+}
+
+func resourceThreatPreventionProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.ThreatPrevention.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting threat prevention profile")
+	}
+
+	d.SetId("")
+	return nil
+}
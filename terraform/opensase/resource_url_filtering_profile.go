@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ URL Filtering Profile Resource ============
+
+var urlFilteringActions = []string{opensase.URLActionAllow, opensase.URLActionBlock, opensase.URLActionWarn}
+
+func resourceURLFilteringProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "URL filtering profile with per-category actions and custom allow/deny lists",
+		CreateContext: resourceURLFilteringProfileCreate,
+		ReadContext:   resourceURLFilteringProfileRead,
+		UpdateContext: resourceURLFilteringProfileUpdate,
+		DeleteContext: resourceURLFilteringProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      opensase.URLActionAllow,
+				Description:  "Action for categories without an explicit category block",
+				ValidateFunc: validation.StringInSlice(urlFilteringActions, false),
+			},
+			"category": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL category ID from the catalog",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(urlFilteringActions, false),
+						},
+					},
+				},
+			},
+			"allow_list": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Domains or URLs always allowed, regardless of category",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"deny_list": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Domains or URLs always blocked, regardless of category",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"safe_search": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enforce safe search on supported search engines",
+			},
+			"site_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandURLCategories(s *schema.Set) []opensase.URLCategoryAction {
+	categories := make([]opensase.URLCategoryAction, 0, s.Len())
+	for _, r := range s.List() {
+		c := r.(map[string]interface{})
+		categories = append(categories, opensase.URLCategoryAction{
+			Category: c["name"].(string),
+			Action:   c["action"].(string),
+		})
+	}
+	return categories
+}
+
+func flattenURLCategories(categories []opensase.URLCategoryAction) []interface{} {
+	out := make([]interface{}, 0, len(categories))
+	for _, c := range categories {
+		out = append(out, map[string]interface{}{
+			"name":   c.Category,
+			"action": c.Action,
+		})
+	}
+	return out
+}
+
+func resourceURLFilteringProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.URLFiltering.Create(ctx, &opensase.CreateURLFilteringProfileParams{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		DefaultAction: d.Get("default_action").(string),
+		Categories:    expandURLCategories(d.Get("category").(*schema.Set)),
+		AllowList:     expandStringSet(d.Get("allow_list").(*schema.Set)),
+		DenyList:      expandStringSet(d.Get("deny_list").(*schema.Set)),
+		SafeSearch:    d.Get("safe_search").(bool),
+		SiteIDs:       expandStringSet(d.Get("site_ids").(*schema.Set)),
+		UserGroups:    expandStringSet(d.Get("user_groups").(*schema.Set)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating URL filtering profile")
+	}
+
+	d.SetId(profile.ID)
+	return resourceURLFilteringProfileRead(ctx, d, m)
+}
+
+func resourceURLFilteringProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	profile, err := client.API.Security.URLFiltering.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading URL filtering profile")
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("default_action", profile.DefaultAction)
+	d.Set("category", flattenURLCategories(profile.Categories))
+	d.Set("allow_list", profile.AllowList)
+	d.Set("deny_list", profile.DenyList)
+	d.Set("safe_search", profile.SafeSearch)
+	d.Set("site_ids", profile.SiteIDs)
+	d.Set("user_groups", profile.UserGroups)
+	return nil
+}
+
+func resourceURLFilteringProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateURLFilteringProfileParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("default_action") {
+		params.DefaultAction = opensase.String(d.Get("default_action").(string))
+	}
+	if d.HasChange("category") {
+		categories := expandURLCategories(d.Get("category").(*schema.Set))
+		params.Categories = &categories
+	}
+	if d.HasChange("safe_search") {
+		params.SafeSearch = opensase.Bool(d.Get("safe_search").(bool))
+	}
+	for key, field := range map[string]**[]string{
+		"allow_list":  &params.AllowList,
+		"deny_list":   &params.DenyList,
+		"site_ids":    &params.SiteIDs,
+		"user_groups": &params.UserGroups,
+	} {
+		if d.HasChange(key) {
+			list := expandStringSet(d.Get(key).(*schema.Set))
+			*field = &list
+		}
+	}
+
+	if _, err := client.API.Security.URLFiltering.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating URL filtering profile")
+	}
+
+	return resourceURLFilteringProfileRead(ctx, d, m)
+}
+
+func resourceURLFilteringProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.URLFiltering.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting URL filtering profile")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ VLAN Resource ============
+
+func resourceVLAN() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Routed VLAN interface on the edge device of a site",
+		CreateContext: resourceVLANCreate,
+		ReadContext:   resourceVLANRead,
+		UpdateContext: resourceVLANUpdate,
+		DeleteContext: resourceVLANDelete,
+		CustomizeDiff: validateVLAN,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("VLAN"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "802.1Q tag, unique within the site",
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Subnet of the VLAN, e.g. 10.42.10.0/24",
+				ValidateFunc: validation.IsCIDRNetwork(8, 30),
+			},
+			"gateway_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Address of the edge device on the VLAN. Defaults to the first address of cidr.",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"segment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of an opensase_network_segment the VLAN's hosts belong to",
+			},
+		},
+	}
+}
+
+func validateVLAN(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("cidr") || !d.NewValueKnown("gateway_ip") {
+		return nil
+	}
+	gateway := d.Get("gateway_ip").(string)
+	if gateway == "" {
+		return nil
+	}
+
+	_, subnet, err := net.ParseCIDR(d.Get("cidr").(string))
+	if err != nil {
+		return nil
+	}
+	if !subnet.Contains(net.ParseIP(gateway)) {
+		return fmt.Errorf("gateway_ip: %s is not in %s", gateway, subnet)
+	}
+	return nil
+}
+
+func resourceVLANCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	vlan, err := client.API.Network.VLANs.Create(ctx, siteID, &opensase.CreateVLANParams{
+		Name:      d.Get("name").(string),
+		VLANID:    d.Get("vlan_id").(int),
+		CIDR:      d.Get("cidr").(string),
+		GatewayIP: d.Get("gateway_ip").(string),
+		SegmentID: d.Get("segment_id").(string),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating VLAN")
+	}
+
+	d.SetId(siteID + "/" + vlan.ID)
+	return resourceVLANRead(ctx, d, m)
+}
+
+func resourceVLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, vlanID, err := parseSiteScopedID(d.Id(), "VLAN")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vlan, err := client.API.Network.VLANs.Get(ctx, siteID, vlanID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading VLAN")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("name", vlan.Name)
+	d.Set("vlan_id", vlan.VLANID)
+	d.Set("cidr", vlan.CIDR)
+	d.Set("gateway_ip", vlan.GatewayIP)
+	d.Set("segment_id", vlan.SegmentID)
+	return nil
+}
+
+func resourceVLANUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, vlanID, err := parseSiteScopedID(d.Id(), "VLAN")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateVLANParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("cidr") {
+		params.CIDR = opensase.String(d.Get("cidr").(string))
+	}
+	if d.HasChange("gateway_ip") {
+		params.GatewayIP = opensase.String(d.Get("gateway_ip").(string))
+	}
+	if d.HasChange("segment_id") {
+		params.SegmentID = opensase.String(d.Get("segment_id").(string))
+	}
+
+	if _, err := client.API.Network.VLANs.Update(ctx, siteID, vlanID, params); err != nil {
+		return apiDiagnostics(err, "Error updating VLAN")
+	}
+
+	return resourceVLANRead(ctx, d, m)
+}
+
+func resourceVLANDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, vlanID, err := parseSiteScopedID(d.Id(), "VLAN")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.VLANs.Delete(ctx, siteID, vlanID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting VLAN")
+	}
+
+	d.SetId("")
+	return nil
+}
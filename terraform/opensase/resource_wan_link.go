@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/billyronks/opensase-go/enum"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ WAN Link Resource ============
+
+func resourceWANLink() *schema.Resource {
+	return &schema.Resource{
+		Description:   "WAN uplink of a site, managed independently of the site's other links. Do not combine with inline wan_links on opensase_site for the same site.",
+		CreateContext: resourceWANLinkCreate,
+		ReadContext:   resourceWANLinkRead,
+		UpdateContext: resourceWANLinkUpdate,
+		DeleteContext: resourceWANLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteScoped("WAN link"),
+		},
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Link type: broadband, mpls, lte or satellite",
+				ValidateFunc: validation.StringInSlice(enum.Strings(enum.WANLinkTypes()), false),
+			},
+			"provider_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Carrier or ISP providing the link",
+			},
+			"bandwidth_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"failover_priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Order in which links take over traffic; lower values are preferred",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Objects nested under a site (WAN links, NAT rules, routes) have IDs that
+// are only unique within the site, so their resource ID is site_id/object_id
+func parseSiteScopedID(id, kind string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected %s ID %q, expected <site_id>/<%s_id>", kind, id, strings.ReplaceAll(kind, " ", "_"))
+	}
+	return parts[0], parts[1], nil
+}
+
+// importSiteScoped returns an importer for site-scoped resources that sets
+// site_id from the imported ID
+func importSiteScoped(kind string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		siteID, _, err := parseSiteScopedID(d.Id(), kind)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("site_id", siteID)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func resourceWANLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	link, err := client.API.Network.WANLinks.Create(ctx, siteID, &opensase.CreateWANLinkParams{
+		Name:             d.Get("name").(string),
+		Type:             enum.WANLinkType(d.Get("type").(string)),
+		Provider:         d.Get("provider_name").(string),
+		BandwidthMbps:    d.Get("bandwidth_mbps").(int),
+		FailoverPriority: d.Get("failover_priority").(int),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating WAN link")
+	}
+
+	d.SetId(siteID + "/" + link.ID)
+	return resourceWANLinkRead(ctx, d, m)
+}
+
+func resourceWANLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, linkID, err := parseSiteScopedID(d.Id(), "WAN link")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	link, err := client.API.Network.WANLinks.Get(ctx, siteID, linkID)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading WAN link")
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("name", link.Name)
+	d.Set("type", string(link.Type))
+	d.Set("provider_name", link.Provider)
+	d.Set("bandwidth_mbps", link.BandwidthMbps)
+	d.Set("failover_priority", link.FailoverPriority)
+	d.Set("status", string(link.Status))
+	return nil
+}
+
+func resourceWANLinkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, linkID, err := parseSiteScopedID(d.Id(), "WAN link")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &opensase.UpdateWANLinkParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("type") {
+		params.Type = opensase.Ptr(enum.WANLinkType(d.Get("type").(string)))
+	}
+	if d.HasChange("provider_name") {
+		params.Provider = opensase.String(d.Get("provider_name").(string))
+	}
+	if d.HasChange("bandwidth_mbps") {
+		params.BandwidthMbps = opensase.Int(d.Get("bandwidth_mbps").(int))
+	}
+	if d.HasChange("failover_priority") {
+		params.FailoverPriority = opensase.Int(d.Get("failover_priority").(int))
+	}
+
+	if _, err := client.API.Network.WANLinks.Update(ctx, siteID, linkID, params); err != nil {
+		return apiDiagnostics(err, "Error updating WAN link")
+	}
+
+	return resourceWANLinkRead(ctx, d, m)
+}
+
+func resourceWANLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID, linkID, err := parseSiteScopedID(d.Id(), "WAN link")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.API.Network.WANLinks.Delete(ctx, siteID, linkID); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting WAN link")
+	}
+
+	d.SetId("")
+	return nil
+}
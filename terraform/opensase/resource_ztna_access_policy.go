@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ ZTNA Access Policy Resource ============
+
+func resourceZTNAAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Access policy granting user groups access to ZTNA applications",
+		CreateContext: resourceZTNAAccessPolicyCreate,
+		ReadContext:   resourceZTNAAccessPolicyRead,
+		UpdateContext: resourceZTNAAccessPolicyUpdate,
+		DeleteContext: resourceZTNAAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "allow",
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Evaluation order; lower values are evaluated first",
+			},
+			"application_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"device_posture": devicePostureSchema(),
+			"mfa": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required": {Type: schema.TypeBool, Optional: true, Default: true},
+						"methods": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"totp", "webauthn", "push", "sms"}, false),
+							},
+						},
+						"reauth_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"hit_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Times the rule has matched traffic. Only populated with the provider's rule_usage_stats enabled.",
+			},
+			"last_matched_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the rule last matched traffic. Only populated with the provider's rule_usage_stats enabled.",
+			},
+		},
+	}
+}
+
+// devicePostureSchema is the device_posture block shared by resources that
+// gate client connections on device checks
+func devicePostureSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"managed":         {Type: schema.TypeBool, Optional: true},
+				"disk_encryption": {Type: schema.TypeBool, Optional: true},
+				"firewall":        {Type: schema.TypeBool, Optional: true},
+				"min_os_versions": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: "Minimum OS version keyed by platform, e.g. macos = \"14.0\"",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandDevicePosture(raw []interface{}) *opensase.ZTNADevicePosture {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	p := raw[0].(map[string]interface{})
+	posture := &opensase.ZTNADevicePosture{
+		Managed:        p["managed"].(bool),
+		DiskEncryption: p["disk_encryption"].(bool),
+		Firewall:       p["firewall"].(bool),
+	}
+	if versions := p["min_os_versions"].(map[string]interface{}); len(versions) > 0 {
+		posture.MinOSVersions = make(map[string]string, len(versions))
+		for k, v := range versions {
+			posture.MinOSVersions[k] = v.(string)
+		}
+	}
+	return posture
+}
+
+func flattenDevicePosture(p *opensase.ZTNADevicePosture) []interface{} {
+	if p == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"managed":         p.Managed,
+		"disk_encryption": p.DiskEncryption,
+		"firewall":        p.Firewall,
+		"min_os_versions": p.MinOSVersions,
+	}}
+}
+
+func expandMFA(raw []interface{}) *opensase.ZTNAMFARequirement {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &opensase.ZTNAMFARequirement{
+		Required:      m["required"].(bool),
+		Methods:       expandStringSet(m["methods"].(*schema.Set)),
+		ReauthMinutes: m["reauth_minutes"].(int),
+	}
+}
+
+func flattenMFA(mfa *opensase.ZTNAMFARequirement) []interface{} {
+	if mfa == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"required":       mfa.Required,
+		"methods":        mfa.Methods,
+		"reauth_minutes": mfa.ReauthMinutes,
+	}}
+}
+
+func resourceZTNAAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.ZTNAAccessPolicies.Create(ctx, &opensase.CreateZTNAAccessPolicyParams{
+		Name:           d.Get("name").(string),
+		Action:         d.Get("action").(string),
+		Priority:       d.Get("priority").(int),
+		ApplicationIDs: expandStringSet(d.Get("application_ids").(*schema.Set)),
+		UserGroups:     expandStringSet(d.Get("user_groups").(*schema.Set)),
+		DevicePosture:  expandDevicePosture(d.Get("device_posture").([]interface{})),
+		MFA:            expandMFA(d.Get("mfa").([]interface{})),
+		Enabled:        opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating ZTNA access policy")
+	}
+
+	d.SetId(policy.ID)
+	return resourceZTNAAccessPolicyRead(ctx, d, m)
+}
+
+func resourceZTNAAccessPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	policy, err := client.API.Security.ZTNAAccessPolicies.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading ZTNA access policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("action", policy.Action)
+	d.Set("priority", policy.Priority)
+	d.Set("application_ids", policy.ApplicationIDs)
+	d.Set("user_groups", policy.UserGroups)
+	d.Set("device_posture", flattenDevicePosture(policy.DevicePosture))
+	d.Set("mfa", flattenMFA(policy.MFA))
+	d.Set("enabled", policy.Enabled)
+	setRuleUsage(d, client, policy.RuleUsage)
+	return nil
+}
+
+func resourceZTNAAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateZTNAAccessPolicyParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("action") {
+		params.Action = opensase.String(d.Get("action").(string))
+	}
+	if d.HasChange("priority") {
+		params.Priority = opensase.Int(d.Get("priority").(int))
+	}
+	if d.HasChange("application_ids") {
+		params.ApplicationIDs = expandStringSet(d.Get("application_ids").(*schema.Set))
+	}
+	if d.HasChange("user_groups") {
+		params.UserGroups = expandStringSet(d.Get("user_groups").(*schema.Set))
+	}
+	if d.HasChange("device_posture") {
+		params.DevicePosture = expandDevicePosture(d.Get("device_posture").([]interface{}))
+		if params.DevicePosture == nil {
+			params.DevicePosture = &opensase.ZTNADevicePosture{}
+		}
+	}
+	if d.HasChange("mfa") {
+		params.MFA = expandMFA(d.Get("mfa").([]interface{}))
+		if params.MFA == nil {
+			params.MFA = &opensase.ZTNAMFARequirement{}
+		}
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Security.ZTNAAccessPolicies.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating ZTNA access policy")
+	}
+
+	return resourceZTNAAccessPolicyRead(ctx, d, m)
+}
+
+func resourceZTNAAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.ZTNAAccessPolicies.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting ZTNA access policy")
+	}
+
+	d.SetId("")
+	return nil
+}
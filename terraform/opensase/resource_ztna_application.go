@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+
+	opensase "github.com/billyronks/opensase-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ============ ZTNA Application Resource ============
+
+func resourceZTNAApplication() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Private application published through ZTNA connectors",
+		CreateContext: resourceZTNAApplicationCreate,
+		ReadContext:   resourceZTNAApplicationRead,
+		UpdateContext: resourceZTNAApplicationUpdate,
+		DeleteContext: resourceZTNAApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hostnames": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Internal hostnames or IP addresses of the application",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"port": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "tcp",
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+						},
+						"from": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"to": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Last port of the range; defaults to from",
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"connector_group_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Connector groups that can reach the application",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandZTNAPorts(s *schema.Set) []opensase.ZTNAPortRange {
+	ports := make([]opensase.ZTNAPortRange, 0, s.Len())
+	for _, r := range s.List() {
+		p := r.(map[string]interface{})
+		ports = append(ports, opensase.ZTNAPortRange{
+			Protocol: p["protocol"].(string),
+			From:     p["from"].(int),
+			To:       p["to"].(int),
+		})
+	}
+	return ports
+}
+
+func flattenZTNAPorts(ports []opensase.ZTNAPortRange) []interface{} {
+	out := make([]interface{}, 0, len(ports))
+	for _, p := range ports {
+		out = append(out, map[string]interface{}{
+			"protocol": p.Protocol,
+			"from":     p.From,
+			"to":       p.To,
+		})
+	}
+	return out
+}
+
+func resourceZTNAApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	app, err := client.API.Security.ZTNAApplications.Create(ctx, &opensase.CreateZTNAApplicationParams{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Hostnames:       expandStringSet(d.Get("hostnames").(*schema.Set)),
+		Ports:           expandZTNAPorts(d.Get("port").(*schema.Set)),
+		ConnectorGroups: expandStringSet(d.Get("connector_group_ids").(*schema.Set)),
+		Enabled:         opensase.Bool(d.Get("enabled").(bool)),
+	})
+	if err != nil {
+		return apiDiagnostics(err, "Error creating ZTNA application")
+	}
+
+	d.SetId(app.ID)
+	return resourceZTNAApplicationRead(ctx, d, m)
+}
+
+func resourceZTNAApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	app, err := client.API.Security.ZTNAApplications.Get(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return apiDiagnostics(err, "Error reading ZTNA application")
+	}
+
+	d.Set("name", app.Name)
+	d.Set("description", app.Description)
+	d.Set("hostnames", app.Hostnames)
+	d.Set("port", flattenZTNAPorts(app.Ports))
+	d.Set("connector_group_ids", app.ConnectorGroups)
+	d.Set("enabled", app.Enabled)
+	d.Set("status", app.Status)
+	return nil
+}
+
+func resourceZTNAApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	params := &opensase.UpdateZTNAApplicationParams{}
+	if d.HasChange("name") {
+		params.Name = opensase.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		params.Description = opensase.String(d.Get("description").(string))
+	}
+	if d.HasChange("hostnames") {
+		params.Hostnames = expandStringSet(d.Get("hostnames").(*schema.Set))
+	}
+	if d.HasChange("port") {
+		params.Ports = expandZTNAPorts(d.Get("port").(*schema.Set))
+	}
+	if d.HasChange("connector_group_ids") {
+		params.ConnectorGroups = expandStringSet(d.Get("connector_group_ids").(*schema.Set))
+	}
+	if d.HasChange("enabled") {
+		params.Enabled = opensase.Bool(d.Get("enabled").(bool))
+	}
+
+	if _, err := client.API.Security.ZTNAApplications.Update(ctx, d.Id(), params); err != nil {
+		return apiDiagnostics(err, "Error updating ZTNA application")
+	}
+
+	return resourceZTNAApplicationRead(ctx, d, m)
+}
+
+func resourceZTNAApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if err := client.API.Security.ZTNAApplications.Delete(ctx, d.Id()); err != nil && !isNotFound(err) {
+		return apiDiagnostics(err, "Error deleting ZTNA application")
+	}
+
+	d.SetId("")
+	return nil
+}